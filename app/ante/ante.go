@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// ExtensionOptionsEthereumTxTypeURL is the type URL of the extension option
+// that flags a Cosmos transaction as a wrapper of Ethereum transactions.
+const ExtensionOptionsEthereumTxTypeURL = "/ethermint.evm.v1.ExtensionOptionsEthereumTx"
+
+// NewAnteHandler returns an ante handler responsible for attempting to route
+// an Ethereum or SDK transaction to an internal ante handler for performing
+// transaction-level processing (e.g. fee payment, signature verification)
+// before being passed onto its respective handler.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	evmAnteHandler := newMonoEVMAnteHandler(options)
+	cosmosAnteHandler := newCosmosAnteHandler(options)
+
+	return func(
+		ctx sdk.Context, tx sdk.Tx, sim bool,
+	) (newCtx sdk.Context, err error) {
+		txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+		if ok {
+			opts := txWithExtensions.GetExtensionOptions()
+			if len(opts) > 0 {
+				switch typeURL := opts[0].GetTypeUrl(); typeURL {
+				case ExtensionOptionsEthereumTxTypeURL:
+					// handle as *evmtypes.MsgEthereumTx
+					return evmAnteHandler(ctx, tx, sim)
+				default:
+					return ctx, errorsmod.Wrapf(
+						errortypes.ErrUnknownExtensionOptions,
+						"rejecting tx with unsupported extension option: %s", typeURL,
+					)
+				}
+			}
+		}
+
+		// handle as totally normal Cosmos SDK tx
+		return cosmosAnteHandler(ctx, tx, sim)
+	}, nil
+}
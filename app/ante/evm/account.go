@@ -0,0 +1,146 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evm
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	"github.com/green901612/cosevm/x/evm/keeper"
+	"github.com/green901612/cosevm/x/evm/statedb"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// VerifyAccountBalance checks that the account balance is greater than the
+// total transaction cost. The account will be set to store if it doesn't exist,
+// i.e. cannot be found on store. It returns an error if the sender is not an
+// EOA (i.e. it has code other than an EIP-7702 delegation designator).
+func VerifyAccountBalance(
+	ctx sdk.Context,
+	accountKeeper evmtypes.AccountKeeper,
+	evmKeeper EVMKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+) error {
+	// check whether the sender address is EOA
+	if account != nil && account.IsContract() && !isDelegated(ctx, evmKeeper, account) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidType,
+			"the sender is not EOA: address %s", from,
+		)
+	}
+
+	if account == nil {
+		acc := accountKeeper.NewAccountWithAddress(ctx, from.Bytes())
+		accountKeeper.SetAccount(ctx, acc)
+		account = statedb.NewEmptyAccount()
+	}
+
+	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// isDelegated returns true if the code of the given account is an EIP-7702
+// delegation designator.
+func isDelegated(ctx sdk.Context, evmKeeper EVMKeeper, account *statedb.Account) bool {
+	_, ok := vm.ParseDelegation(evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash)))
+	return ok
+}
+
+// CanTransfer checks that the fee cap of the transaction is not lower than the
+// block base fee (London hard fork) and that the sender has enough balance to
+// cover the value transferred by the **topmost** call.
+func CanTransfer(
+	ctx sdk.Context,
+	evmKeeper EVMKeeper,
+	from common.Address,
+	txData evmtypes.TxData,
+	baseFee *big.Int,
+	isLondon bool,
+) error {
+	if isLondon && baseFee != nil && txData.GetGasFeeCap().Cmp(baseFee) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"max fee per gas less than block base fee (%s < %s)",
+			txData.GetGasFeeCap(), baseFee,
+		)
+	}
+
+	value := txData.GetValue()
+	if value.Sign() > 0 && evmKeeper.GetBalance(ctx, from).Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"failed to transfer %s from address %s using the EVM block context transfer function",
+			value,
+			from,
+		)
+	}
+
+	return nil
+}
+
+// IncrementNonce checks that the transaction nonce matches the account
+// sequence and increments it by one. The nonce verification is merged with the
+// increment so that a transaction with multiple messages from the same sender
+// is accepted.
+//
+// NOTE: contract creations are incremented as well. The state transition
+// resets the nonce to the tx one before creating the contract, so that the
+// contract address is derived from the correct nonce.
+func IncrementNonce(
+	ctx sdk.Context,
+	accountKeeper evmtypes.AccountKeeper,
+	account sdk.AccountI,
+	txNonce uint64,
+) error {
+	nonce := account.GetSequence()
+	if txNonce != nonce {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"invalid nonce; got %d, expected %d", txNonce, nonce,
+		)
+	}
+
+	nonce++
+
+	if err := account.SetSequence(nonce); err != nil {
+		return errorsmod.Wrapf(err, "failed to set sequence to %d", nonce)
+	}
+
+	accountKeeper.SetAccount(ctx, account)
+	return nil
+}
+
+// CheckNonce checks that the transaction nonce is not lower than the account
+// sequence, nor higher than the sequence plus the given maximum nonce gap. It
+// is used in CheckTx instead of IncrementNonce, since the application mempool
+// queues the transactions with a future nonce and replaces the ones with an
+// already pending nonce. The gap bounds the future nonces that reach the
+// mempool without ever being executable.
+func CheckNonce(account sdk.AccountI, txNonce, maxNonceGap uint64) error {
+	nonce := account.GetSequence()
+	if txNonce < nonce {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"nonce too low; got %d, expected at least %d", txNonce, nonce,
+		)
+	}
+	if txNonce-nonce > maxNonceGap {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"nonce too high; got %d, expected at most %d", txNonce, nonce+maxNonceGap,
+		)
+	}
+	return nil
+}
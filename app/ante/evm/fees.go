@@ -0,0 +1,232 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evm
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	apptypes "github.com/green901612/cosevm/types"
+	"github.com/green901612/cosevm/utils"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// CheckMempoolFee checks if the provided fee is at least as large as the local
+// validator's configured value. The fee computation assumes that both price
+// and fee are represented in 18 decimals.
+//
+// NOTE: the check is skipped once the London hard fork is enabled, since the
+// base fee check performed by the fee market takes over.
+func CheckMempoolFee(fee, mempoolMinGasPrice, gasLimit sdkmath.LegacyDec, isLondon bool) error {
+	if isLondon {
+		return nil
+	}
+
+	requiredFee := mempoolMinGasPrice.Mul(gasLimit)
+
+	if fee.LT(requiredFee) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"insufficient fee; got: %s required: %s",
+			fee, requiredFee,
+		)
+	}
+
+	return nil
+}
+
+// CheckGlobalFee checks if the provided fee is at least as large as the global
+// minimum gas price defined in the fee market parameters. The fee computation
+// assumes that both price and fee are represented in 18 decimals.
+func CheckGlobalFee(fee, globalMinGasPrice, gasLimit sdkmath.LegacyDec) error {
+	// skip check if the global min gas price is not set
+	if globalMinGasPrice.IsZero() {
+		return nil
+	}
+
+	requiredFee := globalMinGasPrice.Mul(gasLimit)
+
+	if fee.LT(requiredFee) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"provided fee < minimum global fee (%s < %s). Please increase the priority tip (for EIP-1559 txs) or the gas prices (for access list or legacy txs)", //nolint:lll
+			fee.TruncateInt().String(), requiredFee.TruncateInt().String(),
+		)
+	}
+
+	return nil
+}
+
+// ConsumeFeesAndEmitEvent deduces fees from the sender and emits the fee
+// event of the transaction.
+func ConsumeFeesAndEmitEvent(
+	ctx sdk.Context,
+	evmKeeper EVMKeeper,
+	fees sdk.Coins,
+	from common.Address,
+) error {
+	if !fees.IsZero() {
+		if err := evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, from); err != nil {
+			return errorsmod.Wrap(err, "failed to deduct transaction costs from user balance")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
+		),
+	)
+
+	return nil
+}
+
+// RefundReplacedTxFees refunds the fees deducted during CheckTx for a pending
+// transaction of the sender with the same nonce, which is replaced in the
+// application mempool by the new transaction. Since the nonce isn't
+// incremented in CheckTx, this ensures that the fees of every nonce are only
+// deducted once from the check state.
+func RefundReplacedTxFees(
+	ctx sdk.Context,
+	evmKeeper EVMKeeper,
+	from common.Address,
+	nonce uint64,
+) error {
+	fees := evmKeeper.GetCheckTxFees(ctx, from, nonce)
+	if fees.IsZero() {
+		return nil
+	}
+
+	if err := evmKeeper.RefundTxCostsToUserBalance(ctx, fees, from); err != nil {
+		return errorsmod.Wrap(err, "failed to refund the fees of the replaced transaction")
+	}
+	evmKeeper.SetCheckTxFees(ctx, from, nonce, nil)
+	return nil
+}
+
+// UpdateCumulativeGasWanted updates the cumulative gas wanted. During CheckTx
+// the gas wanted of a single message is capped to the max tx gas wanted
+// configured by the node, since the unused gas is refunded after execution.
+func UpdateCumulativeGasWanted(
+	ctx sdk.Context,
+	msgGasWanted uint64,
+	maxTxGasWanted uint64,
+	cumulativeGasWanted uint64,
+) uint64 {
+	if ctx.IsCheckTx() && maxTxGasWanted != 0 && msgGasWanted > maxTxGasWanted {
+		// We can't trust the tx gas limit, because we'll refund the unused gas.
+		return cumulativeGasWanted + maxTxGasWanted
+	}
+
+	return cumulativeGasWanted + msgGasWanted
+}
+
+// GetMsgPriority returns the lowest priority between the given message and
+// the current minimum priority.
+func GetMsgPriority(
+	txData evmtypes.TxData,
+	minPriority int64,
+	baseFee *big.Int,
+) int64 {
+	priority := evmtypes.GetTxPriority(txData, baseFee)
+
+	if priority < minPriority {
+		minPriority = priority
+	}
+	return minPriority
+}
+
+// UpdateCumulativeTxFee adds the fee of a message to the cumulative fee of the
+// transaction.
+func UpdateCumulativeTxFee(
+	cumulativeTxFee sdk.Coins,
+	msgFee *big.Int,
+	denom string,
+) sdk.Coins {
+	return cumulativeTxFee.Add(
+		sdk.Coin{
+			Denom:  denom,
+			Amount: sdkmath.NewIntFromBigInt(msgFee),
+		},
+	)
+}
+
+// CheckGasWanted checks that the gas wanted of the transaction does not exceed
+// the block gas limit and, if the base fee is enabled, adds it to the
+// cumulative gas wanted of the block tracked by the fee market.
+func CheckGasWanted(
+	ctx sdk.Context,
+	feeMarketKeeper FeeMarketKeeper,
+	tx sdk.Tx,
+	isLondon bool,
+) error {
+	if !isLondon {
+		return nil
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	gasWanted := feeTx.GetGas()
+	blockGasLimit := apptypes.BlockGasLimit(ctx)
+
+	// Node should not store a transaction with gas wanted larger than block
+	// gas limit (rejected by CometBFT mempool)
+	if gasWanted > blockGasLimit {
+		return errorsmod.Wrapf(
+			errortypes.ErrOutOfGas,
+			"tx gas (%d) exceeds block gas limit (%d)",
+			gasWanted,
+			blockGasLimit,
+		)
+	}
+
+	if !feeMarketKeeper.GetBaseFeeEnabled(ctx) {
+		return nil
+	}
+
+	// Add total gasWanted to cumulative in block transientStore in FeeMarket module
+	if _, err := feeMarketKeeper.AddTransientGasWanted(ctx, gasWanted); err != nil {
+		return errorsmod.Wrapf(err, "failed to add gas wanted to transient store")
+	}
+
+	return nil
+}
+
+// CheckBlockGasLimit checks that the gas wanted by the transaction does not
+// exceed the block gas limit and sets up the transaction gas meter and
+// priority.
+func CheckBlockGasLimit(ctx sdk.Context, gasWanted uint64, minPriority int64) (sdk.Context, error) {
+	blockGasLimit := apptypes.BlockGasLimit(ctx)
+
+	// return error if the tx gas is greater than the block limit (max gas)
+
+	// NOTE: it's important here to use the gas wanted instead of the gas consumed
+	// from the tx gas pool. The latter only has the value so far since the
+	// SetupContext, so it will never exceed the block gas limit.
+	if gasWanted > blockGasLimit {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrOutOfGas,
+			"tx gas (%d) exceeds block gas limit (%d)",
+			gasWanted,
+			blockGasLimit,
+		)
+	}
+
+	// Set tx GasMeter with a limit of GasWanted (i.e. gas limit from the
+	// Ethereum tx). The gas consumed will be then reset to the gas used by the
+	// state transition in the EVM.
+	ctx = ctx.
+		WithGasMeter(utils.NewInfiniteGasMeterWithLimit(gasWanted)).
+		WithPriority(minPriority)
+
+	return ctx, nil
+}
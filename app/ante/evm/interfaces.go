@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evm
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/green901612/cosevm/x/evm/statedb"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+	feemarkettypes "github.com/green901612/cosevm/x/feemarket/types"
+)
+
+// EVMKeeper defines the expected keeper interface used on the EVM AnteHandler
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	GetEthChainConfig(ctx sdk.Context) *params.ChainConfig
+	GetBaseFee(ctx sdk.Context) *big.Int
+	GetMinGasPrice(ctx sdk.Context) math.LegacyDec
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
+	GetCode(ctx sdk.Context, codeHash common.Hash) []byte
+	GetTxIndexTransient(ctx sdk.Context) uint64
+	ResetTransientGasUsed(ctx sdk.Context)
+	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	RefundTxCostsToUserBalance(ctx sdk.Context, fees sdk.Coins, to common.Address) error
+	GetCheckTxFees(ctx sdk.Context, sender common.Address, nonce uint64) sdk.Coins
+	SetCheckTxFees(ctx sdk.Context, sender common.Address, nonce uint64, fees sdk.Coins)
+}
+
+// TxPool defines the expected application mempool interface used on the EVM
+// AnteHandler during CheckTx, where the mempool is the nonce authority.
+type TxPool interface {
+	CheckReplacement(sender common.Address, nonce uint64, hash common.Hash, gasFeeCap, gasTipCap *big.Int) error
+	MaxNonceGap() uint64
+}
+
+// FeeMarketKeeper defines the expected keeper interface used on the EVM AnteHandler
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
+	GetBaseFeeEnabled(ctx sdk.Context) bool
+	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
+}
@@ -0,0 +1,318 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evm
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/green901612/cosevm/x/evm/keeper"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// MonoDecorator is a single decorator that handles all the prechecks for
+// Ethereum transactions (i.e. Cosmos transactions that only contain
+// MsgEthereumTx messages).
+type MonoDecorator struct {
+	accountKeeper   evmtypes.AccountKeeper
+	evmKeeper       EVMKeeper
+	feeMarketKeeper FeeMarketKeeper
+	txPool          TxPool
+	maxGasWanted    uint64
+}
+
+// DecoratorUtils contain a bunch of relevant variables used for a bunch of
+// checks throughout the mono decorator.
+type DecoratorUtils struct {
+	EvmParams          evmtypes.Params
+	EthConfig          *params.ChainConfig
+	Rules              params.Rules
+	Signer             ethtypes.Signer
+	BaseFee            *big.Int
+	EvmDenom           string
+	MempoolMinGasPrice sdkmath.LegacyDec
+	GlobalMinGasPrice  sdkmath.LegacyDec
+	BlockTxIndex       uint64
+	TxGasLimit         uint64
+	GasWanted          uint64
+	MinPriority        int64
+	TxFee              sdk.Coins
+}
+
+// NewEVMMonoDecorator creates the 'mono' decorator, that is used to run the
+// ante handle logic for EVM transactions on the chain.
+//
+// This runs all the default checks for EVM transactions enable through Cosmos
+// SDK. It combines the previously separate decorators from Ethermint to reduce
+// the number of keeper and state reads.
+func NewEVMMonoDecorator(
+	accountKeeper evmtypes.AccountKeeper,
+	feeMarketKeeper FeeMarketKeeper,
+	evmKeeper EVMKeeper,
+	txPool TxPool,
+	maxGasWanted uint64,
+) MonoDecorator {
+	return MonoDecorator{
+		accountKeeper:   accountKeeper,
+		feeMarketKeeper: feeMarketKeeper,
+		evmKeeper:       evmKeeper,
+		txPool:          txPool,
+		maxGasWanted:    maxGasWanted,
+	}
+}
+
+// AnteHandle handles the entire decorator chain using a mono decorator. The
+// checks run for every MsgEthereumTx are the following:
+//
+//  1. mempool inclusion fee (CheckTx only and pre-London)
+//  2. global minimum gas price
+//  3. message validation (sender and EVM access control)
+//  4. signature verification and EIP-155 replay protection
+//  5. sender account verification and balance
+//  6. base fee and value transfer
+//  7. intrinsic gas and fee deduction (once per nonce in CheckTx)
+//  8. nonce verification and increment (verification only in CheckTx)
+//
+// Finally, the gas wanted of the whole transaction is checked against the
+// block gas limit.
+func (md MonoDecorator) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	var txFeeInfo *txtypes.Fee
+	if !ctx.IsReCheckTx() {
+		txFeeInfo, err = ValidateTx(tx)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	// 0. Setup the context for the EVM execution
+	ctx, err = SetupContext(ctx, tx, md.evmKeeper)
+	if err != nil {
+		return ctx, err
+	}
+
+	decUtils, err := NewMonoDecoratorUtils(ctx, md.evmKeeper, md.feeMarketKeeper)
+	if err != nil {
+		return ctx, err
+	}
+
+	// Use the lowest priority of all the messages as the final one.
+	for i, msg := range tx.GetMsgs() {
+		ethMsg, txData, err := evmtypes.UnpackEthMsg(msg)
+		if err != nil {
+			return ctx, err
+		}
+
+		feeAmt := txData.Fee()
+		gas := txData.GetGas()
+		fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
+		gasLimit := sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
+
+		// 1. mempool inclusion fee
+		if ctx.IsCheckTx() && !simulate {
+			if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
+				return ctx, err
+			}
+		}
+
+		// 2. min gas price (global min fee)
+		if isDynamicFeeTx(txData) && decUtils.BaseFee != nil {
+			feeAmt = txData.EffectiveFee(decUtils.BaseFee)
+			fee = sdkmath.LegacyNewDecFromBigInt(feeAmt)
+		}
+
+		if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice, gasLimit); err != nil {
+			return ctx, err
+		}
+
+		// 3. validate msg contents
+		if err := ValidateMsg(decUtils.EvmParams, ethMsg, txData); err != nil {
+			return ctx, err
+		}
+
+		// 4. signature verification
+		if err := SignatureVerification(ethMsg, decUtils.Signer, decUtils.EvmParams.AllowUnprotectedTxs); err != nil {
+			return ctx, err
+		}
+
+		// NOTE: sender address has been verified and cached
+		from := common.HexToAddress(ethMsg.From)
+
+		// the same nonce replacements of a pending transaction are refunded
+		// the fees of the replaced transaction before the balance checks.
+		// The underpriced replacements, rejected by the mempool, are rejected
+		// first, since the changes of the ante handler are written to the
+		// check state before the mempool insertion.
+		isCheckTx := ctx.IsCheckTx() && !simulate
+		if isCheckTx {
+			if err := md.txPool.CheckReplacement(
+				from, txData.GetNonce(), ethMsg.TxHash(), txData.GetGasFeeCap(), txData.GetGasTipCap(),
+			); err != nil {
+				return ctx, err
+			}
+			if err := RefundReplacedTxFees(ctx, md.evmKeeper, from, txData.GetNonce()); err != nil {
+				return ctx, err
+			}
+		}
+
+		// 5. account balance verification
+		account := md.evmKeeper.GetAccount(ctx, from)
+		if err := VerifyAccountBalance(ctx, md.accountKeeper, md.evmKeeper, account, from, txData); err != nil {
+			return ctx, err
+		}
+
+		// 6. can transfer
+		if err := CanTransfer(ctx, md.evmKeeper, from, txData, decUtils.BaseFee, decUtils.Rules.IsLondon); err != nil {
+			return ctx, err
+		}
+
+		// 7. gas consumption
+		msgFees, err := keeper.VerifyFee(
+			txData,
+			decUtils.EvmDenom,
+			decUtils.BaseFee,
+			decUtils.Rules.IsHomestead,
+			decUtils.Rules.IsIstanbul,
+			decUtils.Rules.IsShanghai,
+			ctx.IsCheckTx(),
+		)
+		if err != nil {
+			return ctx, err
+		}
+
+		if err := ConsumeFeesAndEmitEvent(ctx, md.evmKeeper, msgFees, from); err != nil {
+			return ctx, err
+		}
+		if isCheckTx {
+			md.evmKeeper.SetCheckTxFees(ctx, from, txData.GetNonce(), msgFees)
+		}
+
+		decUtils.GasWanted = UpdateCumulativeGasWanted(ctx, gas, md.maxGasWanted, decUtils.GasWanted)
+		decUtils.MinPriority = GetMsgPriority(txData, decUtils.MinPriority, decUtils.BaseFee)
+		decUtils.TxFee = UpdateCumulativeTxFee(decUtils.TxFee, txData.Fee(), decUtils.EvmDenom)
+		decUtils.TxGasLimit += gas
+
+		// 8. increment sequence
+		acc := md.accountKeeper.GetAccount(ctx, from.Bytes())
+		if acc == nil {
+			// safety check: shouldn't happen
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s does not exist", from)
+		}
+
+		// the application mempool is the nonce authority in CheckTx, it
+		// queues future nonces, up to its maximum nonce gap, and handles the
+		// same nonce replacements
+		if isCheckTx {
+			if err := CheckNonce(acc, txData.GetNonce(), md.txPool.MaxNonceGap()); err != nil {
+				return ctx, err
+			}
+		} else if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
+			return ctx, err
+		}
+
+		// emit ethereum tx hash as an event so that it can be indexed by
+		// CometBFT for query purposes. It's emitted in the ante handler so
+		// that failed transactions (e.g. out of block gas limit) can be queried.
+		EmitTxHashEvent(ctx, ethMsg, decUtils.BlockTxIndex, uint64(i)) // #nosec G115 -- i is never negative
+	}
+
+	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
+		return ctx, err
+	}
+
+	// 9. gas wanted
+	if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
+		return ctx, err
+	}
+
+	ctx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, decUtils.MinPriority)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// NewMonoDecoratorUtils returns a new DecoratorUtils instance.
+//
+// These utilities are extracted once at the beginning of the ante handle
+// process, and are used throughout the entire decorator chain. This avoids
+// redundant calls to the keeper and thus improves speed of transaction
+// processing.
+func NewMonoDecoratorUtils(
+	ctx sdk.Context,
+	ek EVMKeeper,
+	fmk FeeMarketKeeper,
+) (*DecoratorUtils, error) {
+	evmParams := ek.GetParams(ctx)
+	ethCfg := ek.GetEthChainConfig(ctx)
+	blockHeight := big.NewInt(ctx.BlockHeight())
+	rules := ethCfg.Rules(blockHeight, ethCfg.MergeNetsplitBlock != nil)
+	baseFee := ek.GetBaseFee(ctx)
+	evmDenom := evmtypes.GetEVMCoinDenom()
+
+	if rules.IsLondon && baseFee == nil {
+		return nil, errorsmod.Wrap(
+			evmtypes.ErrInvalidBaseFee,
+			"base fee is supported but evm block context value is nil",
+		)
+	}
+
+	// Mempool gas price should be scaled to the 18 decimals representation. If
+	// it is already a 18 decimal token, this is a no-op.
+	mempoolMinGasPrice := evmtypes.ConvertAmountTo18DecimalsLegacy(ctx.MinGasPrices().AmountOf(evmDenom))
+
+	// NOTE: the evm keeper already returns the global min gas price in the
+	// 18 decimals representation.
+	globalMinGasPrice := ek.GetMinGasPrice(ctx)
+
+	return &DecoratorUtils{
+		EvmParams:          evmParams,
+		EthConfig:          ethCfg,
+		Rules:              rules,
+		Signer:             ethtypes.MakeSigner(ethCfg, blockHeight),
+		BaseFee:            baseFee,
+		EvmDenom:           evmDenom,
+		MempoolMinGasPrice: mempoolMinGasPrice,
+		GlobalMinGasPrice:  globalMinGasPrice,
+		BlockTxIndex:       ek.GetTxIndexTransient(ctx),
+		GasWanted:          0,
+		MinPriority:        int64(math.MaxInt64),
+		TxGasLimit:         0,
+		TxFee:              sdk.Coins{},
+	}, nil
+}
+
+// isDynamicFeeTx returns true if the fees of the transaction depend on the
+// base fee, i.e. for the EIP-1559 and EIP-7702 transactions.
+func isDynamicFeeTx(txData evmtypes.TxData) bool {
+	txType := txData.TxType()
+	return txType == ethtypes.DynamicFeeTxType || txType == evmtypes.SetCodeTxType
+}
+
+// EmitTxHashEvent emits the Ethereum tx hash and the index of the message in
+// the block.
+func EmitTxHashEvent(ctx sdk.Context, msg *evmtypes.MsgEthereumTx, blockTxIndex, msgIndex uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			evmtypes.EventTypeEthereumTx,
+			sdk.NewAttribute(evmtypes.AttributeKeyEthereumTxHash, msg.Hash),
+			sdk.NewAttribute(evmtypes.AttributeKeyTxIndex, strconv.FormatUint(blockTxIndex+msgIndex, 10)),
+		),
+	)
+}
@@ -0,0 +1,237 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	"github.com/green901612/cosevm/app/ante/evm"
+	"github.com/green901612/cosevm/mempool"
+	"github.com/green901612/cosevm/testutil"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var (
+	gasPrice  = big.NewInt(10_000_000_000)
+	recipient = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+// txPool returns the application mempool, which handles the nonces in CheckTx.
+func txPool(miniApp *app.MiniApp) *mempool.EVMMempool {
+	return miniApp.Mempool().(*mempool.EVMMempool)
+}
+
+// newEthTx returns the Cosmos transaction of a legacy Ethereum transfer of the
+// account, signed for the chain of the application.
+func newEthTx(t *testing.T, miniApp *app.MiniApp, ctx sdk.Context, acc testutil.Account, nonce, gas uint64) sdk.Tx {
+	t.Helper()
+
+	return newEthTxWithGasPrice(t, miniApp, ctx, acc, nonce, gas, gasPrice)
+}
+
+// newEthTxWithGasPrice returns the Cosmos transaction of a legacy Ethereum
+// transfer of the account paying the given gas price.
+func newEthTxWithGasPrice(t *testing.T, miniApp *app.MiniApp, ctx sdk.Context, acc testutil.Account, nonce, gas uint64, gasPrice *big.Int) sdk.Tx {
+	t.Helper()
+
+	chainID := miniApp.EvmKeeper.GetEthChainConfig(ctx).ChainID
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  chainID,
+		Nonce:    nonce,
+		To:       &recipient,
+		Amount:   big.NewInt(1),
+		GasLimit: gas,
+		GasPrice: gasPrice,
+	})
+
+	key, err := acc.Key.ToECDSA()
+	require.NoError(t, err)
+	signedTx, err := ethtypes.SignTx(msg.AsTransaction(), ethtypes.LatestSignerForChainID(chainID), key)
+	require.NoError(t, err)
+	require.NoError(t, msg.FromEthereumTx(signedTx))
+
+	tx, err := msg.BuildTx(miniApp.TxConfig().NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	require.NoError(t, err)
+	return tx
+}
+
+func nextAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+func TestMonoDecoratorFees(t *testing.T) {
+	acc := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(acc, sdkmath.NewInt(1e18)))
+	md := evm.NewEVMMonoDecorator(miniApp.AccountKeeper, miniApp.FeemarketKeeper, miniApp.EvmKeeper, txPool(miniApp), 0)
+	feeCollector := miniApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	denom := evmtypes.GetEVMCoinDenom()
+
+	balanceBefore := miniApp.BankKeeper.GetBalance(ctx, acc.AccAddr, denom)
+	feesBefore := miniApp.BankKeeper.GetBalance(ctx, feeCollector, denom)
+
+	_, err := md.AnteHandle(ctx, newEthTx(t, miniApp, ctx, acc, 0, 21000), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// the fees of the whole gas limit are deducted before the execution
+	fee := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, big.NewInt(21000)))
+	require.Equal(t, balanceBefore.Amount.Sub(fee), miniApp.BankKeeper.GetBalance(ctx, acc.AccAddr, denom).Amount)
+	require.Equal(t, feesBefore.Amount.Add(fee), miniApp.BankKeeper.GetBalance(ctx, feeCollector, denom).Amount)
+
+	// the balance must cover the fees
+	_, err = md.AnteHandle(ctx, newEthTx(t, miniApp, ctx, acc, 1, 1_000_000_000), false, nextAnteHandler)
+	require.Error(t, err)
+
+	// the gas limit must cover the intrinsic gas in CheckTx
+	_, err = md.AnteHandle(ctx.WithIsCheckTx(true), newEthTx(t, miniApp, ctx, acc, 1, 20000), false, nextAnteHandler)
+	require.ErrorContains(t, err, "intrinsic gas")
+}
+
+func TestMonoDecoratorNonce(t *testing.T) {
+	acc := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(acc, sdkmath.NewInt(1e18)))
+	md := evm.NewEVMMonoDecorator(miniApp.AccountKeeper, miniApp.FeemarketKeeper, miniApp.EvmKeeper, txPool(miniApp), 0)
+	maxNonceGap := txPool(miniApp).MaxNonceGap()
+
+	sequence := func() uint64 {
+		seq, err := miniApp.AccountKeeper.GetSequence(ctx, acc.AccAddr)
+		require.NoError(t, err)
+		return seq
+	}
+
+	testCases := []struct {
+		name     string
+		checkTx  bool
+		nonce    uint64
+		expErr   bool
+		expNonce uint64
+	}{
+		{"check tx - the current nonce is not incremented", true, 0, false, 0},
+		{"check tx - a future nonce is queued by the mempool", true, 5, false, 0},
+		{"check tx - a nonce beyond the mempool nonce gap is rejected", true, maxNonceGap + 1, true, 0},
+		{"check tx - a nonce at the mempool nonce gap is queued", true, maxNonceGap, false, 0},
+		{"deliver tx - a future nonce is rejected", false, 1, true, 0},
+		{"deliver tx - the current nonce is incremented", false, 0, false, 1},
+		{"deliver tx - a past nonce is rejected", false, 0, true, 1},
+		{"check tx - a past nonce is rejected", true, 0, true, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := newEthTx(t, miniApp, ctx, acc, tc.nonce, 21000)
+			_, err := md.AnteHandle(ctx.WithIsCheckTx(tc.checkTx), tx, false, nextAnteHandler)
+			if tc.expErr {
+				require.ErrorContains(t, err, "nonce")
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expNonce, sequence())
+		})
+	}
+}
+
+func TestMonoDecoratorGasWanted(t *testing.T) {
+	acc := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(acc, sdkmath.NewInt(1e18).MulRaw(1e6)))
+	blockGasLimit := uint64(ctx.ConsensusParams().Block.MaxGas)
+
+	testCases := []struct {
+		name         string
+		checkTx      bool
+		maxGasWanted uint64
+		gas          uint64
+		expGasWanted uint64
+		expErr       bool
+	}{
+		{"check tx - the gas wanted is capped", true, 50_000, 100_000, 50_000, false},
+		{"check tx - no cap", true, 0, 100_000, 100_000, false},
+		{"deliver tx - the cap doesn't apply", false, 50_000, 100_000, 100_000, false},
+		{"deliver tx - the gas exceeds the block gas limit", false, 0, blockGasLimit + 1, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.WithIsCheckTx(tc.checkTx).CacheContext()
+			md := evm.NewEVMMonoDecorator(miniApp.AccountKeeper, miniApp.FeemarketKeeper, miniApp.EvmKeeper, txPool(miniApp), tc.maxGasWanted)
+
+			newCtx, err := md.AnteHandle(cacheCtx, newEthTx(t, miniApp, ctx, acc, 0, tc.gas), false, nextAnteHandler)
+			if tc.expErr {
+				require.ErrorContains(t, err, "exceeds block gas limit")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expGasWanted, newCtx.GasMeter().Limit())
+		})
+	}
+}
+
+func TestMonoDecoratorCheckTxReplacement(t *testing.T) {
+	// the balance covers the cost of a single transaction
+	fee := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, big.NewInt(21000)))
+	acc := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(acc, fee.MulRaw(2)))
+	md := evm.NewEVMMonoDecorator(miniApp.AccountKeeper, miniApp.FeemarketKeeper, miniApp.EvmKeeper, txPool(miniApp), 0)
+	ctx = ctx.WithIsCheckTx(true)
+	denom := evmtypes.GetEVMCoinDenom()
+
+	_, err := md.AnteHandle(ctx, newEthTx(t, miniApp, ctx, acc, 0, 21000), false, nextAnteHandler)
+	require.NoError(t, err)
+	require.Equal(t, fee, miniApp.BankKeeper.GetBalance(ctx, acc.AccAddr, denom).Amount)
+
+	// the replacement of the pending nonce is refunded the fees of the
+	// replaced transaction, so they are only deducted once
+	_, err = md.AnteHandle(ctx, newEthTx(t, miniApp, ctx, acc, 0, 21000), false, nextAnteHandler)
+	require.NoError(t, err)
+	require.Equal(t, fee, miniApp.BankKeeper.GetBalance(ctx, acc.AccAddr, denom).Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, fee)), miniApp.EvmKeeper.GetCheckTxFees(ctx, acc.Address, 0))
+
+	// the fees of the pending nonce still account for the following ones
+	_, err = md.AnteHandle(ctx, newEthTx(t, miniApp, ctx, acc, 1, 21000), false, nextAnteHandler)
+	require.ErrorContains(t, err, "insufficient funds")
+}
+
+func TestMonoDecoratorCheckTxUnderpricedReplacement(t *testing.T) {
+	acc := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(acc, sdkmath.NewInt(1e18)))
+	mp := txPool(miniApp)
+	md := evm.NewEVMMonoDecorator(miniApp.AccountKeeper, miniApp.FeemarketKeeper, miniApp.EvmKeeper, mp, 0)
+	ctx = ctx.WithIsCheckTx(true)
+	denom := evmtypes.GetEVMCoinDenom()
+	balanceBefore := miniApp.BankKeeper.GetBalance(ctx, acc.AccAddr, denom).Amount
+
+	feeOf := func(gasPrice *big.Int) sdkmath.Int {
+		return sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, big.NewInt(21000)))
+	}
+
+	// the pending transaction is checked and inserted in the mempool
+	tx := newEthTx(t, miniApp, ctx, acc, 0, 21000)
+	_, err := md.AnteHandle(ctx, tx, false, nextAnteHandler)
+	require.NoError(t, err)
+	require.NoError(t, mp.Insert(ctx, tx))
+
+	// a replacement that doesn't bump the gas price enough is rejected before
+	// refunding the fees of the pending transaction
+	underpriced := new(big.Int).Div(new(big.Int).Mul(gasPrice, big.NewInt(105)), big.NewInt(100))
+	for i := 0; i < 2; i++ {
+		_, err = md.AnteHandle(ctx, newEthTxWithGasPrice(t, miniApp, ctx, acc, 0, 21000, underpriced), false, nextAnteHandler)
+		require.ErrorContains(t, err, "replacement transaction underpriced")
+		require.Equal(t, balanceBefore.Sub(feeOf(gasPrice)), miniApp.BankKeeper.GetBalance(ctx, acc.AccAddr, denom).Amount)
+		require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, feeOf(gasPrice))), miniApp.EvmKeeper.GetCheckTxFees(ctx, acc.Address, 0))
+	}
+
+	// a replacement bumping the gas price by the price bump is charged its
+	// fees instead of the ones of the pending transaction
+	bumped := new(big.Int).Div(new(big.Int).Mul(gasPrice, big.NewInt(110)), big.NewInt(100))
+	replacement := newEthTxWithGasPrice(t, miniApp, ctx, acc, 0, 21000, bumped)
+	_, err = md.AnteHandle(ctx, replacement, false, nextAnteHandler)
+	require.NoError(t, err)
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, balanceBefore.Sub(feeOf(bumped)), miniApp.BankKeeper.GetBalance(ctx, acc.AccAddr, denom).Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, feeOf(bumped))), miniApp.EvmKeeper.GetCheckTxFees(ctx, acc.Address, 0))
+}
@@ -0,0 +1,36 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	evmante "github.com/green901612/cosevm/x/evm/ante"
+)
+
+// SetupContext sets up the context for the EVM AnteHandler. It replaces the
+// gas meter with an infinite one, as the gas is tracked by the EVM state
+// transition and not by the SDK, and resets the transient gas used, which is
+// needed to sum the gas used of a Cosmos tx that contains multiple Ethereum
+// messages.
+func SetupContext(ctx sdk.Context, tx sdk.Tx, evmKeeper EVMKeeper) (sdk.Context, error) {
+	// all transactions must implement GasTx
+	if _, ok := tx.(authante.GasTx); !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected GasTx", tx)
+	}
+
+	// We need to setup an empty gas config so that the gas is consistent with Ethereum.
+	newCtx := evmante.BuildEvmExecutionCtx(ctx).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// Reset transient gas used to prepare the execution of current cosmos tx.
+	// Transient gas-used is necessary to sum the gas-used of cosmos tx, when it contains multiple eth msgs.
+	evmKeeper.ResetTransientGasUsed(ctx)
+
+	return newCtx, nil
+}
@@ -0,0 +1,59 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// SignatureVerification checks that the signature of the Ethereum transaction
+// is valid and recovers the sender address, which is then cached on the
+// message From field. Unprotected (i.e. non EIP-155 signed) transactions are
+// rejected unless they are explicitly allowed.
+func SignatureVerification(
+	msg *evmtypes.MsgEthereumTx,
+	signer ethtypes.Signer,
+	allowUnprotectedTxs bool,
+) error {
+	// EIP-7702 transactions are always protected and cannot be recovered by
+	// the go-ethereum signers
+	if txData, err := evmtypes.UnpackTxData(msg.Data); err == nil && txData.TxType() == evmtypes.SetCodeTxType {
+		if _, err := msg.GetSender(signer.ChainID()); err != nil {
+			return errorsmod.Wrapf(
+				errortypes.ErrorInvalidSigner,
+				"couldn't retrieve sender address from the ethereum transaction: %s",
+				err.Error(),
+			)
+		}
+		return nil
+	}
+
+	ethTx := msg.AsTransaction()
+	if ethTx == nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "failed to unpack ethereum transaction data")
+	}
+
+	if !allowUnprotectedTxs && !ethTx.Protected() {
+		return errorsmod.Wrapf(
+			errortypes.ErrNotSupported,
+			"rejected unprotected Ethereum transaction. Please EIP155 sign your transaction to protect it against replay-attacks")
+	}
+
+	sender, err := signer.Sender(ethTx)
+	if err != nil {
+		return errorsmod.Wrapf(
+			errortypes.ErrorInvalidSigner,
+			"couldn't retrieve sender address from the ethereum transaction: %s",
+			err.Error(),
+		)
+	}
+
+	// set up the sender to the transaction field if not already
+	msg.From = sender.Hex()
+	return nil
+}
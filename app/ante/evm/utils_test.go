@@ -0,0 +1,125 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app/ante/evm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+func TestCheckMempoolFee(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fee      sdkmath.LegacyDec
+		minPrice sdkmath.LegacyDec
+		isLondon bool
+		expPass  bool
+	}{
+		{"pass - fee covers the min gas price", sdkmath.LegacyNewDec(100), sdkmath.LegacyNewDec(1), false, true},
+		{"fail - fee lower than the min gas price", sdkmath.LegacyNewDec(99), sdkmath.LegacyNewDec(1), false, false},
+		{"pass - skipped after london", sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(1), true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := evm.CheckMempoolFee(tc.fee, tc.minPrice, sdkmath.LegacyNewDec(100), tc.isLondon)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckGlobalFee(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fee      sdkmath.LegacyDec
+		minPrice sdkmath.LegacyDec
+		expPass  bool
+	}{
+		{"pass - global min gas price disabled", sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), true},
+		{"pass - fee covers the global min gas price", sdkmath.LegacyNewDec(200), sdkmath.LegacyNewDec(2), true},
+		{"fail - fee lower than the global min gas price", sdkmath.LegacyNewDec(199), sdkmath.LegacyNewDec(2), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := evm.CheckGlobalFee(tc.fee, tc.minPrice, sdkmath.LegacyNewDec(100))
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateCumulativeGasWanted(t *testing.T) {
+	checkTxCtx := sdk.Context{}.WithIsCheckTx(true)
+	deliverTxCtx := sdk.Context{}
+
+	require.Equal(t, uint64(150), evm.UpdateCumulativeGasWanted(checkTxCtx, 100, 0, 50))
+	require.Equal(t, uint64(80), evm.UpdateCumulativeGasWanted(checkTxCtx, 100, 30, 50))
+	require.Equal(t, uint64(150), evm.UpdateCumulativeGasWanted(deliverTxCtx, 100, 30, 50))
+}
+
+func TestSignatureVerification(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.BigToAddress(big.NewInt(1))
+	chainID := big.NewInt(2931)
+
+	newMsg := func(signer ethtypes.Signer) *evmtypes.MsgEthereumTx {
+		tx := ethtypes.NewTx(&ethtypes.LegacyTx{
+			Nonce:    1,
+			To:       &to,
+			Value:    big.NewInt(10),
+			Gas:      21000,
+			GasPrice: big.NewInt(1),
+		})
+		signedTx, err := ethtypes.SignTx(tx, signer, key)
+		require.NoError(t, err)
+
+		msg := &evmtypes.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(signedTx))
+		return msg
+	}
+
+	testCases := []struct {
+		name        string
+		txSigner    ethtypes.Signer
+		allowUnprot bool
+		expPass     bool
+	}{
+		{"pass - protected tx", ethtypes.NewEIP155Signer(chainID), false, true},
+		{"fail - unprotected tx not allowed", ethtypes.HomesteadSigner{}, false, false},
+		{"pass - unprotected tx allowed", ethtypes.HomesteadSigner{}, true, true},
+		{"fail - protected tx for another chain", ethtypes.NewEIP155Signer(big.NewInt(1)), false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := newMsg(tc.txSigner)
+
+			err := evm.SignatureVerification(msg, ethtypes.NewLondonSigner(chainID), tc.allowUnprot)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, from.Hex(), msg.From)
+			} else {
+				require.Error(t, err)
+				require.Empty(t, msg.From)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// protoTxProvider is the interface implemented by the SDK tx wrapper that
+// exposes the underlying protobuf transaction.
+type protoTxProvider interface {
+	GetProtoTx() *tx.Tx
+}
+
+// ValidateTx checks that the Cosmos transaction wrapping the Ethereum
+// messages does not contain any field that is not used by the EVM (memo,
+// timeout height, signatures, etc.) and returns the fee information of the
+// transaction.
+func ValidateTx(sdkTx sdk.Tx) (*tx.Fee, error) {
+	wrapperTx, ok := sdkTx.(protoTxProvider)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid tx type %T, didn't implement interface protoTxProvider", sdkTx)
+	}
+
+	protoTx := wrapperTx.GetProtoTx()
+	if protoTx == nil || protoTx.Body == nil || protoTx.AuthInfo == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "invalid eth tx: missing body or auth info")
+	}
+
+	if len(protoTx.Body.Messages) == 0 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx messages should not be empty")
+	}
+
+	body := protoTx.Body
+	if body.Memo != "" || body.TimeoutHeight != uint64(0) || len(body.NonCriticalExtensionOptions) > 0 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest,
+			"for eth tx body Memo TimeoutHeight NonCriticalExtensionOptions should be empty")
+	}
+
+	if len(body.ExtensionOptions) != 1 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be 1")
+	}
+
+	authInfo := protoTx.AuthInfo
+	if len(authInfo.SignerInfos) > 0 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
+	}
+
+	if authInfo.Fee == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee should not be empty")
+	}
+
+	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	}
+
+	if len(protoTx.Signatures) > 0 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx Signatures should be empty")
+	}
+
+	return authInfo.Fee, nil
+}
+
+// ValidateMsg validates an Ethereum specific message type and returns an error
+// if invalid. It checks that the sender is not set by the client (it is always
+// recovered from the signature) and that the contract creation or call is not
+// disabled through the EVM access control parameters.
+func ValidateMsg(
+	evmParams evmtypes.Params,
+	ethMsg *evmtypes.MsgEthereumTx,
+	txData evmtypes.TxData,
+) error {
+	if ethMsg.From != "" {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid from address; expected empty; got: %q", ethMsg.From)
+	}
+
+	return checkDisabledCreateCall(txData, &evmParams.AccessControl)
+}
+
+// checkDisabledCreateCall checks if the transaction is a contract creation or
+// call and if those actions are disabled through governance.
+func checkDisabledCreateCall(
+	txData evmtypes.TxData,
+	permissions *evmtypes.AccessControl,
+) error {
+	to := txData.GetTo()
+	blockCreate := permissions.Create.AccessType == evmtypes.AccessTypeRestricted
+	blockCall := permissions.Call.AccessType == evmtypes.AccessTypeRestricted
+
+	// return error if contract creation or call are disabled through governance
+	// and the transaction is trying to create a contract or call a contract
+	if blockCreate && to == nil {
+		return errorsmod.Wrap(evmtypes.ErrCreateDisabled, "failed to create new contract")
+	} else if blockCall && to != nil {
+		return errorsmod.Wrap(evmtypes.ErrCallDisabled, "failed to perform a call")
+	}
+	return nil
+}
+
+// CheckTxFee checks that the fee and gas limit set in the Cosmos transaction
+// auth info match the cumulative fee and gas limit of the Ethereum messages.
+// The provided txFee is expected in the 18 decimals representation.
+func CheckTxFee(txFeeInfo *tx.Fee, txFee sdk.Coins, txGasLimit uint64) error {
+	if txFeeInfo == nil {
+		return nil
+	}
+
+	// the fees in the auth info use the original decimals of the evm coin
+	txFee = evmtypes.ConvertCoinsFrom18Decimals(txFee)
+	if !txFeeInfo.Amount.Equal(txFee) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid AuthInfo Fee Amount (%s != %s)", txFeeInfo.Amount, txFee)
+	}
+
+	if txFeeInfo.GasLimit != txGasLimit {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid AuthInfo Fee GasLimit (%d != %d)", txFeeInfo.GasLimit, txGasLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	txsigning "cosmossdk.io/x/tx/signing"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmante "github.com/green901612/cosevm/app/ante/evm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// HandlerOptions defines the list of module keepers required to run the
+// AnteHandler decorators for both Cosmos and Ethereum transactions.
+type HandlerOptions struct {
+	AccountKeeper          evmtypes.AccountKeeper
+	BankKeeper             authtypes.BankKeeper
+	FeegrantKeeper         authante.FeegrantKeeper
+	ExtensionOptionChecker authante.ExtensionOptionChecker
+	SignModeHandler        *txsigning.HandlerMap
+	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
+	TxFeeChecker           authante.TxFeeChecker
+	EvmKeeper              evmante.EVMKeeper
+	FeeMarketKeeper        evmante.FeeMarketKeeper
+	// TxPool is the application mempool, which handles the nonces of the
+	// Ethereum transactions in CheckTx.
+	TxPool evmante.TxPool
+	// MaxTxGasWanted caps the gas wanted of a single Ethereum message during
+	// CheckTx. A zero value disables the cap.
+	MaxTxGasWanted uint64
+}
+
+// Validate checks if the keepers are defined
+func (options HandlerOptions) Validate() error {
+	if options.AccountKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "account keeper is required for AnteHandler")
+	}
+	if options.BankKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "bank keeper is required for AnteHandler")
+	}
+	if options.SignModeHandler == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "sign mode handler is required for AnteHandler")
+	}
+	if options.SigGasConsumer == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "signature gas consumer is required for AnteHandler")
+	}
+	if options.EvmKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "evm keeper is required for AnteHandler")
+	}
+	if options.FeeMarketKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "fee market keeper is required for AnteHandler")
+	}
+	if options.TxPool == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "tx pool is required for AnteHandler")
+	}
+	return nil
+}
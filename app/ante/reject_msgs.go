@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// RejectMessagesDecorator prevents invalid msg types from being executed
+type RejectMessagesDecorator struct{}
+
+// AnteHandle rejects messages that requires ethereum-specific authentication.
+// For example `MsgEthereumTx` requires fee to be deducted in the antehandler in
+// order to perform the refund.
+func (rmd RejectMessagesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrInvalidType,
+				"MsgEthereumTx needs to be contained within a tx with 'ExtensionOptionsEthereumTx' option",
+			)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,72 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ante
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/green901612/cosevm/crypto/ethsecp256k1"
+)
+
+// Secp256k1VerifyCost is the gas consumed by the verification of an
+// eth_secp256k1 signature. It matches the cost of the ecrecover precompile
+// plus the intrinsic cost of an Ethereum transaction.
+const Secp256k1VerifyCost uint64 = 21000
+
+// SigVerificationGasConsumer consumes the gas of the verification of a
+// signature. The eth_secp256k1 keys are supported, including within multisig
+// keys, and the other key types are handled as in the SDK default consumer.
+func SigVerificationGasConsumer(
+	meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params,
+) error {
+	switch pubkey := sig.PubKey.(type) {
+	case *ethsecp256k1.PubKey:
+		meter.ConsumeGas(Secp256k1VerifyCost, "ante verify: eth_secp256k1")
+		return nil
+
+	case multisig.PubKey:
+		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
+		if !ok {
+			return fmt.Errorf("expected %T, got, %T", &signing.MultiSignatureData{}, sig.Data)
+		}
+		return consumeMultisignatureVerificationGas(meter, multisignature, pubkey, params, sig.Sequence)
+
+	default:
+		return authante.DefaultSigVerificationGasConsumer(meter, sig, params)
+	}
+}
+
+// consumeMultisignatureVerificationGas consumes the gas of the verification of
+// each signature of a multisig.
+func consumeMultisignatureVerificationGas(
+	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubkey multisig.PubKey,
+	params authtypes.Params, accSeq uint64,
+) error {
+	pubkeys := pubkey.GetPubKeys()
+	size := sig.BitArray.Count()
+	sigIndex := 0
+
+	for i := 0; i < size; i++ {
+		if !sig.BitArray.GetIndex(i) {
+			continue
+		}
+		sigV2 := signing.SignatureV2{
+			PubKey:   pubkeys[i],
+			Data:     sig.Signatures[sigIndex],
+			Sequence: accSeq,
+		}
+		if err := SigVerificationGasConsumer(meter, sigV2, params); err != nil {
+			return err
+		}
+		sigIndex++
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package ante_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app/ante"
+	"github.com/green901612/cosevm/crypto/ethsecp256k1"
+)
+
+func TestSigVerificationGasConsumer(t *testing.T) {
+	params := authtypes.DefaultParams()
+
+	ethKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	ethPubKey := ethKey.PubKey()
+	secpPubKey := secp256k1.GenPrivKey().PubKey()
+
+	// newMultisig returns the multisig signature of all the given keys
+	newMultisig := func(t *testing.T, pubkeys []cryptotypes.PubKey) signing.SignatureV2 {
+		t.Helper()
+
+		pubkey := kmultisig.NewLegacyAminoPubKey(len(pubkeys), pubkeys)
+		data := multisig.NewMultisig(len(pubkeys))
+		for _, pk := range pubkeys {
+			sig := signing.SignatureV2{PubKey: pk, Data: &signing.SingleSignatureData{Signature: []byte("sig")}}
+			require.NoError(t, multisig.AddSignatureV2(data, sig, pubkeys))
+		}
+		return signing.SignatureV2{PubKey: pubkey, Data: data}
+	}
+
+	testCases := []struct {
+		name   string
+		sig    func(t *testing.T) signing.SignatureV2
+		expGas uint64
+		expErr bool
+	}{
+		{
+			"eth_secp256k1",
+			func(*testing.T) signing.SignatureV2 { return signing.SignatureV2{PubKey: ethPubKey} },
+			ante.Secp256k1VerifyCost,
+			false,
+		},
+		{
+			"secp256k1",
+			func(*testing.T) signing.SignatureV2 { return signing.SignatureV2{PubKey: secpPubKey} },
+			params.SigVerifyCostSecp256k1,
+			false,
+		},
+		{
+			"multisig of eth_secp256k1 and secp256k1 keys",
+			func(t *testing.T) signing.SignatureV2 {
+				return newMultisig(t, []cryptotypes.PubKey{ethPubKey, secpPubKey})
+			},
+			ante.Secp256k1VerifyCost + params.SigVerifyCostSecp256k1,
+			false,
+		},
+		{
+			"ed25519 is unsupported",
+			func(*testing.T) signing.SignatureV2 {
+				return signing.SignatureV2{PubKey: ed25519.GenPrivKey().PubKey()}
+			},
+			params.SigVerifyCostED25519,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			meter := storetypes.NewInfiniteGasMeter()
+
+			err := ante.SigVerificationGasConsumer(meter, tc.sig(t), params)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expGas, meter.GasConsumed())
+		})
+	}
+}
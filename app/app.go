@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 
 	clienthelpers "cosmossdk.io/client/v2/helpers"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -23,26 +24,42 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cast"
+
+	"github.com/green901612/cosevm/app/ante"
+	"github.com/green901612/cosevm/mempool"
+	srvflags "github.com/green901612/cosevm/server/flags"
+	erc20keeper "github.com/green901612/cosevm/x/erc20/keeper"
 	evmkeeper "github.com/green901612/cosevm/x/evm/keeper"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
 	feemarketkeeper "github.com/green901612/cosevm/x/feemarket/keeper"
 
 	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 	_ "cosmossdk.io/api/cosmos/tx/config/v1"          // import for side-effects
+	_ "cosmossdk.io/x/evidence"                       // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/auth"           // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config" // import for side-effects
+	_ "github.com/cosmos/cosmos-sdk/x/authz/module"   // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/bank"           // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"      // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/distribution"   // import for side-effects
+	_ "github.com/cosmos/cosmos-sdk/x/gov"            // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/mint"           // import for side-effects
+	_ "github.com/cosmos/cosmos-sdk/x/slashing"       // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/staking"        // import for side-effects
-	_ "github.com/green901612/cosevm/x/evm"        // import for side-effects
-	_ "github.com/green901612/cosevm/x/feemarket"        // import for side-effects
+	_ "github.com/green901612/cosevm/x/erc20"         // import for side-effects
+	_ "github.com/green901612/cosevm/x/evm"           // import for side-effects
+	_ "github.com/green901612/cosevm/x/feemarket"     // import for side-effects
 )
 
 // DefaultNodeHome default home directories for the application daemon
@@ -72,9 +89,14 @@ type MiniApp struct {
 	StakingKeeper         *stakingkeeper.Keeper
 	DistrKeeper           distrkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
-	
-	EvmKeeper							*evmkeeper.Keeper
-	FeemarketKeeper				feemarketkeeper.Keeper
+	AuthzKeeper           authzkeeper.Keeper
+	GovKeeper             *govkeeper.Keeper
+	SlashingKeeper        slashingkeeper.Keeper
+	EvidenceKeeper        evidencekeeper.Keeper
+
+	EvmKeeper       *evmkeeper.Keeper
+	FeemarketKeeper feemarketkeeper.Keeper
+	Erc20Keeper     erc20keeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -135,14 +157,49 @@ func NewMiniApp(
 		&app.StakingKeeper,
 		&app.DistrKeeper,
 		&app.ConsensusParamsKeeper,
+		&app.AuthzKeeper,
+		&app.GovKeeper,
+		&app.SlashingKeeper,
+		&app.EvidenceKeeper,
 		&app.EvmKeeper,
 		&app.FeemarketKeeper,
+		&app.Erc20Keeper,
 	); err != nil {
 		return nil, err
 	}
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// configure the x/evm globals (chain config and EVM coin) for this chain
+	if err := EvmAppOptions(app.ChainID()); err != nil {
+		return nil, err
+	}
+
+	// register the static precompiled contracts available to the EVM
+	app.EvmKeeper.WithStaticPrecompiles(NewAvailableStaticPrecompiles(
+		app.EvmKeeper,
+		app.StakingKeeper,
+		app.AuthzKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		app.GovKeeper,
+		app.SlashingKeeper,
+		app.EvidenceKeeper,
+		app.Erc20Keeper,
+		common.HexToAddress(evmtypes.WERC20PrecompileAddress),
+	))
+
+	// register the erc20 keeper that instantiates the dynamic ERC-20 precompiles
+	app.EvmKeeper.WithErc20Keeper(app.Erc20Keeper)
+
+	// set the EVM-aware application mempool used to build the block proposals
+	mp := app.setMempool(appOpts)
+
+	// set the ante handler that routes Cosmos and Ethereum transactions
+	if err := app.setAnteHandler(appOpts, mp); err != nil {
+		return nil, err
+	}
+
 	// register streaming services
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		return nil, err
@@ -162,11 +219,67 @@ func NewMiniApp(
 	return app, nil
 }
 
+// setAnteHandler sets the ante handler of the application, which routes
+// Cosmos transactions to the default SDK decorators and Ethereum transactions
+// to the EVM decorators.
+func (app *MiniApp) setAnteHandler(appOpts servertypes.AppOptions, txPool *mempool.EVMMempool) error {
+	anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
+		AccountKeeper:   app.AccountKeeper,
+		BankKeeper:      app.BankKeeper,
+		SignModeHandler: app.txConfig.SignModeHandler(),
+		SigGasConsumer:  ante.SigVerificationGasConsumer,
+		EvmKeeper:       app.EvmKeeper,
+		FeeMarketKeeper: app.FeemarketKeeper,
+		TxPool:          txPool,
+		MaxTxGasWanted:  cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted)),
+	})
+	if err != nil {
+		return err
+	}
+
+	app.SetAnteHandler(anteHandler)
+	return nil
+}
+
+// setMempool sets the EVM-aware application mempool, which orders the
+// Ethereum transactions by sender nonce and effective tip, and the proposal
+// handlers that select the block transactions from it.
+func (app *MiniApp) setMempool(appOpts servertypes.AppOptions) *mempool.EVMMempool {
+	mp := mempool.NewEVMMempool(mempool.Config{
+		PriceBump:    cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)),
+		AccountSlots: cast.ToUint64(appOpts.Get(srvflags.EVMMempoolAccountSlots)),
+		GlobalSlots:  cast.ToUint64(appOpts.Get(srvflags.EVMMempoolGlobalSlots)),
+		AccountQueue: cast.ToUint64(appOpts.Get(srvflags.EVMMempoolAccountQueue)),
+		GlobalQueue:  cast.ToUint64(appOpts.Get(srvflags.EVMMempoolGlobalQueue)),
+	}, app.EvmKeeper)
+	app.SetMempool(mp)
+
+	proposalHandler := baseapp.NewDefaultProposalHandler(mp, app.BaseApp)
+	app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+	return mp
+}
+
 // LegacyAmino returns MiniApp's amino codec.
 func (app *MiniApp) LegacyAmino() *codec.LegacyAmino {
 	return app.legacyAmino
 }
 
+// AppCodec returns MiniApp's app codec.
+func (app *MiniApp) AppCodec() codec.Codec {
+	return app.appCodec
+}
+
+// InterfaceRegistry returns MiniApp's InterfaceRegistry.
+func (app *MiniApp) InterfaceRegistry() codectypes.InterfaceRegistry {
+	return app.interfaceRegistry
+}
+
+// TxConfig returns MiniApp's TxConfig.
+func (app *MiniApp) TxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 func (app *MiniApp) GetKey(storeKey string) *storetypes.KVStoreKey {
 	sk := app.UnsafeFindStoreKey(storeKey)
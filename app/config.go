@@ -0,0 +1,47 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/green901612/cosevm/utils"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// sealed specifies if the EVM configuration has already been applied in this
+// process. The x/evm globals can only be set once.
+var sealed = false
+
+// EvmAppOptions sets up the global x/evm configuration (chain config and EVM
+// coin information) for the given Cosmos chain ID. It must be called before
+// any EVM state transition is executed.
+//
+// The chains without a registered coin information, e.g. local ones, use the
+// devnet coin information, and the chain IDs that are not in the EIP-155
+// format use the default chain config.
+func EvmAppOptions(chainID string) error {
+	if sealed {
+		return nil
+	}
+
+	id := strings.Split(chainID, "-")[0]
+	coinInfo, found := evmtypes.ChainsCoinInfo[id]
+	if !found {
+		coinInfo = evmtypes.ChainsCoinInfo[utils.DevnetChainID]
+	}
+
+	if _, err := utils.ParseChainID(chainID); err != nil {
+		chainID = ""
+	}
+	ethCfg := evmtypes.DefaultChainConfig(chainID)
+
+	err := evmtypes.NewEVMConfigurator().
+		WithChainConfig(ethCfg).
+		WithEVMCoinInfo(coinInfo.Denom, uint8(coinInfo.Decimals)).
+		Configure()
+	if err != nil {
+		return err
+	}
+
+	sealed = true
+	return nil
+}
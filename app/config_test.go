@@ -0,0 +1,22 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app/params"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+func TestEvmAppOptions(t *testing.T) {
+	// a chain id without a registered coin information nor an EIP-155 chain id
+	require.NoError(t, EvmAppOptions("local"))
+	require.True(t, sealed)
+	require.Equal(t, params.BaseDenom, evmtypes.GetEVMCoinDenom())
+	require.Equal(t, uint64(2931), evmtypes.GetChainConfig().ChainId)
+
+	// the configuration is sealed once applied, so it is not modified
+	require.NoError(t, EvmAppOptions("torram_2929-1"))
+	require.Equal(t, uint64(2931), evmtypes.GetChainConfig().ChainId)
+}
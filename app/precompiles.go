@@ -0,0 +1,104 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package app
+
+import (
+	"fmt"
+
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/common"
+
+	bankprecompile "github.com/green901612/cosevm/precompiles/bank"
+	bech32precompile "github.com/green901612/cosevm/precompiles/bech32"
+	blockhashprecompile "github.com/green901612/cosevm/precompiles/blockhash"
+	distributionprecompile "github.com/green901612/cosevm/precompiles/distribution"
+	evidenceprecompile "github.com/green901612/cosevm/precompiles/evidence"
+	govprecompile "github.com/green901612/cosevm/precompiles/gov"
+	"github.com/green901612/cosevm/precompiles/p256"
+	slashingprecompile "github.com/green901612/cosevm/precompiles/slashing"
+	stakingprecompile "github.com/green901612/cosevm/precompiles/staking"
+	werc20precompile "github.com/green901612/cosevm/precompiles/werc20"
+	erc20keeper "github.com/green901612/cosevm/x/erc20/keeper"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+// NewAvailableStaticPrecompiles returns the list of all available static
+// precompiled contracts of the chain, indexed by address. Only the ones listed
+// in the active static precompiles of the EVM parameters can be called. The
+// WERC20 precompile of the EVM coin is registered at the given address, so the
+// EVM coin must be configured beforehand.
+func NewAvailableStaticPrecompiles(
+	evmKeeper blockhashprecompile.EVMKeeper,
+	stakingKeeper *stakingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+	distributionKeeper distrkeeper.Keeper,
+	govKeeper *govkeeper.Keeper,
+	slashingKeeper slashingkeeper.Keeper,
+	evidenceKeeper evidencekeeper.Keeper,
+	erc20Keeper erc20keeper.Keeper,
+	werc20Address common.Address,
+) map[common.Address]vm.PrecompiledContract {
+	p256Precompile := &p256.Precompile{}
+
+	blockhashPrecompile := blockhashprecompile.NewPrecompile(evmKeeper)
+
+	bech32Precompile, err := bech32precompile.NewPrecompile()
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate bech32 precompile: %w", err))
+	}
+
+	stakingPrecompile, err := stakingprecompile.NewPrecompile(stakingKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate staking precompile: %w", err))
+	}
+
+	distributionPrecompile, err := distributionprecompile.NewPrecompile(distributionKeeper, stakingKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate distribution precompile: %w", err))
+	}
+
+	bankPrecompile, err := bankprecompile.NewPrecompile(bankKeeper, erc20Keeper, werc20Address)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
+	}
+
+	govPrecompile, err := govprecompile.NewPrecompile(govKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate gov precompile: %w", err))
+	}
+
+	slashingPrecompile, err := slashingprecompile.NewPrecompile(slashingKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate slashing precompile: %w", err))
+	}
+
+	evidencePrecompile, err := evidenceprecompile.NewPrecompile(evidenceKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate evidence precompile: %w", err))
+	}
+
+	werc20Precompile, err := werc20precompile.NewPrecompile(werc20Address, bankKeeper, erc20Keeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate werc20 precompile: %w", err))
+	}
+
+	return map[common.Address]vm.PrecompiledContract{
+		p256Precompile.Address():         p256Precompile,
+		bech32Precompile.Address():       bech32Precompile,
+		stakingPrecompile.Address():      stakingPrecompile,
+		distributionPrecompile.Address(): distributionPrecompile,
+		bankPrecompile.Address():         bankPrecompile,
+		govPrecompile.Address():          govPrecompile,
+		slashingPrecompile.Address():     slashingPrecompile,
+		evidencePrecompile.Address():     evidencePrecompile,
+		werc20Precompile.Address():       werc20Precompile,
+		blockhashPrecompile.Address():    blockhashPrecompile,
+	}
+}
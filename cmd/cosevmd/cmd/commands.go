@@ -26,6 +26,7 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
 	"github.com/green901612/cosevm/app"
+	cosevmserver "github.com/green901612/cosevm/server"
 )
 
 func initRootCmd(rootCmd *cobra.Command, txConfig client.TxConfig, basicManager module.BasicManager) {
@@ -40,11 +41,12 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxConfig, basicManager
 		snapshot.Cmd(newApp),
 	)
 
-	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, appExport, func(startCmd *cobra.Command) {})
+	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, cosevmserver.NewStartCmdOptions())
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
+		cosevmserver.NewIndexTxCmd(),
 		genutilcli.Commands(txConfig, basicManager, app.DefaultNodeHome),
 		queryCommand(),
 		txCommand(),
@@ -141,8 +143,11 @@ func appExport(
 	viperAppOpts.Set(server.FlagInvCheckPeriod, 1)
 	appOpts = viperAppOpts
 
+	// the baseapp options define the chain ID used to configure the EVM
+	baseappOptions := server.DefaultBaseappOptions(appOpts)
+
 	if height != -1 {
-		miniApp, err = app.NewMiniApp(logger, db, traceStore, false, appOpts)
+		miniApp, err = app.NewMiniApp(logger, db, traceStore, false, appOpts, baseappOptions...)
 		if err != nil {
 			return servertypes.ExportedApp{}, err
 		}
@@ -151,7 +156,7 @@ func appExport(
 			return servertypes.ExportedApp{}, err
 		}
 	} else {
-		miniApp, err = app.NewMiniApp(logger, db, traceStore, true, appOpts)
+		miniApp, err = app.NewMiniApp(logger, db, traceStore, true, appOpts, baseappOptions...)
 		if err != nil {
 			return servertypes.ExportedApp{}, err
 		}
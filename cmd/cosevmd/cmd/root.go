@@ -16,13 +16,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/server"
-	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtxconfig "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/green901612/cosevm/app"
+	srvconfig "github.com/green901612/cosevm/server/config"
 )
 
 // NewRootCmd creates a new root command for cosevmd. It is called once in the
@@ -73,16 +73,16 @@ func NewRootCmd() *cobra.Command {
 				return err
 			}
 
-			// overwrite the minimum gas price from the app configuration
-			srvCfg := serverconfig.DefaultConfig()
-			srvCfg.MinGasPrices = "0mini"
+			// use the EVM app configuration, which adds the [evm] and [json-rpc]
+			// sections to app.toml
+			customAppTemplate, customAppConfig := srvconfig.AppConfig("mini")
 
 			// overwrite the block timeout
 			cmtCfg := cmtcfg.DefaultConfig()
 			cmtCfg.Consensus.TimeoutCommit = 3 * time.Second
 			cmtCfg.LogLevel = "*:error,p2p:info,state:info" // better default logging
 
-			return server.InterceptConfigsPreRunHandler(cmd, serverconfig.DefaultConfigTemplate, srvCfg, cmtCfg)
+			return server.InterceptConfigsPreRunHandler(cmd, customAppTemplate, customAppConfig, cmtCfg)
 		},
 	}
 
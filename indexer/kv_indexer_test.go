@@ -0,0 +1,202 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/tx/signing"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/indexer"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+func TestKVIndexer(t *testing.T) {
+	clientCtx := newClientCtx(t)
+
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(2931))
+	to := common.BigToAddress(big.NewInt(1))
+
+	newEthTx := func(nonce uint64) *evmtypes.MsgEthereumTx {
+		tx, err := ethtypes.SignNewTx(priv, signer, &ethtypes.LegacyTx{
+			Nonce:    nonce,
+			To:       &to,
+			Gas:      100000,
+			GasPrice: big.NewInt(0),
+		})
+		require.NoError(t, err)
+		msg := &evmtypes.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(tx))
+		return msg
+	}
+
+	encodeEthTx := func(msgs ...*evmtypes.MsgEthereumTx) []byte {
+		builder := clientCtx.TxConfig.NewTxBuilder()
+		_, err := msgs[0].BuildTx(builder, "ucose")
+		require.NoError(t, err)
+
+		sdkMsgs := make([]sdk.Msg, len(msgs))
+		for i, msg := range msgs {
+			sdkMsgs[i] = msg
+		}
+		require.NoError(t, builder.SetMsgs(sdkMsgs...))
+
+		bz, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	ethTxEvent := func(hash string, txIndex, gasUsed string) abci.Event {
+		return abci.Event{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+			{Key: "ethereumTxHash", Value: hash},
+			{Key: "txIndex", Value: txIndex},
+			{Key: "amount", Value: "0"},
+			{Key: "txGasUsed", Value: gasUsed},
+			{Key: "txHash", Value: "14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57"},
+		}}
+	}
+
+	msg0, msg1, msg2, msg3 := newEthTx(0), newEthTx(1), newEthTx(2), newEthTx(3)
+
+	// cosmos tx, ignored by the indexer
+	cosmosTxBuilder := clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, cosmosTxBuilder.SetMsgs(&banktypes.MsgSend{}))
+	cosmosTx, err := clientCtx.TxConfig.TxEncoder()(cosmosTxBuilder.GetTx())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		block       *cmttypes.Block
+		blockResult []*abci.ExecTxResult
+		expIndexed  map[common.Hash]struct {
+			ethTxIndex int32
+			msgIndex   uint32
+			gasUsed    uint64
+			failed     bool
+		}
+	}{
+		{
+			"success, single eth tx and a cosmos tx",
+			&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{cosmosTx, encodeEthTx(msg0)}}},
+			[]*abci.ExecTxResult{
+				{Code: 0},
+				{Code: 0, GasUsed: 21000, Events: []abci.Event{ethTxEvent(msg0.Hash, "0", "21000")}},
+			},
+			map[common.Hash]struct {
+				ethTxIndex int32
+				msgIndex   uint32
+				gasUsed    uint64
+				failed     bool
+			}{
+				common.HexToHash(msg0.Hash): {0, 0, 21000, false},
+			},
+		},
+		{
+			"success, multi-msg eth tx and a tx exceeding the block gas limit",
+			&cmttypes.Block{Header: cmttypes.Header{Height: 2}, Data: cmttypes.Data{Txs: []cmttypes.Tx{encodeEthTx(msg1, msg2), encodeEthTx(msg3)}}},
+			[]*abci.ExecTxResult{
+				{Code: 0, GasUsed: 42000, Events: []abci.Event{
+					ethTxEvent(msg1.Hash, "0", "21000"),
+					ethTxEvent(msg2.Hash, "1", "21000"),
+				}},
+				{Code: 11, Log: "out of gas in location: block gas meter; gasWanted: 100000"},
+			},
+			map[common.Hash]struct {
+				ethTxIndex int32
+				msgIndex   uint32
+				gasUsed    uint64
+				failed     bool
+			}{
+				common.HexToHash(msg1.Hash): {0, 0, 21000, false},
+				common.HexToHash(msg2.Hash): {1, 1, 21000, false},
+				common.HexToHash(msg3.Hash): {2, 0, 100000, true},
+			},
+		},
+		{
+			"fail, failed tx is not indexed",
+			&cmttypes.Block{Header: cmttypes.Header{Height: 3}, Data: cmttypes.Data{Txs: []cmttypes.Tx{encodeEthTx(newEthTx(4))}}},
+			[]*abci.ExecTxResult{
+				{Code: 15, Log: "nonce mismatch"},
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+			err := idxer.IndexBlock(tc.block, tc.blockResult)
+			require.NoError(t, err)
+
+			last, err := idxer.LastIndexedBlock()
+			require.NoError(t, err)
+			first, err := idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			if len(tc.expIndexed) == 0 {
+				require.Equal(t, int64(-1), last)
+				require.Equal(t, int64(-1), first)
+				return
+			}
+			require.Equal(t, tc.block.Height, last)
+			require.Equal(t, tc.block.Height, first)
+
+			for hash, exp := range tc.expIndexed {
+				res, err := idxer.GetByTxHash(hash)
+				require.NoError(t, err)
+				require.Equal(t, tc.block.Height, res.Height)
+				require.Equal(t, exp.ethTxIndex, res.EthTxIndex)
+				require.Equal(t, exp.msgIndex, res.MsgIndex)
+				require.Equal(t, exp.gasUsed, res.GasUsed)
+				require.Equal(t, exp.failed, res.Failed)
+
+				res2, err := idxer.GetByBlockAndIndex(tc.block.Height, exp.ethTxIndex)
+				require.NoError(t, err)
+				require.Equal(t, res, res2)
+			}
+
+			_, err = idxer.GetByBlockAndIndex(tc.block.Height, int32(len(tc.expIndexed)))
+			require.Error(t, err)
+		})
+	}
+}
+
+func newClientCtx(t *testing.T) client.Context {
+	signingOptions := signing.Options{
+		AddressCodec:          address.NewBech32Codec("cose"),
+		ValidatorAddressCodec: address.NewBech32Codec("cosevaloper"),
+	}
+	signingOptions.DefineCustomGetSigners(evmtypes.MsgEthereumTxCustomGetSigner.MsgType, evmtypes.MsgEthereumTxCustomGetSigner.Fn)
+
+	interfaceRegistry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles:     proto.HybridResolver,
+		SigningOptions: signingOptions,
+	})
+	require.NoError(t, err)
+	evmtypes.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	return client.Context{}.
+		WithCodec(cdc).
+		WithInterfaceRegistry(interfaceRegistry).
+		WithTxConfig(authtx.NewTxConfig(cdc, authtx.DefaultSignModes))
+}
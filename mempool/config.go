@@ -0,0 +1,69 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+const (
+	// DefaultPriceBump is the default minimum price bump percentage to replace
+	// an already existing transaction with the same nonce.
+	DefaultPriceBump uint64 = 10
+	// DefaultAccountSlots is the default number of executable transactions
+	// held per account.
+	DefaultAccountSlots uint64 = 16
+	// DefaultGlobalSlots is the default maximum number of executable
+	// transactions held across all accounts.
+	DefaultGlobalSlots uint64 = 5120
+	// DefaultAccountQueue is the default number of non-executable (future
+	// nonce) transactions held per account.
+	DefaultAccountQueue uint64 = 64
+	// DefaultGlobalQueue is the default maximum number of non-executable
+	// transactions held across all accounts.
+	DefaultGlobalQueue uint64 = 1024
+)
+
+// Config defines the limits of the EVM mempool.
+type Config struct {
+	// PriceBump is the minimum price bump percentage to replace an already
+	// existing transaction with the same nonce.
+	PriceBump uint64
+	// AccountSlots is the number of executable transactions held per account.
+	AccountSlots uint64
+	// GlobalSlots is the maximum number of executable transactions held
+	// across all accounts.
+	GlobalSlots uint64
+	// AccountQueue is the number of non-executable transactions held per account.
+	AccountQueue uint64
+	// GlobalQueue is the maximum number of non-executable transactions held
+	// across all accounts.
+	GlobalQueue uint64
+}
+
+// DefaultConfig returns the default EVM mempool configuration.
+func DefaultConfig() Config {
+	return Config{
+		PriceBump:    DefaultPriceBump,
+		AccountSlots: DefaultAccountSlots,
+		GlobalSlots:  DefaultGlobalSlots,
+		AccountQueue: DefaultAccountQueue,
+		GlobalQueue:  DefaultGlobalQueue,
+	}
+}
+
+// sanitize replaces the unset (zero) limits with their default values.
+func (c Config) sanitize() Config {
+	if c.PriceBump == 0 {
+		c.PriceBump = DefaultPriceBump
+	}
+	if c.AccountSlots == 0 {
+		c.AccountSlots = DefaultAccountSlots
+	}
+	if c.GlobalSlots == 0 {
+		c.GlobalSlots = DefaultGlobalSlots
+	}
+	if c.AccountQueue == 0 {
+		c.AccountQueue = DefaultAccountQueue
+	}
+	if c.GlobalQueue == 0 {
+		c.GlobalQueue = DefaultGlobalQueue
+	}
+	return c
+}
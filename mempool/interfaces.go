@@ -0,0 +1,17 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EVMKeeper defines the expected EVM keeper interface used by the mempool to
+// look up the committed nonces of the senders and the current base fee.
+type EVMKeeper interface {
+	GetNonce(ctx sdk.Context, addr common.Address) uint64
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
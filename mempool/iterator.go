@@ -0,0 +1,152 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"container/heap"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var _ sdkmempool.Iterator = (*iterator)(nil)
+
+// iterator merges the ordered Ethereum transactions of a selection with the
+// Cosmos transactions iterator, yielding first the transaction paying the
+// highest effective tip. Ties are resolved in favor of the Ethereum
+// transaction.
+type iterator struct {
+	evmTxs  []*evmTx
+	cosmos  sdkmempool.Iterator
+	baseFee *big.Int
+
+	tx sdk.Tx
+}
+
+// newIterator returns an iterator positioned on the first transaction to
+// select, or nil if there are no transactions.
+func newIterator(evmTxs []*evmTx, cosmos sdkmempool.Iterator, baseFee *big.Int) sdkmempool.Iterator {
+	it := &iterator{
+		evmTxs:  evmTxs,
+		cosmos:  cosmos,
+		baseFee: baseFee,
+	}
+	return it.advance()
+}
+
+// Next implements sdkmempool.Iterator.
+func (it *iterator) Next() sdkmempool.Iterator {
+	return it.advance()
+}
+
+// Tx implements sdkmempool.Iterator.
+func (it *iterator) Tx() sdk.Tx {
+	return it.tx
+}
+
+// advance moves the iterator to the next transaction to select and returns
+// nil once both sources are exhausted.
+func (it *iterator) advance() sdkmempool.Iterator {
+	switch {
+	case len(it.evmTxs) == 0 && it.cosmos == nil:
+		return nil
+	case it.cosmos == nil:
+		it.nextEVMTx()
+	case len(it.evmTxs) == 0:
+		it.nextCosmosTx()
+	default:
+		cosmosTip := cosmosEffectiveTip(it.cosmos.Tx(), it.baseFee)
+		if it.evmTxs[0].effectiveTip(it.baseFee).Cmp(cosmosTip) >= 0 {
+			it.nextEVMTx()
+		} else {
+			it.nextCosmosTx()
+		}
+	}
+	return it
+}
+
+func (it *iterator) nextEVMTx() {
+	it.evmTxs[0].clearSenders()
+	it.tx = it.evmTxs[0].tx
+	it.evmTxs = it.evmTxs[1:]
+}
+
+func (it *iterator) nextCosmosTx() {
+	it.tx = it.cosmos.Tx()
+	it.cosmos = it.cosmos.Next()
+}
+
+// cosmosEffectiveTip returns the tip per unit of gas paid by a Cosmos
+// transaction in the EVM denomination, scaled to 18 decimals, on top of the
+// base fee.
+func cosmosEffectiveTip(tx sdk.Tx, baseFee *big.Int) *big.Int {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return big.NewInt(0)
+	}
+
+	fee := evmtypes.ConvertAmountTo18DecimalsBigInt(feeTx.GetFee().AmountOf(evmtypes.GetEVMCoinDenom()).BigInt())
+	gasPrice := fee.Div(fee, new(big.Int).SetUint64(feeTx.GetGas()))
+	if baseFee == nil {
+		return gasPrice
+	}
+	return gasPrice.Sub(gasPrice, baseFee)
+}
+
+// orderByTip flattens the executable sequences of the senders into a single
+// list ordered by effective tip, preserving the nonce order of every sender.
+// Transactions paying the same tip are ordered by arrival.
+func orderByTip(sequences [][]*evmTx, baseFee *big.Int) []*evmTx {
+	var total int
+	h := &headsHeap{baseFee: baseFee}
+	for _, seq := range sequences {
+		total += len(seq)
+		h.heads = append(h.heads, seq)
+	}
+	heap.Init(h)
+
+	ordered := make([]*evmTx, 0, total)
+	for h.Len() > 0 {
+		seq := h.heads[0]
+		ordered = append(ordered, seq[0])
+		if len(seq) > 1 {
+			h.heads[0] = seq[1:]
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return ordered
+}
+
+// headsHeap is a max-heap of the remaining sequences of every sender, keyed by
+// the effective tip of their first transaction.
+type headsHeap struct {
+	heads   [][]*evmTx
+	baseFee *big.Int
+}
+
+func (h headsHeap) Len() int { return len(h.heads) }
+
+func (h headsHeap) Less(i, j int) bool {
+	a, b := h.heads[i][0], h.heads[j][0]
+	if cmp := a.effectiveTip(h.baseFee).Cmp(b.effectiveTip(h.baseFee)); cmp != 0 {
+		return cmp > 0
+	}
+	return a.seq < b.seq
+}
+
+func (h headsHeap) Swap(i, j int) { h.heads[i], h.heads[j] = h.heads[j], h.heads[i] }
+
+func (h *headsHeap) Push(x any) { h.heads = append(h.heads, x.([]*evmTx)) }
+
+func (h *headsHeap) Pop() any {
+	old := h.heads
+	n := len(old)
+	x := old[n-1]
+	h.heads = old[:n-1]
+	return x
+}
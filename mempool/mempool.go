@@ -0,0 +1,394 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var _ sdkmempool.Mempool = (*EVMMempool)(nil)
+
+// EVMMempool is an application-side mempool that is aware of Ethereum
+// transactions. The Ethereum transactions are keyed by (sender, nonce):
+//   - transactions whose nonce follows the committed nonce of the sender
+//     without gaps are executable, the ones after a nonce gap are queued and
+//     promoted as soon as the gap is filled;
+//   - executable transactions are selected by effective tip, using the base
+//     fee of the x/feemarket module, while preserving the nonce order of
+//     every sender;
+//   - a transaction with the same nonce as an existing one replaces it only if
+//     it bumps both fee caps by at least Config.PriceBump percent;
+//   - the per-account and global limits of Config are enforced on insertion,
+//     evicting the cheapest transactions at the end of a sender's nonce
+//     sequence when a global limit is exceeded.
+//
+// Cosmos transactions are delegated to the SDK priority nonce mempool and
+// merged with the Ethereum transactions by effective tip on selection.
+type EVMMempool struct {
+	mtx sync.RWMutex
+
+	cfg       Config
+	evmKeeper EVMKeeper
+
+	// cosmosPool holds the Cosmos (non-Ethereum) transactions
+	cosmosPool sdkmempool.Mempool
+
+	accounts map[common.Address]*account
+	evmCount int
+	// seq is the insertion sequence, it breaks the ties between transactions
+	// paying the same tip in favor of the oldest one.
+	seq uint64
+}
+
+// account holds the Ethereum transactions of a single sender.
+type account struct {
+	// nonce is the latest known committed nonce of the sender
+	nonce uint64
+	txs   map[uint64]*evmTx
+}
+
+// NewEVMMempool creates a new EVM mempool. Unset limits of the given config
+// are replaced by their default values.
+func NewEVMMempool(cfg Config, evmKeeper EVMKeeper) *EVMMempool {
+	return &EVMMempool{
+		cfg:        cfg.sanitize(),
+		evmKeeper:  evmKeeper,
+		cosmosPool: sdkmempool.NewPriorityMempool(sdkmempool.DefaultPriorityNonceMempoolConfig()),
+		accounts:   make(map[common.Address]*account),
+	}
+}
+
+// Insert adds a transaction to the mempool. Ethereum transactions with a nonce
+// lower than the committed nonce of their sender are rejected.
+func (mp *EVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	etx, err := newEVMTx(tx)
+	if err != nil {
+		return err
+	}
+	if etx == nil {
+		return mp.cosmosPool.Insert(goCtx, tx)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	nonce := mp.evmKeeper.GetNonce(ctx, etx.sender)
+	if acc, found := mp.accounts[etx.sender]; found {
+		acc.nonce = nonce
+		mp.pruneStale(acc)
+	}
+
+	if etx.nonce < nonce {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"nonce too low; got %d, expected at least %d", etx.nonce, nonce,
+		)
+	}
+
+	acc := mp.getOrCreateAccount(etx.sender)
+	acc.nonce = nonce
+
+	mp.seq++
+	etx.seq = mp.seq
+
+	if existing, found := acc.txs[etx.nonce]; found {
+		if existing.hash == etx.hash {
+			return nil
+		}
+		if !etx.replaces(existing, mp.cfg.PriceBump) {
+			return mp.errUnderpriced()
+		}
+		acc.txs[etx.nonce] = etx
+		return nil
+	}
+
+	acc.txs[etx.nonce] = etx
+	mp.evmCount++
+
+	if err := mp.enforceLimits(acc, etx, mp.evmKeeper.GetBaseFee(ctx)); err != nil {
+		mp.removeEVMTx(acc, etx)
+		return err
+	}
+
+	return nil
+}
+
+// CheckReplacement returns an error if the sender has a pending transaction
+// with the given nonce and a different hash, whose fee caps aren't bumped by
+// at least Config.PriceBump percent by the given ones. It lets the ante
+// handler reject an underpriced replacement during CheckTx before refunding
+// the fees of the pending transaction, since the changes of the ante handler
+// are written to the check state before the transaction is inserted.
+func (mp *EVMMempool) CheckReplacement(sender common.Address, nonce uint64, hash common.Hash, gasFeeCap, gasTipCap *big.Int) error {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	acc, found := mp.accounts[sender]
+	if !found {
+		return nil
+	}
+
+	existing, found := acc.txs[nonce]
+	if !found || existing.hash == hash {
+		return nil
+	}
+
+	etx := &evmTx{gasFeeCap: gasFeeCap, gasTipCap: gasTipCap}
+	if !etx.replaces(existing, mp.cfg.PriceBump) {
+		return mp.errUnderpriced()
+	}
+	return nil
+}
+
+// MaxNonceGap returns the maximum difference between the nonce of a
+// transaction and the committed nonce of its sender that can become
+// executable. A transaction further ahead couldn't be held together with the
+// ones filling the gap, given the per-account limits.
+func (mp *EVMMempool) MaxNonceGap() uint64 {
+	return mp.cfg.AccountSlots + mp.cfg.AccountQueue - 1
+}
+
+// Select returns an iterator over the executable Ethereum transactions,
+// ordered by effective tip while preserving the nonce order of every sender,
+// merged with the Cosmos transactions of the mempool.
+//
+// NOTE: the Ethereum transactions are snapshotted when Select is called, so
+// removing transactions during the iteration is safe.
+func (mp *EVMMempool) Select(goCtx context.Context, txs [][]byte) sdkmempool.Iterator {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	baseFee := mp.evmKeeper.GetBaseFee(ctx)
+
+	mp.mtx.Lock()
+	sequences := make([][]*evmTx, 0, len(mp.accounts))
+	for addr, acc := range mp.accounts {
+		acc.nonce = mp.evmKeeper.GetNonce(ctx, addr)
+		mp.pruneStale(acc)
+		if seq := acc.executable(baseFee); len(seq) > 0 {
+			sequences = append(sequences, seq)
+		}
+	}
+	mp.mtx.Unlock()
+
+	return newIterator(orderByTip(sequences, baseFee), mp.cosmosPool.Select(goCtx, txs), baseFee)
+}
+
+// CountTx returns the total number of transactions in the mempool.
+func (mp *EVMMempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.evmCount + mp.cosmosPool.CountTx()
+}
+
+// Remove removes a transaction from the mempool. An Ethereum transaction is
+// only removed if it is the one stored for its (sender, nonce) key, so that a
+// replaced transaction failing recheck doesn't evict its replacement.
+func (mp *EVMMempool) Remove(tx sdk.Tx) error {
+	etx, err := newEVMTx(tx)
+	if err != nil {
+		return err
+	}
+	if etx == nil {
+		return mp.cosmosPool.Remove(tx)
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	acc, found := mp.accounts[etx.sender]
+	if !found {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	existing, found := acc.txs[etx.nonce]
+	if !found || existing.hash != etx.hash {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	mp.removeEVMTx(acc, existing)
+	return nil
+}
+
+// Stats returns the number of executable and queued Ethereum transactions,
+// based on the latest known committed nonces of the senders.
+func (mp *EVMMempool) Stats() (executable, queued int) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	for _, acc := range mp.accounts {
+		n := len(acc.executable(nil))
+		executable += n
+		queued += len(acc.txs) - n
+	}
+	return executable, queued
+}
+
+// enforceLimits checks the per-account and global limits after the insertion
+// of etx by the given account. Per-account limits reject the new transaction,
+// global limits evict the cheapest transaction at the end of a sender's nonce
+// sequence, unless that is the new transaction itself.
+func (mp *EVMMempool) enforceLimits(acc *account, etx *evmTx, baseFee *big.Int) error {
+	accExecutable := uint64(len(acc.executable(nil)))
+	accQueued := uint64(len(acc.txs)) - accExecutable
+
+	if accExecutable > mp.cfg.AccountSlots {
+		return errorsmod.Wrapf(
+			errortypes.ErrMempoolIsFull,
+			"account %s exceeds the limit of %d executable transactions", etx.sender, mp.cfg.AccountSlots,
+		)
+	}
+	if accQueued > mp.cfg.AccountQueue {
+		return errorsmod.Wrapf(
+			errortypes.ErrMempoolIsFull,
+			"account %s exceeds the limit of %d queued transactions", etx.sender, mp.cfg.AccountQueue,
+		)
+	}
+
+	for {
+		var executable, queued uint64
+		for _, a := range mp.accounts {
+			n := uint64(len(a.executable(nil)))
+			executable += n
+			queued += uint64(len(a.txs)) - n
+		}
+
+		var evictQueued bool
+		switch {
+		case queued > mp.cfg.GlobalQueue:
+			evictQueued = true
+		case executable > mp.cfg.GlobalSlots:
+			evictQueued = false
+		default:
+			return nil
+		}
+
+		victimAcc, victim := mp.cheapestTail(evictQueued, baseFee)
+		if victim == nil || victim == etx {
+			return errorsmod.Wrapf(
+				errortypes.ErrMempoolIsFull,
+				"mempool is full and the transaction doesn't pay a higher tip than the cheapest one",
+			)
+		}
+		mp.removeEVMTx(victimAcc, victim)
+	}
+}
+
+// cheapestTail returns the transaction paying the lowest effective tip among
+// the last (highest nonce) transactions of every sender. If queued is true,
+// only senders whose last transaction is queued are considered, otherwise
+// only the senders whose last transaction is executable.
+func (mp *EVMMempool) cheapestTail(queued bool, baseFee *big.Int) (*account, *evmTx) {
+	var (
+		victimAcc *account
+		victim    *evmTx
+		victimTip *big.Int
+	)
+
+	for _, acc := range mp.accounts {
+		tail := acc.tail()
+		if tail == nil {
+			continue
+		}
+
+		isQueued := len(acc.executable(nil)) < len(acc.txs)
+		if isQueued != queued {
+			continue
+		}
+
+		tip := tail.effectiveTip(baseFee)
+		if victim == nil || tip.Cmp(victimTip) < 0 || (tip.Cmp(victimTip) == 0 && tail.seq > victim.seq) {
+			victimAcc, victim, victimTip = acc, tail, tip
+		}
+	}
+
+	return victimAcc, victim
+}
+
+// getOrCreateAccount returns the account of the given sender, creating it if
+// it doesn't exist yet.
+func (mp *EVMMempool) getOrCreateAccount(sender common.Address) *account {
+	acc, found := mp.accounts[sender]
+	if !found {
+		acc = &account{txs: make(map[uint64]*evmTx)}
+		mp.accounts[sender] = acc
+	}
+	return acc
+}
+
+// pruneStale removes the transactions of the account whose nonce is lower
+// than the committed one, dropping the account if none is left.
+func (mp *EVMMempool) pruneStale(acc *account) {
+	for nonce, etx := range acc.txs {
+		if nonce < acc.nonce {
+			mp.removeEVMTx(acc, etx)
+		}
+	}
+}
+
+// removeEVMTx removes the given Ethereum transaction of the account and drops
+// the account once it is empty.
+func (mp *EVMMempool) removeEVMTx(acc *account, etx *evmTx) {
+	delete(acc.txs, etx.nonce)
+	mp.evmCount--
+
+	if len(acc.txs) == 0 {
+		delete(mp.accounts, etx.sender)
+	}
+}
+
+// executable returns the transactions of the account that can be executed in
+// order on top of its committed nonce. If a base fee is given, the sequence
+// also stops at the first transaction whose fee cap doesn't cover it.
+func (acc *account) executable(baseFee *big.Int) []*evmTx {
+	var seq []*evmTx
+
+	nonce := acc.nonce
+	for {
+		etx, found := acc.txs[nonce]
+		if !found {
+			return seq
+		}
+		if baseFee != nil && etx.gasFeeCap.Cmp(baseFee) < 0 {
+			return seq
+		}
+		seq = append(seq, etx)
+		nonce = etx.nextNonce
+	}
+}
+
+// tail returns the transaction of the account with the highest nonce.
+func (acc *account) tail() *evmTx {
+	var tail *evmTx
+	for _, etx := range acc.txs {
+		if tail == nil || etx.nonce > tail.nonce {
+			tail = etx
+		}
+	}
+	return tail
+}
+
+// chainID returns the EIP-155 chain ID used to recover the transaction senders.
+func chainID() *big.Int {
+	return evmtypes.GetEthChainConfig().ChainID
+}
+
+// errUnderpriced returns the error of a replacement transaction that doesn't
+// bump the fee caps of the existing one enough.
+func (mp *EVMMempool) errUnderpriced() error {
+	return errorsmod.Wrapf(
+		errortypes.ErrInsufficientFee,
+		"replacement transaction underpriced; fee caps must be bumped by at least %d%%", mp.cfg.PriceBump,
+	)
+}
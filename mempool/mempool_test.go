@@ -0,0 +1,198 @@
+package mempool_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	"github.com/green901612/cosevm/mempool"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+type mockEVMKeeper struct {
+	nonces  map[common.Address]uint64
+	baseFee *big.Int
+}
+
+func (k *mockEVMKeeper) GetNonce(_ sdk.Context, addr common.Address) uint64 {
+	return k.nonces[addr]
+}
+
+func (k *mockEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int {
+	return k.baseFee
+}
+
+type sender struct {
+	priv *ecdsa.PrivateKey
+	addr common.Address
+}
+
+func newSender(t *testing.T) sender {
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return sender{priv: priv, addr: crypto.PubkeyToAddress(priv.PublicKey)}
+}
+
+// newTx returns a signed dynamic fee transaction paying the given fee cap and
+// tip cap.
+func newTx(t *testing.T, s sender, nonce uint64, feeCap, tipCap int64) *evmtypes.MsgEthereumTx {
+	to := common.BigToAddress(big.NewInt(1))
+	tx, err := ethtypes.SignNewTx(s.priv, ethtypes.LatestSignerForChainID(big.NewInt(2931)), &ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(2931),
+		Nonce:     nonce,
+		To:        &to,
+		Gas:       21000,
+		GasFeeCap: big.NewInt(feeCap),
+		GasTipCap: big.NewInt(tipCap),
+	})
+	require.NoError(t, err)
+
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(tx))
+	return msg
+}
+
+func setup(t *testing.T, cfg mempool.Config) (*mempool.EVMMempool, *mockEVMKeeper, context.Context) {
+	require.NoError(t, app.EvmAppOptions("torram_2931-1"))
+
+	keeper := &mockEVMKeeper{nonces: make(map[common.Address]uint64), baseFee: big.NewInt(10)}
+	ctx := sdk.Context{}.WithContext(context.Background())
+	return mempool.NewEVMMempool(cfg, keeper), keeper, ctx
+}
+
+func selectAll(ctx context.Context, mp *mempool.EVMMempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestQueuedPromotion(t *testing.T) {
+	mp, keeper, ctx := setup(t, mempool.DefaultConfig())
+	alice := newSender(t)
+
+	tx1, tx2 := newTx(t, alice, 1, 100, 5), newTx(t, alice, 2, 100, 5)
+	require.NoError(t, mp.Insert(ctx, tx2))
+	require.NoError(t, mp.Insert(ctx, tx1))
+
+	executable, queued := mp.Stats()
+	require.Equal(t, 0, executable)
+	require.Equal(t, 2, queued)
+	require.Empty(t, selectAll(ctx, mp))
+
+	tx0 := newTx(t, alice, 0, 100, 5)
+	require.NoError(t, mp.Insert(ctx, tx0))
+
+	executable, queued = mp.Stats()
+	require.Equal(t, 3, executable)
+	require.Equal(t, 0, queued)
+	require.Equal(t, []sdk.Tx{tx0, tx1, tx2}, selectAll(ctx, mp))
+
+	// once tx0 is committed, a tx with the same nonce is rejected and the
+	// stale one is pruned
+	keeper.nonces[alice.addr] = 1
+	err := mp.Insert(ctx, newTx(t, alice, 0, 200, 10))
+	require.ErrorIs(t, err, errortypes.ErrInvalidSequence)
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []sdk.Tx{tx1, tx2}, selectAll(ctx, mp))
+}
+
+func TestReplacement(t *testing.T) {
+	mp, _, ctx := setup(t, mempool.DefaultConfig())
+	alice := newSender(t)
+
+	tx := newTx(t, alice, 0, 100, 10)
+	require.NoError(t, mp.Insert(ctx, tx))
+	// re-inserting the same tx is a no-op
+	require.NoError(t, mp.Insert(ctx, tx))
+
+	// fee cap bumped by 10% but tip cap only by 9%
+	err := mp.Insert(ctx, newTx(t, alice, 0, 110, 10))
+	require.ErrorIs(t, err, errortypes.ErrInsufficientFee)
+	require.Equal(t, []sdk.Tx{tx}, selectAll(ctx, mp))
+
+	replacement := newTx(t, alice, 0, 110, 11)
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []sdk.Tx{replacement}, selectAll(ctx, mp))
+
+	// the replaced tx can't remove its replacement
+	require.ErrorIs(t, mp.Remove(tx), sdkmempool.ErrTxNotFound)
+	require.NoError(t, mp.Remove(replacement))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestSelectOrder(t *testing.T) {
+	mp, _, ctx := setup(t, mempool.DefaultConfig())
+	alice, bob, carol := newSender(t), newSender(t), newSender(t)
+
+	// effective tip: min(tip cap, fee cap - base fee)
+	alice0 := newTx(t, alice, 0, 100, 1)  // 1
+	alice1 := newTx(t, alice, 1, 100, 50) // 50
+	bob0 := newTx(t, bob, 0, 15, 10)      // 5
+	carol0 := newTx(t, carol, 0, 5, 5)    // fee cap below the base fee
+
+	for _, tx := range []sdk.Tx{alice1, alice0, bob0, carol0} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	require.Equal(t, []sdk.Tx{bob0, alice0, alice1}, selectAll(ctx, mp))
+}
+
+func TestLimits(t *testing.T) {
+	mp, _, ctx := setup(t, mempool.Config{AccountSlots: 2, GlobalSlots: 3, AccountQueue: 1, GlobalQueue: 1})
+	alice, bob := newSender(t), newSender(t)
+
+	require.NoError(t, mp.Insert(ctx, newTx(t, alice, 0, 100, 5)))
+	require.NoError(t, mp.Insert(ctx, newTx(t, alice, 1, 100, 5)))
+
+	// per-account limits reject the new tx
+	err := mp.Insert(ctx, newTx(t, alice, 2, 100, 5))
+	require.ErrorIs(t, err, errortypes.ErrMempoolIsFull)
+	require.NoError(t, mp.Insert(ctx, newTx(t, alice, 3, 100, 5)))
+	err = mp.Insert(ctx, newTx(t, alice, 4, 100, 5))
+	require.ErrorIs(t, err, errortypes.ErrMempoolIsFull)
+
+	// the global queue is full and the new tx is the cheapest one
+	err = mp.Insert(ctx, newTx(t, bob, 5, 100, 1))
+	require.ErrorIs(t, err, errortypes.ErrMempoolIsFull)
+
+	// the global queue is full, the cheapest queued tx is evicted
+	bob5 := newTx(t, bob, 5, 100, 10)
+	require.NoError(t, mp.Insert(ctx, bob5))
+	executable, queued := mp.Stats()
+	require.Equal(t, 2, executable)
+	require.Equal(t, 1, queued)
+
+	// the global slots are full, the cheapest executable tail is evicted
+	bob0 := newTx(t, bob, 0, 100, 10)
+	require.NoError(t, mp.Insert(ctx, bob0))
+	bob1 := newTx(t, bob, 1, 100, 10)
+	require.NoError(t, mp.Insert(ctx, bob1))
+	executable, queued = mp.Stats()
+	require.Equal(t, 3, executable)
+	require.Equal(t, 1, queued)
+
+	txs := selectAll(ctx, mp)
+	require.Len(t, txs, 3)
+	require.Equal(t, []sdk.Tx{bob0, bob1}, txs[:2])
+}
+
+func TestMaxNonceGap(t *testing.T) {
+	mp, _, _ := setup(t, mempool.DefaultConfig())
+	require.Equal(t, mempool.DefaultAccountSlots+mempool.DefaultAccountQueue-1, mp.MaxNonceGap())
+
+	mp, _, _ = setup(t, mempool.Config{AccountSlots: 2, AccountQueue: 1})
+	require.Equal(t, uint64(2), mp.MaxNonceGap())
+}
@@ -0,0 +1,126 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// evmTx wraps an Ethereum transaction held by the mempool, together with the
+// values used to key and order it.
+type evmTx struct {
+	tx     sdk.Tx
+	hash   common.Hash
+	sender common.Address
+	// nonce is the lowest nonce of the wrapped messages
+	nonce uint64
+	// nextNonce is the nonce expected by the sender after the execution of
+	// the transaction
+	nextNonce uint64
+	// gasFeeCap and gasTipCap are the lowest caps of the wrapped messages,
+	// both equal to the gas price for legacy and access list transactions
+	gasFeeCap *big.Int
+	gasTipCap *big.Int
+	seq       uint64
+}
+
+// newEVMTx returns the mempool entry of the given transaction, or nil if it is
+// not an Ethereum transaction. All the messages of an Ethereum transaction must
+// be signed by the same sender and have consecutive nonces.
+func newEVMTx(tx sdk.Tx) (*evmTx, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+	if _, ok := msgs[0].(*evmtypes.MsgEthereumTx); !ok {
+		return nil, nil
+	}
+
+	etx := &evmTx{tx: tx}
+	for i, msg := range msgs {
+		ethMsg, txData, err := evmtypes.UnpackEthMsg(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		sender, err := ethMsg.RecoverSender(chainID())
+		if err != nil {
+			return nil, errorsmod.Wrap(errortypes.ErrorInvalidSigner, err.Error())
+		}
+
+		nonce := txData.GetNonce()
+		if i == 0 {
+			etx.hash = ethMsg.TxHash()
+			etx.sender = sender
+			etx.nonce = nonce
+			etx.gasFeeCap = txData.GetGasFeeCap()
+			etx.gasTipCap = txData.GetGasTipCap()
+		} else {
+			if sender != etx.sender {
+				return nil, errorsmod.Wrapf(
+					errortypes.ErrInvalidRequest,
+					"ethereum messages of the same transaction must have the same sender; got %s and %s", etx.sender, sender,
+				)
+			}
+			if nonce != etx.nextNonce {
+				return nil, errorsmod.Wrapf(
+					errortypes.ErrInvalidSequence,
+					"ethereum messages of the same transaction must have consecutive nonces; got %d, expected %d", nonce, etx.nextNonce,
+				)
+			}
+			etx.gasFeeCap = minBig(etx.gasFeeCap, txData.GetGasFeeCap())
+			etx.gasTipCap = minBig(etx.gasTipCap, txData.GetGasTipCap())
+		}
+
+		etx.nextNonce = nonce + 1
+	}
+
+	return etx, nil
+}
+
+// clearSenders clears the sender cached on the messages by the ante handler,
+// so that the selected transaction can be re-encoded and validated again when
+// preparing a proposal.
+func (etx *evmTx) clearSenders() {
+	for _, msg := range etx.tx.GetMsgs() {
+		if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			ethMsg.From = ""
+		}
+	}
+}
+
+// effectiveTip returns the tip paid per unit of gas to the block proposer, i.e.
+// min(gasTipCap, gasFeeCap - baseFee), or the tip cap if there is no base fee.
+func (etx *evmTx) effectiveTip(baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return etx.gasTipCap
+	}
+	return minBig(etx.gasTipCap, new(big.Int).Sub(etx.gasFeeCap, baseFee))
+}
+
+// replaces returns true if the transaction bumps both the fee cap and the tip
+// cap of the existing one by at least priceBump percent.
+func (etx *evmTx) replaces(existing *evmTx, priceBump uint64) bool {
+	return etx.gasFeeCap.Cmp(bumpPrice(existing.gasFeeCap, priceBump)) >= 0 &&
+		etx.gasTipCap.Cmp(bumpPrice(existing.gasTipCap, priceBump)) >= 0
+}
+
+// bumpPrice returns price * (100 + priceBump) / 100.
+func bumpPrice(price *big.Int, priceBump uint64) *big.Int {
+	bumped := new(big.Int).Mul(price, new(big.Int).SetUint64(100+priceBump))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+func minBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) <= 0 {
+		return a
+	}
+	return b
+}
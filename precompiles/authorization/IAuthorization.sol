@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @author Evmos Team
+/// @title Authorization Interface
+/// @dev The interface through which solidity contracts allow other accounts
+/// to execute the methods of a precompile on their behalf. The approvals are
+/// stored as grants of the Cosmos SDK x/authz module.
+interface IAuthorization {
+    /// @dev Approves a list of methods of the precompile to be executed by
+    /// the spender on behalf of the caller, up to the given amount.
+    /// @param spender The address which will spend the funds.
+    /// @param amount The amount of tokens that can be spent. Using the
+    /// maximum uint256 value grants an unlimited allowance, while zero
+    /// removes the existing grants.
+    /// @param methods The methods to approve.
+    /// @return approved Boolean value to indicate if the approval was successful.
+    function approve(
+        address spender,
+        uint256 amount,
+        string[] calldata methods
+    ) external returns (bool approved);
+
+    /// @dev Revokes a list of methods previously approved to the spender.
+    /// @param spender The address which was allowed to execute the methods.
+    /// @param methods The methods to revoke.
+    /// @return revoked Boolean value to indicate if the revocation was successful.
+    function revoke(
+        address spender,
+        string[] calldata methods
+    ) external returns (bool revoked);
+
+    /// @dev Returns the remaining number of tokens that the grantee will be
+    /// allowed to spend on behalf of the granter through the given method.
+    /// @param grantee The address of the account able to transfer the tokens.
+    /// @param granter The address of the account owning the tokens.
+    /// @param method The method for which the allowance is queried.
+    /// @return remaining The remaining number of tokens available to be spent.
+    function allowance(
+        address grantee,
+        address granter,
+        string calldata method
+    ) external view returns (uint256 remaining);
+
+    /// @dev This event is emitted when the allowance of a spender is set by a
+    /// call to the approve method. The value field specifies the amount of
+    /// tokens approved to be spent.
+    /// @param owner The owner of the tokens.
+    /// @param spender The address which will spend the funds.
+    /// @param methods The methods for which the approval is set.
+    /// @param value The amount of tokens approved to be spent.
+    event Approval(
+        address indexed owner,
+        address indexed spender,
+        string[] methods,
+        uint256 value
+    );
+
+    /// @dev This event is emitted when an owner revokes a spender's allowance.
+    /// @param owner The owner of the tokens.
+    /// @param spender The address which was allowed to spend the funds.
+    /// @param methods The methods for which the approval is revoked.
+    event Revocation(
+        address indexed owner,
+        address indexed spender,
+        string[] methods
+    );
+}
@@ -0,0 +1,47 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package authorization
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AcceptGrant checks that the granter has authorized the grantee to execute
+// the given message on its behalf, and updates or deletes the grant
+// according to the response of the authorization.
+func AcceptGrant(
+	ctx sdk.Context,
+	authzKeeper authzkeeper.Keeper,
+	grantee, granter common.Address,
+	msg sdk.Msg,
+) error {
+	msgURL := sdk.MsgTypeURL(msg)
+
+	authz, expiration := authzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), msgURL)
+	if authz == nil {
+		return fmt.Errorf(ErrAuthzDoesNotExistOrExpired, msgURL, grantee)
+	}
+
+	resp, err := authz.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return fmt.Errorf(ErrAuthzNotAccepted, msgURL, grantee)
+	}
+
+	if resp.Delete {
+		return authzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), msgURL)
+	}
+
+	if resp.Updated != nil {
+		return authzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), resp.Updated, expiration)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package authorization
+
+const (
+	// ErrAuthzDoesNotExistOrExpired is raised when the authorization does not exist.
+	ErrAuthzDoesNotExistOrExpired = "authorization to %s for address %s does not exist or is expired"
+	// ErrAuthzNotAccepted is raised when the authorization is not accepted.
+	ErrAuthzNotAccepted = "authorization to %s for address %s is not accepted"
+	// ErrEmptyMethods is raised when the given methods array is empty.
+	ErrEmptyMethods = "no methods defined; expected at least one method"
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrGranteeIsGranter is raised when an account tries to grant itself.
+	ErrGranteeIsGranter = "grantee and granter cannot be the same address: %s"
+)
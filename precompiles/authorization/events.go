@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package authorization
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+// EmitApprovalEvent creates a new approval event emitted on an Approve transaction.
+func EmitApprovalEvent(
+	event abi.Event,
+	stateDB vm.StateDB,
+	precompileAddr, granter, grantee common.Address,
+	methods []string,
+	value *big.Int,
+	height uint64,
+) error {
+	log, err := cmn.NewLog(event, precompileAddr, height, []interface{}{granter, grantee}, methods, value)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(log)
+	return nil
+}
+
+// EmitRevocationEvent creates a new revocation event emitted on a Revoke transaction.
+func EmitRevocationEvent(
+	event abi.Event,
+	stateDB vm.StateDB,
+	precompileAddr, granter, grantee common.Address,
+	methods []string,
+	height uint64,
+) error {
+	log, err := cmn.NewLog(event, precompileAddr, height, []interface{}{granter, grantee}, methods)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(log)
+	return nil
+}
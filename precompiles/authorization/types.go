@@ -0,0 +1,108 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package authorization
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+)
+
+const (
+	// ApproveMethod defines the ABI method name for the authorization Approve transaction.
+	ApproveMethod = "approve"
+	// RevokeMethod defines the ABI method name for the authorization Revoke transaction.
+	RevokeMethod = "revoke"
+	// AllowanceMethod defines the ABI method name for the Allowance query.
+	AllowanceMethod = "allowance"
+
+	// EventTypeApproval defines the event type for the authorization Approve transaction.
+	EventTypeApproval = "Approval"
+	// EventTypeRevocation defines the event type for the authorization Revoke transaction.
+	EventTypeRevocation = "Revocation"
+)
+
+// DefaultExpirationDuration defines the default duration of the grants
+// created by the approve methods of the precompiles.
+const DefaultExpirationDuration = time.Hour * 24 * 365
+
+// ParseApproveArgs parses the approval arguments and returns the grantee
+// (spender), the amount and the approved methods.
+func ParseApproveArgs(args []interface{}) (common.Address, *big.Int, []string, error) {
+	if len(args) != 3 {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, nil, nil, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	amount, ok := args[1].(*big.Int)
+	if !ok || amount == nil {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "amount", &big.Int{}, args[1])
+	}
+
+	methods, ok := args[2].([]string)
+	if !ok {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "methods", []string{}, args[2])
+	}
+	if len(methods) == 0 {
+		return common.Address{}, nil, nil, errors.New(ErrEmptyMethods)
+	}
+
+	return grantee, amount, methods, nil
+}
+
+// ParseRevokeArgs parses the revocation arguments and returns the grantee
+// and the revoked methods.
+func ParseRevokeArgs(args []interface{}) (common.Address, []string, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	methods, ok := args[1].([]string)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "methods", []string{}, args[1])
+	}
+	if len(methods) == 0 {
+		return common.Address{}, nil, errors.New(ErrEmptyMethods)
+	}
+
+	return grantee, methods, nil
+}
+
+// ParseAllowanceArgs parses the allowance arguments and returns the grantee,
+// the granter and the method.
+func ParseAllowanceArgs(args []interface{}) (common.Address, common.Address, string, error) {
+	if len(args) != 3 {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	granter, ok := args[1].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(ErrInvalidGranter, args[1])
+	}
+
+	method, ok := args[2].(string)
+	if !ok {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(cmn.ErrInvalidType, "method", "", args[2])
+	}
+
+	return grantee, granter, method, nil
+}
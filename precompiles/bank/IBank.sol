@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IBank contract's address.
+address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
+
+/// @dev The IBank contract's instance.
+IBank constant IBANK_CONTRACT = IBank(IBANK_PRECOMPILE_ADDRESS);
+
+/// @dev Balance specifies the token address and the amount held for it. The
+/// token address of the EVM coin is the WERC20 precompile, the one of a native
+/// coin registered in x/erc20 its dynamic ERC-20 precompile and the one of an
+/// ERC-20 token converted to a bank coin its contract. The denominations
+/// without an ERC-20 token pair are not returned.
+struct Balance {
+    address contractAddress;
+    uint256 amount;
+}
+
+/// @author Evmos Team
+/// @title Bank Precompiled Contract
+/// @dev The interface through which solidity contracts can read the native
+/// balances and supplies of the Cosmos SDK x/bank module. The amounts of the
+/// EVM coin are returned with 18 decimals.
+/// @custom:address 0x0000000000000000000000000000000000000804
+interface IBank {
+    /// @dev balances defines a method for retrieving all the native token balances
+    /// for a given account.
+    /// @param account the address of the account to query balances for.
+    /// @return balances the array of native token balances.
+    function balances(
+        address account
+    ) external view returns (Balance[] memory balances);
+
+    /// @dev totalSupply defines a method for retrieving the total supply of all
+    /// native tokens.
+    /// @return totalSupply the supply as an array of native token balances
+    function totalSupply() external view returns (Balance[] memory totalSupply);
+
+    /// @dev supplyOf defines a method for retrieving the total supply of a particular native coin.
+    /// @param erc20Address the address of the token
+    /// @return totalSupply the supply as a uint256
+    function supplyOf(
+        address erc20Address
+    ) external view returns (uint256 totalSupply);
+}
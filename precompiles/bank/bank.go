@@ -0,0 +1,140 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bank
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	erc20types "github.com/green901612/cosevm/x/erc20/types"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+	"github.com/green901612/cosevm/x/evm/wrappers"
+)
+
+const (
+	// GasBalances defines the gas cost for a single ERC-20 balanceOf query
+	GasBalances = 2_851
+
+	// GasTotalSupply defines the gas cost for a single ERC-20 totalSupply query
+	GasTotalSupply = 2_477
+
+	// GasSupplyOf defines the gas cost for a single ERC-20 supplyOf query, taken from totalSupply of ERC20
+	GasSupplyOf = 2_477
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Erc20Keeper defines the methods used by the bank precompile to resolve the
+// ERC-20 token addresses of the native denominations.
+type Erc20Keeper interface {
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	GetDenomPrecompile(ctx sdk.Context, address common.Address) (string, bool)
+}
+
+// Precompile defines the bank precompile
+type Precompile struct {
+	cmn.Precompile
+	bankWrapper   *wrappers.BankWrapper
+	erc20Keeper   Erc20Keeper
+	werc20Address common.Address
+}
+
+// NewPrecompile creates a new bank Precompile instance implementing the
+// PrecompiledContract interface. The balances and supplies of the evm coin
+// are returned with 18 decimals, for the WERC20 precompile at the given
+// address, and the ones of the other denominations for the ERC-20 tokens of
+// their token pairs.
+func NewPrecompile(
+	bankKeeper evmtypes.BankKeeper,
+	erc20Keeper Erc20Keeper,
+	werc20Address common.Address,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: we set an empty gas configuration to avoid extra gas costs
+	// during the run execution
+	return &Precompile{
+		Precompile: cmn.NewPrecompile(
+			newABI,
+			common.HexToAddress(evmtypes.BankPrecompileAddress),
+			storetypes.GasConfig{},
+			storetypes.GasConfig{},
+		),
+		bankWrapper:   wrappers.NewBankWrapper(bankKeeper),
+		erc20Keeper:   erc20Keeper,
+		werc20Address: werc20Address,
+	}, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return 0
+	}
+
+	switch method.Name {
+	case BalancesMethod:
+		return GasBalances
+	case TotalSupplyMethod:
+		return GasTotalSupply
+	case SupplyOfMethod:
+		return GasSupplyOf
+	}
+
+	return 0
+}
+
+// Run executes the precompiled contract bank query methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, readOnly, p.IsTransaction, p.execute)
+}
+
+// execute dispatches the call to the bank method with the given arguments.
+func (p Precompile) execute(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// Bank queries
+	case BalancesMethod:
+		return p.Balances(ctx, method, args)
+	case TotalSupplyMethod:
+		return p.TotalSupply(ctx, method, args)
+	case SupplyOfMethod:
+		return p.SupplyOf(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// It returns false since all bank methods are queries.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
@@ -0,0 +1,227 @@
+package bank_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	"github.com/green901612/cosevm/precompiles/bank"
+	"github.com/green901612/cosevm/testutil"
+	"github.com/green901612/cosevm/utils"
+	erc20types "github.com/green901612/cosevm/x/erc20/types"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var (
+	evmDenom      = testutil.Denom
+	otherDenom    = "uother"
+	externalDenom = "erc20/0x1000000000000000000000000000000000000001"
+	unpairedDenom = "uunpaired"
+
+	werc20Addr   = common.HexToAddress(evmtypes.WERC20PrecompileAddress)
+	otherAddr    = utils.GetDenomAddress(otherDenom)
+	otherERC20   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	externalAddr = common.HexToAddress("0x1000000000000000000000000000000000000001")
+)
+
+// mockBankKeeper implements the bank methods used by the precompile queries.
+type mockBankKeeper struct {
+	evmtypes.BankKeeper
+	balances sdk.Coins
+	supply   sdk.Coins
+}
+
+func (m mockBankKeeper) GetAllBalances(_ context.Context, _ sdk.AccAddress) sdk.Coins {
+	return m.balances
+}
+
+func (m mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, m.supply.AmountOf(denom))
+}
+
+func (m mockBankKeeper) IterateTotalSupply(_ context.Context, cb func(sdk.Coin) bool) {
+	for _, coin := range m.supply {
+		if cb(coin) {
+			return
+		}
+	}
+}
+
+// mockErc20Keeper implements the token pair lookups of the precompile.
+type mockErc20Keeper struct {
+	pairs []erc20types.TokenPair
+}
+
+func (m mockErc20Keeper) GetTokenPairID(_ sdk.Context, token string) []byte {
+	for _, pair := range m.pairs {
+		if pair.Denom == token || (common.IsHexAddress(token) && pair.GetERC20Contract() == common.HexToAddress(token)) {
+			return pair.GetID()
+		}
+	}
+	return nil
+}
+
+func (m mockErc20Keeper) GetTokenPair(_ sdk.Context, id []byte) (erc20types.TokenPair, bool) {
+	for _, pair := range m.pairs {
+		if bytes.Equal(pair.GetID(), id) {
+			return pair, true
+		}
+	}
+	return erc20types.TokenPair{}, false
+}
+
+func (m mockErc20Keeper) GetDenomPrecompile(_ sdk.Context, address common.Address) (string, bool) {
+	for _, pair := range m.pairs {
+		if pair.IsNativeCoin() && pair.GetPrecompileAddress() == address {
+			return pair.Denom, true
+		}
+	}
+	return "", false
+}
+
+func setupPrecompile(t *testing.T) (*bank.Precompile, sdk.Context) {
+	t.Helper()
+
+	require.NoError(t, app.EvmAppOptions(testutil.ChainID))
+
+	bk := mockBankKeeper{
+		balances: sdk.NewCoins(
+			sdk.NewInt64Coin(evmDenom, 100),
+			sdk.NewInt64Coin(externalDenom, 10),
+			sdk.NewInt64Coin(otherDenom, 20),
+			sdk.NewInt64Coin(unpairedDenom, 30),
+		),
+		supply: sdk.NewCoins(
+			sdk.NewInt64Coin(evmDenom, 1_000),
+			sdk.NewInt64Coin(externalDenom, 200),
+			sdk.NewInt64Coin(otherDenom, 300),
+			sdk.NewInt64Coin(unpairedDenom, 400),
+		),
+	}
+	ek := mockErc20Keeper{pairs: []erc20types.TokenPair{
+		erc20types.NewTokenPair(otherERC20, otherDenom, erc20types.OWNER_MODULE),
+		erc20types.NewTokenPair(externalAddr, externalDenom, erc20types.OWNER_EXTERNAL),
+	}}
+
+	p, err := bank.NewPrecompile(bk, ek, werc20Addr)
+	require.NoError(t, err)
+
+	ctx := sdktestutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	return p, ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+}
+
+func TestRequiredGas(t *testing.T) {
+	p, _ := setupPrecompile(t)
+
+	testCases := []struct {
+		method string
+		expGas uint64
+	}{
+		{bank.BalancesMethod, bank.GasBalances},
+		{bank.TotalSupplyMethod, bank.GasTotalSupply},
+		{bank.SupplyOfMethod, bank.GasSupplyOf},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method, func(t *testing.T) {
+			method := p.Methods[tc.method]
+			require.Equal(t, tc.expGas, p.RequiredGas(method.ID))
+		})
+	}
+
+	require.Zero(t, p.RequiredGas([]byte{0x1}))
+	require.Zero(t, p.RequiredGas([]byte{0x1, 0x2, 0x3, 0x4}))
+}
+
+func TestQueries(t *testing.T) {
+	p, ctx := setupPrecompile(t)
+
+	// the balances and supplies are returned for the WERC20 precompile of the
+	// evm coin, the dynamic precompile of the native coin and the contract of
+	// the external token, the denominations without token pair are skipped
+	t.Run("balances", func(t *testing.T) {
+		method := p.Methods[bank.BalancesMethod]
+		bz, err := p.Balances(ctx, &method, []interface{}{common.Address{0x1}})
+		require.NoError(t, err)
+
+		var out []bank.Balance
+		require.NoError(t, p.UnpackIntoInterface(&out, method.Name, bz))
+		require.Equal(t, []bank.Balance{
+			{ContractAddress: werc20Addr, Amount: big.NewInt(100)},
+			{ContractAddress: externalAddr, Amount: big.NewInt(10)},
+			{ContractAddress: otherAddr, Amount: big.NewInt(20)},
+		}, out)
+	})
+
+	t.Run("balances - invalid args", func(t *testing.T) {
+		method := p.Methods[bank.BalancesMethod]
+		_, err := p.Balances(ctx, &method, []interface{}{"invalid"})
+		require.Error(t, err)
+	})
+
+	t.Run("totalSupply", func(t *testing.T) {
+		method := p.Methods[bank.TotalSupplyMethod]
+		bz, err := p.TotalSupply(ctx, &method, nil)
+		require.NoError(t, err)
+
+		var out []bank.Balance
+		require.NoError(t, p.UnpackIntoInterface(&out, method.Name, bz))
+		require.Equal(t, []bank.Balance{
+			{ContractAddress: werc20Addr, Amount: big.NewInt(1_000)},
+			{ContractAddress: externalAddr, Amount: big.NewInt(200)},
+			{ContractAddress: otherAddr, Amount: big.NewInt(300)},
+		}, out)
+	})
+
+	testCases := []struct {
+		name      string
+		token     common.Address
+		expSupply int64
+	}{
+		{"supplyOf - evm coin", werc20Addr, 1_000},
+		{"supplyOf - native coin", otherAddr, 300},
+		{"supplyOf - external token", externalAddr, 200},
+		// the bank supply of a native coin is exposed by its precompile, not
+		// by the ERC-20 contract of its token pair
+		{"supplyOf - erc20 contract of a native coin", otherERC20, 0},
+		{"supplyOf - unknown token", common.Address{0x2}, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			method := p.Methods[bank.SupplyOfMethod]
+			bz, err := p.SupplyOf(ctx, &method, []interface{}{tc.token})
+			require.NoError(t, err)
+
+			out, err := method.Outputs.Unpack(bz)
+			require.NoError(t, err)
+			require.Zero(t, big.NewInt(tc.expSupply).Cmp(out[0].(*big.Int)))
+		})
+	}
+}
+
+func TestQueriesGas(t *testing.T) {
+	p, ctx := setupPrecompile(t)
+
+	// every balance iterated after the first one is charged, including the
+	// skipped ones
+	method := p.Methods[bank.BalancesMethod]
+	_, err := p.Balances(ctx, &method, []interface{}{common.Address{0x1}})
+	require.NoError(t, err)
+	require.Equal(t, 3*uint64(bank.GasBalances), ctx.GasMeter().GasConsumed())
+
+	// the supply of a single token is looked up without iterating the others
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	method = p.Methods[bank.SupplyOfMethod]
+	_, err = p.SupplyOf(ctx, &method, []interface{}{externalAddr})
+	require.NoError(t, err)
+	require.Zero(t, ctx.GasMeter().GasConsumed())
+}
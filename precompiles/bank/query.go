@@ -0,0 +1,156 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bank
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+const (
+	// BalancesMethod defines the ABI method name for the bank Balances
+	// query.
+	BalancesMethod = "balances"
+	// TotalSupplyMethod defines the ABI method name for the bank TotalSupply
+	// query.
+	TotalSupplyMethod = "totalSupply"
+	// SupplyOfMethod defines the ABI method name for the bank SupplyOf
+	// query.
+	SupplyOfMethod = "supplyOf"
+)
+
+// Balances returns all the native token balances (address, amount) for a given
+// account, for the denominations with an ERC-20 token. This method charges the
+// account the corresponding value of an ERC-20 balanceOf call for each
+// balance iterated.
+func (p Precompile) Balances(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, err := ParseBalancesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := p.bankWrapper.GetAllBalances(ctx, account)
+
+	balances := make([]Balance, 0, len(coins))
+	for i, coin := range coins {
+		// NOTE: the first balance is already paid by the required gas
+		if i > 0 {
+			ctx.GasMeter().ConsumeGas(GasBalances, "ERC-20 extension balances method")
+		}
+
+		tokenAddress, found := p.tokenAddress(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+
+		balances = append(balances, Balance{
+			ContractAddress: tokenAddress,
+			Amount:          coin.Amount.BigInt(),
+		})
+	}
+
+	return method.Outputs.Pack(balances)
+}
+
+// TotalSupply returns the total supply of all the native tokens with an
+// ERC-20 token. This method charges the account the corresponding value of an
+// ERC-20 totalSupply call for each supply iterated.
+func (p Precompile) TotalSupply(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	var (
+		totalSupply []Balance
+		i           int
+	)
+
+	p.bankWrapper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		defer func() { i++ }()
+
+		// NOTE: the first supply is already paid by the required gas
+		if i > 0 {
+			ctx.GasMeter().ConsumeGas(GasTotalSupply, "ERC-20 extension totalSupply method")
+		}
+
+		tokenAddress, found := p.tokenAddress(ctx, coin.Denom)
+		if !found {
+			return false
+		}
+
+		totalSupply = append(totalSupply, Balance{
+			ContractAddress: tokenAddress,
+			Amount:          coin.Amount.BigInt(),
+		})
+		return false
+	})
+
+	return method.Outputs.Pack(totalSupply)
+}
+
+// SupplyOf returns the total native supply of a given registered token. If the
+// address doesn't match the token address of any native denomination, zero is
+// returned.
+func (p Precompile) SupplyOf(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	tokenAddress, err := ParseSupplyOfArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, found := p.tokenDenom(ctx, tokenAddress)
+	if !found {
+		return method.Outputs.Pack(big.NewInt(0))
+	}
+
+	return method.Outputs.Pack(p.bankWrapper.GetSupply(ctx, denom).Amount.BigInt())
+}
+
+// tokenAddress returns the address of the ERC-20 token of the given
+// denomination: the WERC20 precompile for the evm coin, the dynamic ERC-20
+// precompile for the registered native coins and the ERC-20 contract for the
+// external tokens. It returns false if the denomination has no token pair.
+func (p Precompile) tokenAddress(ctx sdk.Context, denom string) (common.Address, bool) {
+	if denom == evmtypes.GetEVMCoinDenom() {
+		return p.werc20Address, true
+	}
+
+	tokenPair, found := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetTokenPairID(ctx, denom))
+	if !found {
+		return common.Address{}, false
+	}
+	if tokenPair.IsNativeCoin() {
+		return tokenPair.GetPrecompileAddress(), true
+	}
+	return tokenPair.GetERC20Contract(), true
+}
+
+// tokenDenom returns the denomination of the ERC-20 token at the given
+// address, the reverse of tokenAddress.
+func (p Precompile) tokenDenom(ctx sdk.Context, tokenAddress common.Address) (string, bool) {
+	if tokenAddress == p.werc20Address {
+		return evmtypes.GetEVMCoinDenom(), true
+	}
+
+	if denom, found := p.erc20Keeper.GetDenomPrecompile(ctx, tokenAddress); found {
+		return denom, true
+	}
+
+	tokenPair, found := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetTokenPairID(ctx, tokenAddress.Hex()))
+	if !found || !tokenPair.IsNativeERC20() {
+		return "", false
+	}
+	return tokenPair.Denom, true
+}
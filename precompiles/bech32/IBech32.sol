@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IBech32 contract's address.
+address constant BECH32_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000400;
+
+/// @dev The IBech32 contract's instance.
+IBech32 constant BECH32_CONTRACT = IBech32(BECH32_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Bech32 Precompiled Contract
+/// @dev The interface through which solidity contracts can convert addresses
+/// between the hex and the bech32 formats. The methods do not read nor write
+/// the state, so they can be called from both regular and static calls.
+/// @custom:address 0x0000000000000000000000000000000000000400
+interface IBech32 {
+    /// @dev Defines a method for converting a hex formatted address to bech32.
+    /// @param addr The hex address to be converted.
+    /// @param prefix The human readable prefix (HRP) of the bech32 address.
+    /// @return bech32Address The address in bech32 format.
+    function hexToBech32(
+        address addr,
+        string memory prefix
+    ) external returns (string memory bech32Address);
+
+    /// @dev Defines a method for converting a bech32 formatted address to hex.
+    /// @param bech32Address The bech32 address to be converted.
+    /// @return addr The address in hex format.
+    function bech32ToHex(
+        string memory bech32Address
+    ) external returns (address addr);
+}
@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bech32
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// Bech32Gas is the fixed gas cost of the address conversions. The methods do
+// not access the state, so their cost does not depend on the store gas
+// configuration.
+const Bech32Gas uint64 = 6_000
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for Bech32 encoding.
+type Precompile struct {
+	cmn.Precompile
+}
+
+// NewPrecompile creates a new bech32 Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile() (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the precompile is stateless, so it does not need any gas
+	// configuration for the store accesses
+	return &Precompile{
+		Precompile: cmn.NewPrecompile(
+			newABI,
+			common.HexToAddress(evmtypes.Bech32PrecompileAddress),
+			storetypes.GasConfig{},
+			storetypes.GasConfig{},
+		),
+	}, nil
+}
+
+// RequiredGas returns the fixed gas cost of the address conversions.
+func (Precompile) RequiredGas(_ []byte) uint64 {
+	return Bech32Gas
+}
+
+// Run executes the precompiled contract bech32 methods defined in the ABI.
+// The methods are stateless, so the precompile neither needs the StateDB
+// nor is restricted on read-only calls.
+func (p Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
+	if len(contract.Input) < 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidCallData, len(contract.Input))
+	}
+
+	method, err := p.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case HexToBech32Method:
+		bz, err = p.HexToBech32(method, args)
+	case Bech32ToHexMethod:
+		bz, err = p.Bech32ToHex(method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction returns false, as none of the bech32 methods modify the state.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
@@ -0,0 +1,113 @@
+package bech32_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/precompiles/bech32"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+func TestHexToBech32(t *testing.T) {
+	p, err := bech32.NewPrecompile()
+	require.NoError(t, err)
+
+	addr := common.HexToAddress("0x71f9b58243a4c7a9dff6826786fbb2a8fd795821")
+	method := p.Methods[bech32.HexToBech32Method]
+
+	testCases := []struct {
+		name   string
+		args   []interface{}
+		expRes string
+		expErr bool
+	}{
+		{"success - account prefix", []interface{}{addr, "cose"}, "cose1w8umtqjr5nr6nhlksfncd7aj4r7hjkpp5tqfz2", false},
+		{"success - validator prefix", []interface{}{addr, "cosevaloper"}, "cosevaloper1w8umtqjr5nr6nhlksfncd7aj4r7hjkppyqn9n9", false},
+		{"fail - invalid number of args", []interface{}{addr}, "", true},
+		{"fail - empty prefix", []interface{}{addr, " "}, "", true},
+		{"fail - upper case prefix", []interface{}{addr, "COSE"}, "", true},
+		{"fail - invalid prefix characters", []interface{}{addr, "co se"}, "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := p.HexToBech32(&method, tc.args)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			out, err := method.Outputs.Unpack(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.expRes, out[0])
+		})
+	}
+}
+
+func TestBech32ToHex(t *testing.T) {
+	p, err := bech32.NewPrecompile()
+	require.NoError(t, err)
+
+	addr := common.HexToAddress("0x71f9b58243a4c7a9dff6826786fbb2a8fd795821")
+	method := p.Methods[bech32.Bech32ToHexMethod]
+
+	testCases := []struct {
+		name   string
+		args   []interface{}
+		expErr bool
+	}{
+		{"success - account address", []interface{}{sdk.MustBech32ifyAddressBytes("cose", addr.Bytes())}, false},
+		{"success - validator address", []interface{}{sdk.MustBech32ifyAddressBytes("cosevaloper", addr.Bytes())}, false},
+		{"success - other chain address", []interface{}{sdk.MustBech32ifyAddressBytes("cosmos", addr.Bytes())}, false},
+		{"success - prefix containing the separator", []interface{}{sdk.MustBech32ifyAddressBytes("cose1test", addr.Bytes())}, false},
+		{"fail - invalid number of args", []interface{}{}, true},
+		{"fail - missing separator", []interface{}{"cose"}, true},
+		{"fail - empty prefix", []interface{}{"1w8umtqjr5nr6nhlksfncd7aj4r7hjkpp3j4szn"}, true},
+		{"fail - invalid checksum", []interface{}{"cose1w8umtqjr5nr6nhlksfncd7aj4r7hjkpp5tqfz3"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := p.Bech32ToHex(&method, tc.args)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			out, err := method.Outputs.Unpack(bz)
+			require.NoError(t, err)
+			require.Equal(t, addr, out[0])
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	p, err := bech32.NewPrecompile()
+	require.NoError(t, err)
+
+	addr := common.HexToAddress("0x71f9b58243a4c7a9dff6826786fbb2a8fd795821")
+	input, err := p.Pack(bech32.HexToBech32Method, addr, "cose")
+	require.NoError(t, err)
+
+	require.Equal(t, bech32.Bech32Gas, p.RequiredGas(input))
+	require.False(t, p.IsTransaction(bech32.HexToBech32Method))
+
+	// the conversion is allowed on read-only calls
+	contract := vm.NewContract(vm.AccountRef(common.Address{}), vm.AccountRef(p.Address()), nil, bech32.Bech32Gas)
+	contract.Input = input
+	bz, err := p.Run(nil, contract, true)
+	require.NoError(t, err)
+
+	out, err := p.Unpack(bech32.HexToBech32Method, bz)
+	require.NoError(t, err)
+	require.Equal(t, "cose1w8umtqjr5nr6nhlksfncd7aj4r7hjkpp5tqfz2", out[0])
+
+	contract.Input = input[:3]
+	_, err = p.Run(nil, contract, true)
+	require.Error(t, err)
+}
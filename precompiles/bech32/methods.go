@@ -0,0 +1,127 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bech32
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/utils"
+)
+
+const (
+	// HexToBech32Method defines the ABI method name to convert a EIP-55
+	// hex formatted address to bech32 address string.
+	HexToBech32Method = "hexToBech32"
+	// Bech32ToHexMethod defines the ABI method name to convert a bech32
+	// formatted address string to an EIP-55 address.
+	Bech32ToHexMethod = "bech32ToHex"
+)
+
+const (
+	// ErrInvalidPrefix is raised when the bech32 human readable prefix is not valid.
+	ErrInvalidPrefix = "invalid bech32 human readable prefix (HRP) %q; please provide an account, validator or consensus address prefix (eg: %s, %s, %s)"
+	// ErrInvalidBech32Address is raised when the bech32 address is not valid.
+	ErrInvalidBech32Address = "invalid bech32 address %q: %v"
+)
+
+// HexToBech32 converts a hex address to its corresponding Bech32 format. The
+// Human Readable Prefix (HRP) must be provided in the arguments.
+func (p Precompile) HexToBech32(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	address, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "address", common.Address{}, args[0])
+	}
+
+	prefix, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "prefix", "", args[1])
+	}
+
+	if err := validatePrefix(prefix); err != nil {
+		return nil, err
+	}
+
+	bech32Str, err := sdk.Bech32ifyAddressBytes(prefix, address.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(bech32Str)
+}
+
+// Bech32ToHex converts a bech32 address to its corresponding EIP-55 hex
+// format. Any human readable prefix is accepted, so that the addresses of
+// validators, consensus nodes and other chains can be converted as well.
+func (p Precompile) Bech32ToHex(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	address, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "bech32Address", "", args[0])
+	}
+
+	// NOTE: the separator is the last "1" of the address, the human readable
+	// prefix can contain it as well
+	sep := strings.LastIndex(address, "1")
+	if sep == -1 {
+		return nil, fmt.Errorf(ErrInvalidBech32Address, address, "missing separator")
+	}
+
+	prefix := address[:sep]
+	if err := validatePrefix(prefix); err != nil {
+		return nil, err
+	}
+
+	addr, err := utils.CreateAccAddressFromBech32(address, prefix)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidBech32Address, address, err)
+	}
+
+	return method.Outputs.Pack(common.BytesToAddress(addr))
+}
+
+// validatePrefix checks that the given human readable prefix is not empty, is
+// lower case and only contains the characters allowed by the bech32
+// specification (ASCII 33 to 126).
+func validatePrefix(prefix string) error {
+	if prefix == "" || prefix != strings.ToLower(prefix) {
+		return newInvalidPrefixError(prefix)
+	}
+
+	for _, c := range prefix {
+		if c < 33 || c > 126 {
+			return newInvalidPrefixError(prefix)
+		}
+	}
+
+	return nil
+}
+
+func newInvalidPrefixError(prefix string) error {
+	cfg := sdk.GetConfig()
+	return fmt.Errorf(
+		ErrInvalidPrefix,
+		prefix,
+		cfg.GetBech32AccountAddrPrefix(),
+		cfg.GetBech32ValidatorAddrPrefix(),
+		cfg.GetBech32ConsensusAddrPrefix(),
+	)
+}
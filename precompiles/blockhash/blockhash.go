@@ -0,0 +1,83 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package blockhash
+
+import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	"github.com/green901612/cosevm/x/evm/statedb"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// HistoryGas is the fixed gas cost of a block hash lookup, i.e. the cost of the
+// cold storage read performed by the EIP-2935 history contract.
+const HistoryGas = params.ColdSloadCostEIP2929
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// EVMKeeper defines the x/evm keeper methods used by the block hash precompile.
+type EVMKeeper interface {
+	GetBlockHash(ctx sdk.Context, height uint64) common.Hash
+}
+
+// Precompile serves the block hash history of the x/evm module at the address
+// of the EIP-2935 history contract.
+// See https://eips.ethereum.org/EIPS/eip-2935 for details
+type Precompile struct {
+	evmKeeper EVMKeeper
+}
+
+// NewPrecompile creates a new block hash Precompile instance.
+func NewPrecompile(evmKeeper EVMKeeper) *Precompile {
+	return &Precompile{evmKeeper: evmKeeper}
+}
+
+// Address defines the address of the block hash precompiled contract.
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(evmtypes.HistoryStoragePrecompileAddress)
+}
+
+// RequiredGas returns the static gas required to execute the precompiled contract.
+func (Precompile) RequiredGas(_ []byte) uint64 {
+	return HistoryGas
+}
+
+// Run returns the hash of the block whose number is given as input, like the
+// EIP-2935 history contract.
+//
+// Input data: 32 bytes of the block number
+//
+// Output data: 32 bytes of the block hash
+//   - It reverts if the input is not 32 bytes long or if the block is not one
+//     of the HistoryServeWindow blocks before the current one
+//   - It returns an empty hash if the hash of the block was not recorded
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		return nil, errors.New(cmn.ErrNotRunInEvm)
+	}
+
+	return p.BlockHash(stateDB.GetContext(), contract.Input)
+}
+
+// BlockHash returns the hash of the block whose number is given as input from
+// the block hash history of the given context.
+func (p Precompile) BlockHash(ctx sdk.Context, input []byte) ([]byte, error) {
+	if len(input) != common.HashLength {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	number := new(big.Int).SetBytes(input)
+	if !number.IsUint64() || !evmtypes.IsInHistoryServeWindow(number.Uint64(), uint64(ctx.BlockHeight())) { //nolint:gosec // G115
+		return nil, vm.ErrExecutionReverted
+	}
+
+	return p.evmKeeper.GetBlockHash(ctx, number.Uint64()).Bytes(), nil
+}
@@ -0,0 +1,68 @@
+package blockhash_test
+
+import (
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/precompiles/blockhash"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// mockEVMKeeper returns the hashes of the blocks of the history window.
+type mockEVMKeeper struct{}
+
+func (mockEVMKeeper) GetBlockHash(ctx sdk.Context, height uint64) common.Hash {
+	if !evmtypes.IsInHistoryServeWindow(height, uint64(ctx.BlockHeight())) { //nolint:gosec // G115
+		return common.Hash{}
+	}
+	return common.BigToHash(new(big.Int).SetUint64(height))
+}
+
+func TestBlockHash(t *testing.T) {
+	p := blockhash.NewPrecompile(mockEVMKeeper{})
+	require.Equal(t, common.HexToAddress(evmtypes.HistoryStoragePrecompileAddress), p.Address())
+	require.Equal(t, blockhash.HistoryGas, p.RequiredGas(nil))
+
+	current := uint64(10_000)
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).
+		WithBlockHeight(int64(current))
+
+	number := func(n uint64) []byte {
+		return common.BigToHash(new(big.Int).SetUint64(n)).Bytes()
+	}
+
+	testCases := []struct {
+		name    string
+		input   []byte
+		expHash common.Hash
+		expErr  bool
+	}{
+		{"previous block", number(current - 1), common.BigToHash(big.NewInt(9_999)), false},
+		{"oldest block of the window", number(current - evmtypes.HistoryServeWindow), common.BigToHash(big.NewInt(1_809)), false},
+		{"block before the window", number(current - evmtypes.HistoryServeWindow - 1), common.Hash{}, true},
+		{"current block", number(current), common.Hash{}, true},
+		{"future block", number(current + 1), common.Hash{}, true},
+		{"number overflow", common.HexToHash("0x010000000000000000").Bytes(), common.Hash{}, true},
+		{"short input", number(current - 1)[1:], common.Hash{}, true},
+		{"empty input", nil, common.Hash{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := p.BlockHash(ctx, tc.input)
+			if tc.expErr {
+				require.ErrorIs(t, err, vm.ErrExecutionReverted)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expHash.Bytes(), bz)
+		})
+	}
+}
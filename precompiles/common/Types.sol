@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev Coin is a struct that represents a token with a denomination and an amount.
+struct Coin {
+    string denom;
+    uint256 amount;
+}
+
+/// @dev PageRequest defines the pagination parameters of the queries.
+struct PageRequest {
+    bytes key;
+    uint64 offset;
+    uint64 limit;
+    bool countTotal;
+    bool reverse;
+}
+
+/// @dev PageResponse defines the pagination response of the queries.
+struct PageResponse {
+    bytes nextKey;
+    uint64 total;
+}
+
+/// @dev Dec is a struct that represents a decimal number with a value scaled
+/// by 10^precision.
+struct Dec {
+    uint256 value;
+    uint8 precision;
+}
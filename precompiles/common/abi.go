@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// LoadABI reads the ABI of a precompile from the given Hardhat artifact
+// embedded in the file system.
+func LoadABI(fs embed.FS, path string) (abi.ABI, error) {
+	abiBz, err := fs.ReadFile(path)
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("error loading the ABI %s", err)
+	}
+
+	var artifact struct {
+		ABI json.RawMessage `json:"abi"`
+	}
+	if err := json.Unmarshal(abiBz, &artifact); err != nil {
+		return abi.ABI{}, fmt.Errorf("invalid ABI artifact %s", err)
+	}
+
+	return abi.JSON(bytes.NewReader(artifact.ABI))
+}
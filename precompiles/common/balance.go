@@ -0,0 +1,100 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// Operation is a type that defines if the precompile call
+// produced an addition or subtraction of an account's balance
+type Operation int8
+
+const (
+	// Sub subtracts the amount from the account balance
+	Sub Operation = iota
+	// Add adds the amount to the account balance
+	Add
+)
+
+// BalanceChangeEntry defines the change of the EVM coin balance of an account
+// performed by a precompile call. The changes are applied on the StateDB so
+// that its dirty balances stay in sync with the x/bank module.
+type BalanceChangeEntry struct {
+	Account common.Address
+	Amount  *big.Int
+	Op      Operation
+}
+
+// NewBalanceChangeEntry creates a new BalanceChangeEntry.
+func NewBalanceChangeEntry(acc common.Address, amt *big.Int, op Operation) BalanceChangeEntry {
+	return BalanceChangeEntry{acc, amt, op}
+}
+
+// ParseBalanceChanges returns the changes of the EVM coin balances recorded
+// by the x/bank coin_spent and coin_received events. The messages of the
+// Cosmos SDK modules transfer coins through the x/bank module, so every
+// balance they change has to be updated on the StateDB as well. The amounts
+// are scaled to 18 decimals, which is the precision of the StateDB balances.
+func ParseBalanceChanges(events sdk.Events) ([]BalanceChangeEntry, error) {
+	evmDenom := evmtypes.GetEVMCoinDenom()
+
+	var entries []BalanceChangeEntry
+	for _, event := range events {
+		var (
+			op      Operation
+			addrKey string
+		)
+
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			op, addrKey = Sub, banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			op, addrKey = Add, banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		addrAttr, ok := event.GetAttribute(addrKey)
+		if !ok {
+			return nil, fmt.Errorf(ErrMissingEventAttribute, addrKey, event.Type)
+		}
+
+		amountAttr, ok := event.GetAttribute(sdk.AttributeKeyAmount)
+		if !ok {
+			return nil, fmt.Errorf(ErrMissingEventAttribute, sdk.AttributeKeyAmount, event.Type)
+		}
+
+		account, err := sdk.AccAddressFromBech32(addrAttr.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		// NOTE: the amounts are not normalized, as the registered denom units
+		// would convert the EVM coin denomination into another one
+		coins, err := sdk.ParseDecCoins(amountAttr.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		amount := coins.AmountOf(evmDenom).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+
+		entries = append(entries, NewBalanceChangeEntry(
+			common.BytesToAddress(account),
+			evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt()),
+			op,
+		))
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+const (
+	// ErrNotRunInEvm is raised when a function is not called inside the EVM.
+	ErrNotRunInEvm = "not run in EVM"
+	// ErrUnknownMethod is raised when the method is not known by the precompile.
+	ErrUnknownMethod = "unknown method: %s"
+	// ErrInvalidNumberOfArgs is raised when the number of arguments is not what is expected.
+	ErrInvalidNumberOfArgs = "invalid number of arguments; expected %d; got: %d"
+	// ErrInvalidCallData is raised when the call data is too short to contain a method ID.
+	ErrInvalidCallData = "invalid call data; length should be at least 4 bytes; got: %d"
+	// ErrInvalidType is raised when the provided type is different than the expected.
+	ErrInvalidType = "invalid type for %s: expected %T, received %T"
+	// ErrNegativeAmount is raised when an amount is negative.
+	ErrNegativeAmount = "negative amount"
+	// ErrMissingEventAttribute is raised when a Cosmos SDK event does not contain an expected attribute.
+	ErrMissingEventAttribute = "missing attribute %s in %s event"
+	// ErrInvalidIndexedArgs is raised when an event is emitted with more indexed arguments than its ABI defines.
+	ErrInvalidIndexedArgs = "invalid indexed arguments for event %s; expected at most %d; got: %d"
+	// ErrPrecompilePanic is raised when the execution of a precompile method panics.
+	ErrPrecompilePanic = "precompile execution panicked: %v"
+)
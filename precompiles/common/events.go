@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+// MakeTopic converts a filter query argument into a filter topic.
+func MakeTopic(rule interface{}) (common.Hash, error) {
+	topics, err := abi.MakeTopics([]interface{}{rule})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return topics[0][0], nil
+}
+
+// NewLog returns the EVM log of the given ABI event emitted by the contract
+// at the given address. The indexed arguments are the topics of the log,
+// following the event signature, and the data arguments are packed as its
+// data.
+func NewLog(
+	event abi.Event,
+	address common.Address,
+	height uint64,
+	indexed []interface{},
+	data ...interface{},
+) (*ethtypes.Log, error) {
+	if len(indexed) > len(event.Inputs)-len(event.Inputs.NonIndexed()) {
+		return nil, fmt.Errorf(ErrInvalidIndexedArgs, event.Name, len(event.Inputs)-len(event.Inputs.NonIndexed()), len(indexed))
+	}
+
+	topics := make([]common.Hash, len(indexed)+1)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	for i, rule := range indexed {
+		topics[i+1], err = MakeTopic(rule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethtypes.Log{
+		Address:     address,
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: height,
+	}, nil
+}
+
+// EmitEvent adds the log of the given ABI event of the precompile to the
+// stateDB.
+func (p Precompile) EmitEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	indexed []interface{},
+	data ...interface{},
+) error {
+	log, err := NewLog(
+		p.ABI.Events[eventType],
+		p.Address(),
+		uint64(ctx.BlockHeight()), //#nosec G115 -- block height is positive
+		indexed,
+		data...,
+	)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(log)
+	return nil
+}
@@ -0,0 +1,146 @@
+package common_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	"github.com/green901612/cosevm/precompiles/distribution"
+	"github.com/green901612/cosevm/testutil"
+	evmante "github.com/green901612/cosevm/x/evm/ante"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	"github.com/green901612/cosevm/x/evm/statedb"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// the native actions of these tests fund the community pool with the EVM
+// coins of the caller
+var (
+	distributionAddr = common.HexToAddress(evmtypes.DistributionPrecompileAddress)
+	amount           = sdk.DefaultPowerReduction
+)
+
+// revertingForwarderCode returns the runtime code of a contract that calls the
+// given precompile with its call data and then reverts, with the success flag
+// of the precompile call as revert data.
+func revertingForwarderCode(precompile common.Address) []byte {
+	code := []byte{
+		// copy the call data to the memory
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		// call the precompile with it
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+		byte(vm.PUSH20),
+	}
+	code = append(code, precompile.Bytes()...)
+	return append(code,
+		byte(vm.GAS), byte(vm.CALL),
+		// revert with the success flag
+		byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.REVERT),
+	)
+}
+
+// applyMessage executes and commits the message in the execution context of
+// an Ethereum transaction.
+func applyMessage(t *testing.T, miniApp *app.MiniApp, ctx sdk.Context, msg ethtypes.Message) *evmtypes.MsgEthereumTxResponse {
+	t.Helper()
+
+	ctx = evmante.BuildEvmExecutionCtx(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	res, err := miniApp.EvmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), true)
+	require.NoError(t, err)
+	return res
+}
+
+func communityPool(t *testing.T, miniApp *app.MiniApp, ctx sdk.Context) sdkmath.LegacyDec {
+	t.Helper()
+
+	feePool, err := miniApp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	return feePool.CommunityPool.AmountOf(testutil.Denom)
+}
+
+func TestRunNativeActionRevertedByCaller(t *testing.T) {
+	sender := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(sender, amount))
+
+	p, err := distribution.NewPrecompile(miniApp.DistrKeeper, miniApp.StakingKeeper)
+	require.NoError(t, err)
+
+	// the contract holds the EVM coins it funds the community pool with
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	stateDB := statedb.New(ctx, miniApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	stateDB.SetCode(contract, revertingForwarderCode(distributionAddr))
+	stateDB.AddBalance(contract, amount.MulRaw(2).BigInt())
+	require.NoError(t, stateDB.Commit())
+
+	balanceBefore := miniApp.BankKeeper.GetBalance(ctx, contract.Bytes(), testutil.Denom).Amount
+	poolBefore := communityPool(t, miniApp, ctx)
+
+	input, err := p.Pack(distribution.FundCommunityPoolMethod, contract, amount.BigInt())
+	require.NoError(t, err)
+	msg := ethtypes.NewMessage(
+		sender.Address, &contract, 0, big.NewInt(0), 1_000_000,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, false,
+	)
+	res := applyMessage(t, miniApp, ctx, msg)
+
+	// the precompile call succeeded, but the revert of the contract rolls back
+	// the bank transfer of the native action and the stateDB balance
+	require.True(t, res.Failed())
+	require.Equal(t, vm.ErrExecutionReverted.Error(), res.VmError)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), res.Ret)
+	require.Empty(t, res.Logs)
+
+	require.Equal(t, balanceBefore, miniApp.BankKeeper.GetBalance(ctx, contract.Bytes(), testutil.Denom).Amount)
+	require.Equal(t, balanceBefore.BigInt(), miniApp.EvmKeeper.GetBalance(ctx, contract))
+	require.Equal(t, poolBefore, communityPool(t, miniApp, ctx))
+}
+
+func TestRunNativeActionOutOfGas(t *testing.T) {
+	sender := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(sender, amount.MulRaw(2)))
+
+	p, err := distribution.NewPrecompile(miniApp.DistrKeeper, miniApp.StakingKeeper)
+	require.NoError(t, err)
+
+	balanceBefore := miniApp.BankKeeper.GetBalance(ctx, sender.AccAddr, testutil.Denom).Amount
+	poolBefore := communityPool(t, miniApp, ctx)
+
+	input, err := p.Pack(distribution.FundCommunityPoolMethod, sender.Address, amount.BigInt())
+	require.NoError(t, err)
+	newMsg := func(gasLimit uint64) ethtypes.Message {
+		return ethtypes.NewMessage(
+			sender.Address, &distributionAddr, 0, big.NewInt(0), gasLimit,
+			big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, false,
+		)
+	}
+
+	// the gas limit covers the intrinsic and the required gas of the
+	// precompile, so the gas runs out in the store accesses of the action
+	intrinsicGas, err := miniApp.EvmKeeper.GetEthIntrinsicGas(ctx, newMsg(0), evmtypes.GetEthChainConfig(), false)
+	require.NoError(t, err)
+	gasLimit := intrinsicGas + p.RequiredGas(input) + 1
+
+	res := applyMessage(t, miniApp, ctx, newMsg(gasLimit))
+	require.True(t, res.Failed())
+	require.Equal(t, vm.ErrOutOfGas.Error(), res.VmError)
+	require.Equal(t, gasLimit, res.GasUsed)
+	require.Empty(t, res.Logs)
+
+	require.Equal(t, balanceBefore, miniApp.BankKeeper.GetBalance(ctx, sender.AccAddr, testutil.Denom).Amount)
+	require.Equal(t, balanceBefore.BigInt(), miniApp.EvmKeeper.GetBalance(ctx, sender.Address))
+	require.Equal(t, poolBefore, communityPool(t, miniApp, ctx))
+
+	// the same call succeeds with enough gas
+	res = applyMessage(t, miniApp, ctx, newMsg(1_000_000))
+	require.False(t, res.Failed(), res.VmError)
+	require.Equal(t, balanceBefore.Sub(amount), miniApp.BankKeeper.GetBalance(ctx, sender.AccAddr, testutil.Denom).Amount)
+	require.Equal(t, poolBefore.Add(sdkmath.LegacyNewDecFromInt(amount)), communityPool(t, miniApp, ctx))
+}
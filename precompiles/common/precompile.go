@@ -0,0 +1,210 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	"github.com/green901612/cosevm/x/evm/statedb"
+)
+
+// Precompile is a common struct for all stateful precompiles. It holds the
+// ABI of the precompile and the gas configurations used to meter the Cosmos
+// SDK store accesses performed by its methods.
+type Precompile struct {
+	abi.ABI
+	KvGasConfig          storetypes.GasConfig
+	TransientKVGasConfig storetypes.GasConfig
+	address              common.Address
+}
+
+// NativeAction defines the function that executes a method of a stateful
+// precompile with its unpacked arguments, using the Cosmos SDK context of the
+// precompile call.
+type NativeAction func(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error)
+
+// NewPrecompile creates the common part of a stateful precompile deployed at
+// the given address.
+func NewPrecompile(
+	abi abi.ABI,
+	address common.Address,
+	kvGasConfig, transientKVGasConfig storetypes.GasConfig,
+) Precompile {
+	return Precompile{
+		ABI:                  abi,
+		KvGasConfig:          kvGasConfig,
+		TransientKVGasConfig: transientKVGasConfig,
+		address:              address,
+	}
+}
+
+// Address defines the address of the precompiled contract.
+func (p Precompile) Address() common.Address {
+	return p.address
+}
+
+// RequiredGas calculates the base minimum required gas for a transaction or a query.
+// It uses the method ID to determine if the input is a transaction or a query and
+// uses the Cosmos SDK KV gas config flat costs and per-byte costs to calculate the gas.
+func (p Precompile) RequiredGas(input []byte, isTransaction bool) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	argsBz := input[4:]
+	if isTransaction {
+		return p.KvGasConfig.WriteCostFlat + (p.KvGasConfig.WriteCostPerByte * uint64(len(argsBz)))
+	}
+
+	return p.KvGasConfig.ReadCostFlat + (p.KvGasConfig.ReadCostPerByte * uint64(len(argsBz)))
+}
+
+// RunNativeAction runs the method of the precompile called by the contract
+// input. It dispatches the call to the given action and takes care of the
+// common steps of every stateful precompile call:
+//
+//   - rejects transactions when called in read-only mode (i.e STATICCALL)
+//   - journals the call on the stateDB, so that the Cosmos SDK state changes
+//     are reverted together with the EVM state
+//   - meters the Cosmos SDK gas consumption with the precompile KV gas
+//     configurations and charges it to the contract
+//   - applies the EVM coin balance changes performed by the x/bank module to
+//     the stateDB
+//   - recovers from any panic of the execution and returns it as an error
+func (p Precompile) RunNativeAction(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	readOnly bool,
+	isTransaction func(name string) bool,
+	action NativeAction,
+) (bz []byte, err error) {
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		return nil, errors.New(ErrNotRunInEvm)
+	}
+
+	if len(contract.Input) < 4 {
+		return nil, fmt.Errorf(ErrInvalidCallData, len(contract.Input))
+	}
+
+	// NOTE: this function iterates over the method map and returns
+	// the method with the given ID
+	method, err := p.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	// return error if trying to write to state during a read-only call
+	if readOnly && isTransaction(method.Name) {
+		return nil, vm.ErrWriteProtection
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	// get the stateDB cache ctx
+	ctx, err := stateDB.GetCacheContext()
+	if err != nil {
+		return nil, err
+	}
+
+	// add a precompileCall entry with a snapshot of the current state to the
+	// stateDB journal before any change, so that the changes of the call are
+	// reverted if the call or any of its parent frames fails
+	if err := stateDB.AddPrecompileFn(p.Address(), stateDB.MultiStoreSnapshot(), ctx.EventManager().Events()); err != nil {
+		return nil, err
+	}
+
+	// commit the current changes in the cache ctx
+	// to get the updated state for the precompile call
+	if err := stateDB.CommitWithCacheCtx(); err != nil {
+		return nil, err
+	}
+
+	initialGas := ctx.GasMeter().GasConsumed()
+
+	// set the default SDK gas configuration to track gas usage
+	// we are changing the gas meter type, so it panics gracefully when out of gas
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(contract.Gas)).
+		WithKVGasConfig(p.KvGasConfig).
+		WithTransientKVGasConfig(p.TransientKVGasConfig)
+
+	// This handles any out of gas errors or panics that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the error so the EVM can continue gracefully.
+	defer HandleGasError(ctx, contract, initialGas, &err)()
+
+	// we need to consume the gas that was already used by the EVM
+	ctx.GasMeter().ConsumeGas(initialGas, "creating a new gas meter")
+
+	// the events emitted from now on are the ones of this call
+	eventsBefore := len(ctx.EventManager().Events())
+
+	bz, err = action(ctx, contract, stateDB, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	entries, err := ParseBalanceChanges(ctx.EventManager().Events()[eventsBefore:])
+	if err != nil {
+		return nil, err
+	}
+
+	applyBalanceChanges(stateDB, entries)
+
+	return bz, nil
+}
+
+// applyBalanceChanges applies the given balance changes to the stateDB so
+// that the dirty balances are not overwritten with stale values when the
+// stateDB is committed.
+func applyBalanceChanges(stateDB *statedb.StateDB, entries []BalanceChangeEntry) {
+	for _, entry := range entries {
+		switch entry.Op {
+		case Sub:
+			stateDB.SubBalance(entry.Account, entry.Amount)
+		case Add:
+			stateDB.AddBalance(entry.Account, entry.Amount)
+		}
+	}
+}
+
+// HandleGasError handles the out of gas panic by resetting the gas meter and returning an error.
+// This is used in order to avoid panics and to allow for the EVM to continue cleanup if the tx or query run out of gas.
+// Any other panic of the execution is recovered and returned as an error as well.
+func HandleGasError(ctx sdk.Context, contract *vm.Contract, initialGas storetypes.Gas, err *error) func() {
+	return func() {
+		if r := recover(); r != nil {
+			// update contract gas
+			usedGas := ctx.GasMeter().GasConsumed() - initialGas
+			_ = contract.UseGas(usedGas)
+
+			switch r.(type) {
+			case storetypes.ErrorOutOfGas:
+				*err = vm.ErrOutOfGas
+			default:
+				*err = fmt.Errorf(ErrPrecompilePanic, r)
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package common_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/testutil"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+func TestParseBalanceChanges(t *testing.T) {
+	require.NoError(t, app.EvmAppOptions(testutil.ChainID))
+	evmDenom := testutil.Denom
+
+	spender := common.HexToAddress("0x1")
+	receiver := common.HexToAddress("0x2")
+
+	events := sdk.Events{
+		banktypes.NewCoinSpentEvent(spender.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 5), sdk.NewInt64Coin("other", 1))),
+		banktypes.NewCoinReceivedEvent(receiver.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 5))),
+		// coins of other denominations do not change the EVM balances
+		banktypes.NewCoinReceivedEvent(receiver.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("other", 1))),
+		// events of other types are ignored
+		sdk.NewEvent(banktypes.EventTypeTransfer, sdk.NewAttribute(sdk.AttributeKeyAmount, "1"+evmDenom)),
+	}
+
+	// the EVM coin of the test chain has 18 decimals
+	amount := big.NewInt(5)
+
+	entries, err := cmn.ParseBalanceChanges(events)
+	require.NoError(t, err)
+	require.Equal(t, []cmn.BalanceChangeEntry{
+		cmn.NewBalanceChangeEntry(spender, amount, cmn.Sub),
+		cmn.NewBalanceChangeEntry(receiver, amount, cmn.Add),
+	}, entries)
+
+	_, err = cmn.ParseBalanceChanges(sdk.Events{
+		sdk.NewEvent(banktypes.EventTypeCoinSpent, sdk.NewAttribute(sdk.AttributeKeyAmount, "1"+evmDenom)),
+	})
+	require.Error(t, err)
+}
+
+func TestNewLog(t *testing.T) {
+	addressTy, err := abi.NewType("address", "", nil)
+	require.NoError(t, err)
+	uintTy, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+
+	event := abi.NewEvent("Transfer", "Transfer", false, abi.Arguments{
+		{Name: "from", Type: addressTy, Indexed: true},
+		{Name: "to", Type: addressTy, Indexed: true},
+		{Name: "value", Type: uintTy},
+	})
+
+	precompileAddr := common.HexToAddress("0x800")
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+
+	log, err := cmn.NewLog(event, precompileAddr, 10, []interface{}{from, to}, big.NewInt(3))
+	require.NoError(t, err)
+	require.Equal(t, precompileAddr, log.Address)
+	require.Equal(t, uint64(10), log.BlockNumber)
+	require.Equal(t, []common.Hash{event.ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())}, log.Topics)
+	require.Equal(t, common.BigToHash(big.NewInt(3)).Bytes(), log.Data)
+
+	// the event has only two indexed arguments
+	_, err = cmn.NewLog(event, precompileAddr, 10, []interface{}{from, to, big.NewInt(3)})
+	require.Error(t, err)
+}
+
+func TestHandleGasError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		panicVal interface{}
+		expErr   error
+	}{
+		{"out of gas", storetypes.ErrorOutOfGas{Descriptor: "test"}, vm.ErrOutOfGas},
+		{"other panic", "unexpected", errors.New("precompile execution panicked: unexpected")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(100))
+			ctx.GasMeter().ConsumeGas(30, "test")
+			contract := vm.NewContract(vm.AccountRef{}, vm.AccountRef{}, big.NewInt(0), 100)
+
+			var err error
+			func() {
+				defer cmn.HandleGasError(ctx, contract, 10, &err)()
+				panic(tc.panicVal)
+			}()
+
+			require.EqualError(t, err, tc.expErr.Error())
+			// the gas consumed by the execution is charged to the contract
+			require.Equal(t, uint64(80), contract.Gas)
+		})
+	}
+}
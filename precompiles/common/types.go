@@ -0,0 +1,84 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+import (
+	"errors"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Coin defines a struct that stores all needed information about a coin
+// in types native to the EVM.
+type Coin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+// Dec defines a struct that represents a decimal number in types native to
+// the EVM. The value is scaled by 10^precision.
+type Dec struct {
+	Value     *big.Int
+	Precision uint8
+}
+
+// PageRequest defines the pagination parameters of the queries in types
+// native to the EVM.
+type PageRequest struct {
+	Key        []byte
+	Offset     uint64
+	Limit      uint64
+	CountTotal bool
+	Reverse    bool
+}
+
+// PageResponse defines the pagination response of the queries in types
+// native to the EVM.
+type PageResponse struct {
+	NextKey []byte
+	Total   uint64
+}
+
+// NewCoinResponse converts a Cosmos SDK coin into the ABI representation.
+func NewCoinResponse(coin sdk.Coin) Coin {
+	return Coin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}
+}
+
+// NewDec converts a Cosmos SDK decimal into the ABI representation.
+func NewDec(dec math.LegacyDec) Dec {
+	return Dec{Value: dec.BigInt(), Precision: math.LegacyPrecision}
+}
+
+// NewSdkCoinFromBigInt returns a coin of the given denomination and amount,
+// or an error if the amount is negative.
+func NewSdkCoinFromBigInt(denom string, amount *big.Int) (sdk.Coin, error) {
+	if amount.Sign() < 0 {
+		return sdk.Coin{}, errors.New(ErrNegativeAmount)
+	}
+
+	return sdk.Coin{Denom: denom, Amount: math.NewIntFromBigInt(amount)}, nil
+}
+
+// ToPageRequest converts the ABI pagination request into the Cosmos SDK one.
+func (pr PageRequest) ToPageRequest() *query.PageRequest {
+	return &query.PageRequest{
+		Key:        pr.Key,
+		Offset:     pr.Offset,
+		Limit:      pr.Limit,
+		CountTotal: pr.CountTotal,
+		Reverse:    pr.Reverse,
+	}
+}
+
+// NewPageResponse converts a Cosmos SDK pagination response into the ABI
+// representation.
+func NewPageResponse(res *query.PageResponse) PageResponse {
+	if res == nil {
+		return PageResponse{}
+	}
+
+	return PageResponse{NextKey: res.NextKey, Total: res.Total}
+}
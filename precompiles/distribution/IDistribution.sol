@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IDistribution contract's address.
+address constant DISTRIBUTION_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000801;
+
+/// @dev The IDistribution contract's instance.
+IDistribution constant DISTRIBUTION_CONTRACT = IDistribution(
+    DISTRIBUTION_PRECOMPILE_ADDRESS
+);
+
+/// @dev DecCoin is a struct that represents a token with a denomination, an
+/// amount and a precision. The amount is scaled by 10^precision.
+struct DecCoin {
+    string denom;
+    uint256 amount;
+    uint8 precision;
+}
+
+/// @dev DelegationDelegatorReward defines the rewards of a delegation with
+/// a validator.
+struct DelegationDelegatorReward {
+    string validatorAddress;
+    DecCoin[] reward;
+}
+
+/// @author Evmos Team
+/// @title Distribution Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// Cosmos SDK x/distribution module. The methods can only be called by the
+/// account they act for. The amounts of the EVM coin use 18 decimals.
+/// @custom:address 0x0000000000000000000000000000000000000801
+interface IDistribution {
+    /// @dev ClaimRewards defines an Event emitted when rewards are claimed
+    /// @param delegatorAddress the address of the delegator
+    /// @param amount the amount of the EVM coin being claimed
+    event ClaimRewards(address indexed delegatorAddress, uint256 amount);
+
+    /// @dev WithdrawDelegatorRewards defines an Event emitted when rewards from a delegation are withdrawn
+    /// @param delegatorAddress the address of the delegator
+    /// @param validatorAddress the address of the validator
+    /// @param amount the amount of the EVM coin being withdrawn
+    event WithdrawDelegatorRewards(
+        address indexed delegatorAddress,
+        address indexed validatorAddress,
+        uint256 amount
+    );
+
+    /// @dev WithdrawValidatorCommission defines an Event emitted when validator commissions are being withdrawn
+    /// @param validatorAddress is the address of the validator
+    /// @param commission is the total commission of the EVM coin earned by the validator
+    event WithdrawValidatorCommission(
+        string indexed validatorAddress,
+        uint256 commission
+    );
+
+    /// @dev SetWithdrawerAddress defines an Event emitted when a new withdrawer address is being set
+    /// @param caller the caller of the transaction
+    /// @param withdrawerAddress the newly set withdrawer address
+    event SetWithdrawerAddress(
+        address indexed caller,
+        string withdrawerAddress
+    );
+
+    /// @dev FundCommunityPool defines an Event emitted when an account
+    /// funds the community pool.
+    /// @param depositor the address funding the community pool
+    /// @param amount the amount of the EVM coin funded
+    event FundCommunityPool(address indexed depositor, uint256 amount);
+
+    /// TRANSACTIONS
+
+    /// @dev Claims all rewards from a select set of validators or all of them for a delegator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param maxRetrieve The maximum number of validators to claim rewards from
+    /// @return success Whether the transaction was successful or not
+    function claimRewards(
+        address delegatorAddress,
+        uint32 maxRetrieve
+    ) external returns (bool success);
+
+    /// @dev Change the address, that can withdraw the rewards of a delegator.
+    /// Note that this address cannot be a module account.
+    /// @param delegatorAddress The address of the delegator
+    /// @param withdrawerAddress The address that will be capable of withdrawing rewards for
+    /// the given delegator address, in bech32 or hex format
+    function setWithdrawAddress(
+        address delegatorAddress,
+        string memory withdrawerAddress
+    ) external returns (bool success);
+
+    /// @dev Withdraw the rewards of a delegator from a validator
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The address of the validator
+    /// @return amount The amount of Coin withdrawn
+    function withdrawDelegatorRewards(
+        address delegatorAddress,
+        string memory validatorAddress
+    ) external returns (Coin[] calldata amount);
+
+    /// @dev Withdraws the rewards commission of a validator.
+    /// @param validatorAddress The address of the validator
+    /// @return amount The amount of Coin withdrawn
+    function withdrawValidatorCommission(
+        string memory validatorAddress
+    ) external returns (Coin[] calldata amount);
+
+    /// @dev fundCommunityPool defines a method to allow an account to directly
+    /// fund the community pool with the EVM coin.
+    /// @param depositor The address of the depositor
+    /// @param amount The amount of the EVM coin to fund the community pool with
+    /// @return success Whether the transaction was successful or not
+    function fundCommunityPool(
+        address depositor,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Queries the total rewards accrued by a delegation from a specific address to a given validator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The address of the validator
+    /// @return rewards The total rewards accrued by a delegation.
+    function delegationRewards(
+        address delegatorAddress,
+        string memory validatorAddress
+    ) external view returns (DecCoin[] calldata rewards);
+
+    /// @dev Queries the total rewards accrued by each validator, that a given
+    /// address has delegated to.
+    /// @param delegatorAddress The address of the delegator
+    /// @return rewards The total rewards accrued by each validator for a particular delegator.
+    /// @return total The total rewards accrued by a delegator.
+    function delegationTotalRewards(
+        address delegatorAddress
+    )
+        external
+        view
+        returns (
+            DelegationDelegatorReward[] calldata rewards,
+            DecCoin[] calldata total
+        );
+
+    /// @dev Queries the outstanding rewards of the validator address.
+    /// @param validatorAddress The address of the validator
+    /// @return rewards The validator's outstanding rewards
+    function validatorOutstandingRewards(
+        string memory validatorAddress
+    ) external view returns (DecCoin[] calldata rewards);
+}
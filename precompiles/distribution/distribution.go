@@ -0,0 +1,130 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package distribution
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for distribution.
+type Precompile struct {
+	cmn.Precompile
+	distributionKeeper distrkeeper.Keeper
+	stakingKeeper      *stakingkeeper.Keeper
+}
+
+// NewPrecompile creates a new distribution Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	distributionKeeper distrkeeper.Keeper,
+	stakingKeeper *stakingkeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.NewPrecompile(
+			newABI,
+			common.HexToAddress(evmtypes.DistributionPrecompileAddress),
+			storetypes.KVGasConfig(),
+			storetypes.TransientGasConfig(),
+		),
+		distributionKeeper: distributionKeeper,
+		stakingKeeper:      stakingKeeper,
+	}, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract distribution methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, readOnly, p.IsTransaction, p.execute)
+}
+
+// execute dispatches the call to the distribution method with the given arguments.
+func (p Precompile) execute(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// Distribution transactions
+	case ClaimRewardsMethod:
+		return p.ClaimRewards(ctx, contract, stateDB, method, args)
+	case SetWithdrawAddressMethod:
+		return p.SetWithdrawAddress(ctx, contract, stateDB, method, args)
+	case WithdrawDelegatorRewardsMethod:
+		return p.WithdrawDelegatorRewards(ctx, contract, stateDB, method, args)
+	case WithdrawValidatorCommissionMethod:
+		return p.WithdrawValidatorCommission(ctx, contract, stateDB, method, args)
+	case FundCommunityPoolMethod:
+		return p.FundCommunityPool(ctx, contract, stateDB, method, args)
+	// Distribution queries
+	case DelegationRewardsMethod:
+		return p.DelegationRewards(ctx, method, args)
+	case DelegationTotalRewardsMethod:
+		return p.DelegationTotalRewards(ctx, method, args)
+	case ValidatorOutstandingRewardsMethod:
+		return p.ValidatorOutstandingRewards(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available distribution transactions are:
+//   - ClaimRewards
+//   - SetWithdrawAddress
+//   - WithdrawDelegatorRewards
+//   - WithdrawValidatorCommission
+//   - FundCommunityPool
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case ClaimRewardsMethod,
+		SetWithdrawAddressMethod,
+		WithdrawDelegatorRewardsMethod,
+		WithdrawValidatorCommissionMethod,
+		FundCommunityPoolMethod:
+		return true
+	default:
+		return false
+	}
+}
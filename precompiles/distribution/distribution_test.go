@@ -0,0 +1,257 @@
+package distribution_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/precompiles/distribution"
+	"github.com/green901612/cosevm/testutil"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var (
+	precompileAddr = common.HexToAddress(evmtypes.DistributionPrecompileAddress)
+	// amount is the amount of the delegation, equal to the self-delegation
+	// of the genesis validator
+	amount = sdk.DefaultPowerReduction
+)
+
+type testSuite struct {
+	miniApp   *app.MiniApp
+	ctx       sdk.Context
+	abi       abi.ABI
+	delegator testutil.Account
+	validator sdk.ValAddress
+}
+
+// setupTest returns an application where the delegator holds half of the
+// shares of the genesis validator since the previous block.
+func setupTest(t *testing.T) *testSuite {
+	t.Helper()
+
+	delegator := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(delegator, amount.MulRaw(10)))
+
+	p, err := distribution.NewPrecompile(miniApp.DistrKeeper, miniApp.StakingKeeper)
+	require.NoError(t, err)
+
+	validator := testutil.GenesisValidator(t, miniApp, ctx)
+	_, err = stakingkeeper.NewMsgServerImpl(miniApp.StakingKeeper).Delegate(ctx, stakingtypes.NewMsgDelegate(
+		delegator.AccAddr.String(), validator.String(), sdk.NewCoin(testutil.Denom, amount),
+	))
+	require.NoError(t, err)
+
+	// the delegations don't earn rewards in the block they are created
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	return &testSuite{
+		miniApp:   miniApp,
+		ctx:       ctx,
+		abi:       p.ABI,
+		delegator: delegator,
+		validator: validator,
+	}
+}
+
+func (s *testSuite) call(from common.Address, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, _, err := testutil.CallContract(s.ctx, s.miniApp, from, precompileAddr, s.abi, method, args...)
+	return res, err
+}
+
+func (s *testSuite) balance(addr sdk.AccAddress) sdkmath.Int {
+	return s.miniApp.BankKeeper.GetBalance(s.ctx, addr, testutil.Denom).Amount
+}
+
+// allocateRewards allocates the given amount of rewards to the validator and
+// funds the distribution module with it.
+func (s *testSuite) allocateRewards(t *testing.T, rewards sdkmath.Int) {
+	t.Helper()
+
+	coins := sdk.NewCoins(sdk.NewCoin(testutil.Denom, rewards))
+	require.NoError(t, s.miniApp.BankKeeper.MintCoins(s.ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, s.miniApp.BankKeeper.SendCoinsFromModuleToModule(s.ctx, evmtypes.ModuleName, distributiontypes.ModuleName, coins))
+
+	validator, err := s.miniApp.StakingKeeper.GetValidator(s.ctx, s.validator)
+	require.NoError(t, err)
+	require.NoError(t, s.miniApp.DistrKeeper.AllocateTokensToValidator(s.ctx, validator, sdk.NewDecCoinsFromCoins(coins...)))
+}
+
+func TestClaimRewards(t *testing.T) {
+	s := setupTest(t)
+	s.allocateRewards(t, amount.MulRaw(2))
+	balanceBefore := s.balance(s.delegator.AccAddr)
+
+	// the delegator can only claim its own rewards
+	other := common.BytesToAddress(s.validator)
+	_, err := s.call(other, distribution.ClaimRewardsMethod, s.delegator.Address, uint32(1))
+	require.ErrorContains(t, err, "does not match the delegator address")
+
+	// the rewards can't be claimed from more than the maximum validators
+	_, err = s.call(s.delegator.Address, distribution.ClaimRewardsMethod, s.delegator.Address, uint32(stakingtypes.DefaultMaxValidators+1))
+	require.ErrorContains(t, err, "exceeds the maximum number of validators")
+
+	_, err = s.call(s.delegator.Address, distribution.ClaimRewardsMethod, s.delegator.Address, uint32(1))
+	require.NoError(t, err)
+
+	// the delegator holds half of the validator shares
+	require.Equal(t, balanceBefore.Add(amount), s.balance(s.delegator.AccAddr))
+	require.Equal(t, balanceBefore.Add(amount).BigInt(), s.miniApp.EvmKeeper.GetBalance(s.ctx, s.delegator.Address))
+}
+
+func TestWithdrawDelegatorRewards(t *testing.T) {
+	s := setupTest(t)
+	s.allocateRewards(t, amount.MulRaw(2))
+	withdrawer := testutil.NewAccount(t)
+	balanceBefore := s.balance(s.delegator.AccAddr)
+
+	_, err := s.call(s.delegator.Address, distribution.SetWithdrawAddressMethod, s.delegator.Address, withdrawer.AccAddr.String())
+	require.NoError(t, err)
+	withdrawAddr, err := s.miniApp.DistrKeeper.GetDelegatorWithdrawAddr(s.ctx, s.delegator.AccAddr)
+	require.NoError(t, err)
+	require.Equal(t, withdrawer.AccAddr, withdrawAddr)
+
+	res, err := s.call(s.delegator.Address, distribution.WithdrawDelegatorRewardsMethod, s.delegator.Address, s.validator.String())
+	require.NoError(t, err)
+
+	var out []cmn.Coin
+	require.NoError(t, s.abi.UnpackIntoInterface(&out, distribution.WithdrawDelegatorRewardsMethod, res.Ret))
+	require.Equal(t, []cmn.Coin{{Denom: testutil.Denom, Amount: amount.BigInt()}}, out)
+
+	// the rewards are sent to the withdraw address
+	require.Equal(t, amount, s.balance(withdrawer.AccAddr))
+	require.Equal(t, balanceBefore, s.balance(s.delegator.AccAddr))
+
+	// the withdrawn rewards are no longer pending
+	res, err = s.call(s.delegator.Address, distribution.DelegationRewardsMethod, s.delegator.Address, s.validator.String())
+	require.NoError(t, err)
+	var rewards []distribution.DecCoin
+	require.NoError(t, s.abi.UnpackIntoInterface(&rewards, distribution.DelegationRewardsMethod, res.Ret))
+	require.Empty(t, rewards)
+}
+
+func TestWithdrawValidatorCommission(t *testing.T) {
+	s := setupTest(t)
+
+	// half of the rewards are paid to the operator as commission
+	validator, err := s.miniApp.StakingKeeper.GetValidator(s.ctx, s.validator)
+	require.NoError(t, err)
+	validator.Commission.Rate = sdkmath.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, s.miniApp.StakingKeeper.SetValidator(s.ctx, validator))
+	s.allocateRewards(t, amount.MulRaw(2))
+
+	operator := common.BytesToAddress(s.validator)
+	balanceBefore := s.balance(operator.Bytes())
+
+	// only the operator can withdraw the commission
+	_, err = s.call(s.delegator.Address, distribution.WithdrawValidatorCommissionMethod, s.validator.String())
+	require.ErrorContains(t, err, "does not match the validator address")
+
+	res, err := s.call(operator, distribution.WithdrawValidatorCommissionMethod, s.validator.String())
+	require.NoError(t, err)
+
+	var out []cmn.Coin
+	require.NoError(t, s.abi.UnpackIntoInterface(&out, distribution.WithdrawValidatorCommissionMethod, res.Ret))
+	require.Equal(t, []cmn.Coin{{Denom: testutil.Denom, Amount: amount.BigInt()}}, out)
+	require.Equal(t, balanceBefore.Add(amount), s.balance(operator.Bytes()))
+
+	// the outstanding rewards are the ones of the delegations
+	res, err = s.call(s.delegator.Address, distribution.ValidatorOutstandingRewardsMethod, s.validator.String())
+	require.NoError(t, err)
+	var outstanding []distribution.DecCoin
+	require.NoError(t, s.abi.UnpackIntoInterface(&outstanding, distribution.ValidatorOutstandingRewardsMethod, res.Ret))
+	require.Len(t, outstanding, 1)
+	precision := new(big.Int).Exp(big.NewInt(10), big.NewInt(sdkmath.LegacyPrecision), nil)
+	require.Equal(t, new(big.Int).Mul(amount.BigInt(), precision), outstanding[0].Amount)
+}
+
+func TestFundCommunityPool(t *testing.T) {
+	s := setupTest(t)
+	balanceBefore := s.balance(s.delegator.AccAddr)
+	poolBefore, err := s.miniApp.DistrKeeper.FeePool.Get(s.ctx)
+	require.NoError(t, err)
+
+	_, err = s.call(s.delegator.Address, distribution.FundCommunityPoolMethod, s.delegator.Address, amount.BigInt())
+	require.NoError(t, err)
+
+	pool, err := s.miniApp.DistrKeeper.FeePool.Get(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, poolBefore.CommunityPool.AmountOf(testutil.Denom).Add(sdkmath.LegacyNewDecFromInt(amount)), pool.CommunityPool.AmountOf(testutil.Denom))
+	require.Equal(t, balanceBefore.Sub(amount), s.balance(s.delegator.AccAddr))
+	require.Equal(t, balanceBefore.Sub(amount).BigInt(), s.miniApp.EvmKeeper.GetBalance(s.ctx, s.delegator.Address))
+
+	// the depositor can't fund more than its balance
+	_, err = s.call(s.delegator.Address, distribution.FundCommunityPoolMethod, s.delegator.Address, balanceBefore.BigInt())
+	require.ErrorContains(t, err, "insufficient funds")
+	require.Equal(t, balanceBefore.Sub(amount), s.balance(s.delegator.AccAddr))
+}
+
+func TestDelegationTotalRewards(t *testing.T) {
+	s := setupTest(t)
+	s.allocateRewards(t, amount.MulRaw(2))
+
+	res, err := s.call(s.delegator.Address, distribution.DelegationTotalRewardsMethod, s.delegator.Address)
+	require.NoError(t, err)
+
+	var out struct {
+		Rewards []distribution.DelegationDelegatorReward
+		Total   []distribution.DecCoin
+	}
+	require.NoError(t, s.abi.UnpackIntoInterface(&out, distribution.DelegationTotalRewardsMethod, res.Ret))
+
+	// the amounts are scaled by the precision of the decimal coins
+	precision := new(big.Int).Exp(big.NewInt(10), big.NewInt(sdkmath.LegacyPrecision), nil)
+	expTotal := []distribution.DecCoin{{
+		Denom:     testutil.Denom,
+		Amount:    new(big.Int).Mul(amount.BigInt(), precision),
+		Precision: uint8(sdkmath.LegacyPrecision),
+	}}
+	require.Equal(t, []distribution.DelegationDelegatorReward{
+		{ValidatorAddress: s.validator.String(), Reward: expTotal},
+	}, out.Rewards)
+	require.Equal(t, expTotal, out.Total)
+}
+
+func TestPrecompile(t *testing.T) {
+	p, err := distribution.NewPrecompile(distrkeeper.Keeper{}, nil)
+	require.NoError(t, err)
+
+	gasConfig := storetypes.KVGasConfig()
+	argsBz := make([]byte, 32)
+
+	for _, name := range []string{
+		distribution.ClaimRewardsMethod,
+		distribution.SetWithdrawAddressMethod,
+		distribution.WithdrawDelegatorRewardsMethod,
+		distribution.WithdrawValidatorCommissionMethod,
+		distribution.FundCommunityPoolMethod,
+	} {
+		require.True(t, p.IsTransaction(name), name)
+		method := p.Methods[name]
+		expGas := gasConfig.WriteCostFlat + gasConfig.WriteCostPerByte*uint64(len(argsBz))
+		require.Equal(t, expGas, p.RequiredGas(append(method.ID, argsBz...)), name)
+	}
+
+	for _, name := range []string{
+		distribution.DelegationRewardsMethod,
+		distribution.DelegationTotalRewardsMethod,
+		distribution.ValidatorOutstandingRewardsMethod,
+	} {
+		require.False(t, p.IsTransaction(name), name)
+		method := p.Methods[name]
+		expGas := gasConfig.ReadCostFlat + gasConfig.ReadCostPerByte*uint64(len(argsBz))
+		require.Equal(t, expGas, p.RequiredGas(append(method.ID, argsBz...)), name)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package distribution
+
+const (
+	// ErrInvalidDelegator is raised when the delegator address is not valid.
+	ErrInvalidDelegator = "invalid delegator address: %v"
+	// ErrInvalidValidator is raised when the validator address is not valid.
+	ErrInvalidValidator = "invalid validator address: %v"
+	// ErrInvalidDepositor is raised when the depositor address is not valid.
+	ErrInvalidDepositor = "invalid depositor address: %v"
+	// ErrInvalidWithdrawer is raised when the withdrawer address is not valid.
+	ErrInvalidWithdrawer = "invalid withdrawer address: %v"
+	// ErrInvalidAmount is raised when the amount is not valid.
+	ErrInvalidAmount = "invalid amount: %v"
+	// ErrInvalidMaxRetrieve is raised when the number of validators to claim
+	// rewards from is not valid.
+	ErrInvalidMaxRetrieve = "invalid max retrieve: %v"
+	// ErrExceedMaxValidators is raised when the number of validators to claim
+	// rewards from exceeds the maximum number of validators.
+	ErrExceedMaxValidators = "maxRetrieve (%d) parameter exceeds the maximum number of validators (%d)"
+	// ErrDifferentCaller is raised when the caller of the precompile is not
+	// the account the transaction acts for.
+	ErrDifferentCaller = "caller address %s does not match the %s address %s"
+)
@@ -0,0 +1,91 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package distribution
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+const (
+	// EventTypeClaimRewards defines the event type for the distribution ClaimRewards transaction.
+	EventTypeClaimRewards = "ClaimRewards"
+	// EventTypeSetWithdrawAddress defines the event type for the distribution SetWithdrawAddress transaction.
+	EventTypeSetWithdrawAddress = "SetWithdrawerAddress"
+	// EventTypeWithdrawDelegatorRewards defines the event type for the distribution WithdrawDelegatorRewards transaction.
+	EventTypeWithdrawDelegatorRewards = "WithdrawDelegatorRewards"
+	// EventTypeWithdrawValidatorCommission defines the event type for the distribution WithdrawValidatorCommission transaction.
+	EventTypeWithdrawValidatorCommission = "WithdrawValidatorCommission"
+	// EventTypeFundCommunityPool defines the event type for the distribution FundCommunityPool transaction.
+	EventTypeFundCommunityPool = "FundCommunityPool"
+)
+
+// EmitClaimRewardsEvent creates a new event emitted on a ClaimRewards transaction.
+func (p Precompile) EmitClaimRewardsEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	delegatorAddr common.Address,
+	amount *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeClaimRewards,
+		[]interface{}{delegatorAddr},
+		amount,
+	)
+}
+
+// EmitSetWithdrawAddressEvent creates a new event emitted on a SetWithdrawAddress transaction.
+func (p Precompile) EmitSetWithdrawAddressEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	caller common.Address,
+	withdrawerAddress string,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeSetWithdrawAddress,
+		[]interface{}{caller},
+		withdrawerAddress,
+	)
+}
+
+// EmitWithdrawDelegatorRewardsEvent creates a new event emitted on a WithdrawDelegatorRewards transaction.
+func (p Precompile) EmitWithdrawDelegatorRewardsEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	delegatorAddr common.Address,
+	validatorAddr sdk.ValAddress,
+	amount *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeWithdrawDelegatorRewards,
+		[]interface{}{delegatorAddr, common.BytesToAddress(validatorAddr)},
+		amount,
+	)
+}
+
+// EmitWithdrawValidatorCommissionEvent creates a new event emitted on a WithdrawValidatorCommission transaction.
+func (p Precompile) EmitWithdrawValidatorCommissionEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	validatorAddress string,
+	commission *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeWithdrawValidatorCommission,
+		[]interface{}{validatorAddress},
+		commission,
+	)
+}
+
+// EmitFundCommunityPoolEvent creates a new event emitted on a FundCommunityPool transaction.
+func (p Precompile) EmitFundCommunityPoolEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	depositorAddr common.Address,
+	amount *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeFundCommunityPool,
+		[]interface{}{depositorAddr},
+		amount,
+	)
+}
@@ -0,0 +1,91 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// DelegationRewardsMethod defines the ABI method name for the distribution
+	// DelegationRewards query.
+	DelegationRewardsMethod = "delegationRewards"
+	// DelegationTotalRewardsMethod defines the ABI method name for the distribution
+	// DelegationTotalRewards query.
+	DelegationTotalRewardsMethod = "delegationTotalRewards"
+	// ValidatorOutstandingRewardsMethod defines the ABI method name for the
+	// distribution ValidatorOutstandingRewards query.
+	ValidatorOutstandingRewardsMethod = "validatorOutstandingRewards"
+)
+
+// DelegationRewards returns the total rewards accrued by a delegation from a
+// delegator to a validator.
+func (p Precompile) DelegationRewards(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, validatorAddr, err := ParseWithdrawDelegatorRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := distrkeeper.NewQuerier(p.distributionKeeper).DelegationRewards(ctx, &distributiontypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: sdk.AccAddress(delegatorAddr.Bytes()).String(),
+		ValidatorAddress: validatorAddr.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDecCoinsResponse(res.Rewards))
+}
+
+// DelegationTotalRewards returns the rewards accrued by a delegator from
+// each of the validators it has delegated to, and their total.
+func (p Precompile) DelegationTotalRewards(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, err := ParseDelegationTotalRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := distrkeeper.NewQuerier(p.distributionKeeper).DelegationTotalRewards(ctx, &distributiontypes.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: sdk.AccAddress(delegatorAddr.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, total := NewDelegationTotalRewardsResponse(res)
+
+	return method.Outputs.Pack(rewards, total)
+}
+
+// ValidatorOutstandingRewards returns the outstanding rewards of a
+// validator, which are not yet withdrawn by its delegators nor itself.
+func (p Precompile) ValidatorOutstandingRewards(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	validatorAddr, err := ParseValidatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := distrkeeper.NewQuerier(p.distributionKeeper).ValidatorOutstandingRewards(ctx, &distributiontypes.QueryValidatorOutstandingRewardsRequest{
+		ValidatorAddress: validatorAddr.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDecCoinsResponse(res.Rewards.Rewards))
+}
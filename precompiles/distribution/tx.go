@@ -0,0 +1,237 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+const (
+	// ClaimRewardsMethod defines the ABI method name for the custom
+	// ClaimRewards transaction.
+	ClaimRewardsMethod = "claimRewards"
+	// SetWithdrawAddressMethod defines the ABI method name for the distribution
+	// SetWithdrawAddress transaction.
+	SetWithdrawAddressMethod = "setWithdrawAddress"
+	// WithdrawDelegatorRewardsMethod defines the ABI method name for the distribution
+	// WithdrawDelegatorRewards transaction.
+	WithdrawDelegatorRewardsMethod = "withdrawDelegatorRewards"
+	// WithdrawValidatorCommissionMethod defines the ABI method name for the distribution
+	// WithdrawValidatorCommission transaction.
+	WithdrawValidatorCommissionMethod = "withdrawValidatorCommission"
+	// FundCommunityPoolMethod defines the ABI method name for the distribution
+	// FundCommunityPool transaction.
+	FundCommunityPoolMethod = "fundCommunityPool"
+)
+
+// ClaimRewards claims the rewards of a delegator from up to maxRetrieve of
+// the validators it has delegated to.
+func (p Precompile) ClaimRewards(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, maxRetrieve, err := ParseClaimRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCaller(contract, "delegator", delegatorAddr); err != nil {
+		return nil, err
+	}
+
+	maxValidators, err := p.stakingKeeper.MaxValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxRetrieve > maxValidators {
+		return nil, fmt.Errorf(ErrExceedMaxValidators, maxRetrieve, maxValidators)
+	}
+
+	res, err := distrkeeper.NewQuerier(p.distributionKeeper).DelegationTotalRewards(ctx, &distributiontypes.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: sdk.AccAddress(delegatorAddr.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := res.Rewards
+	if uint32(len(rewards)) > maxRetrieve { //#nosec G115 -- the number of delegations is bounded by the max validators
+		rewards = rewards[:maxRetrieve]
+	}
+
+	totalCoins := sdk.Coins{}
+	for _, reward := range rewards {
+		validatorAddr, err := sdk.ValAddressFromBech32(reward.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		coins, err := p.distributionKeeper.WithdrawDelegationRewards(ctx, delegatorAddr.Bytes(), validatorAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		totalCoins = totalCoins.Add(coins...)
+	}
+
+	if err := p.EmitClaimRewardsEvent(ctx, stateDB, delegatorAddr, evmCoinAmount(totalCoins)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SetWithdrawAddress sets the address that receives the rewards of a
+// delegator.
+func (p Precompile) SetWithdrawAddress(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, withdrawerAddr, err := ParseSetWithdrawAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCaller(contract, "delegator", delegatorAddr); err != nil {
+		return nil, err
+	}
+
+	msg := distributiontypes.NewMsgSetWithdrawAddress(delegatorAddr.Bytes(), withdrawerAddr)
+	if _, err := distrkeeper.NewMsgServerImpl(p.distributionKeeper).SetWithdrawAddress(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSetWithdrawAddressEvent(ctx, stateDB, delegatorAddr, msg.WithdrawAddress); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// WithdrawDelegatorRewards withdraws the rewards of a delegator from a
+// validator to the withdraw address of the delegator.
+func (p Precompile) WithdrawDelegatorRewards(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, validatorAddr, err := ParseWithdrawDelegatorRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCaller(contract, "delegator", delegatorAddr); err != nil {
+		return nil, err
+	}
+
+	msg := distributiontypes.NewMsgWithdrawDelegatorReward(sdk.AccAddress(delegatorAddr.Bytes()).String(), validatorAddr.String())
+
+	res, err := distrkeeper.NewMsgServerImpl(p.distributionKeeper).WithdrawDelegatorReward(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitWithdrawDelegatorRewardsEvent(ctx, stateDB, delegatorAddr, validatorAddr, evmCoinAmount(res.Amount)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewCoinsResponse(res.Amount))
+}
+
+// WithdrawValidatorCommission withdraws the accumulated commission of a
+// validator to the withdraw address of its operator. Only the operator
+// account can withdraw the commission.
+func (p Precompile) WithdrawValidatorCommission(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	validatorAddr, err := ParseValidatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCaller(contract, "validator", common.BytesToAddress(validatorAddr)); err != nil {
+		return nil, err
+	}
+
+	msg := distributiontypes.NewMsgWithdrawValidatorCommission(validatorAddr.String())
+
+	res, err := distrkeeper.NewMsgServerImpl(p.distributionKeeper).WithdrawValidatorCommission(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitWithdrawValidatorCommissionEvent(ctx, stateDB, validatorAddr.String(), evmCoinAmount(res.Amount)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewCoinsResponse(res.Amount))
+}
+
+// FundCommunityPool sends the given amount of the EVM coin from the
+// depositor to the community pool. The amount is given in 18 decimals.
+func (p Precompile) FundCommunityPool(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	depositorAddr, amount, err := ParseFundCommunityPoolArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCaller(contract, "depositor", depositorAddr); err != nil {
+		return nil, err
+	}
+
+	coin, err := cmn.NewSdkCoinFromBigInt(evmtypes.GetEVMCoinDenom(), evmtypes.ConvertAmountFrom18DecimalsBigInt(amount))
+	if err != nil {
+		return nil, err
+	}
+
+	msg := distributiontypes.NewMsgFundCommunityPool(sdk.NewCoins(coin), sdk.AccAddress(depositorAddr.Bytes()).String())
+
+	if _, err := distrkeeper.NewMsgServerImpl(p.distributionKeeper).FundCommunityPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitFundCommunityPoolEvent(ctx, stateDB, depositorAddr, evmtypes.ConvertAmountTo18DecimalsBigInt(coin.Amount.BigInt())); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// checkCaller checks that the caller of the precompile is the account the
+// transaction acts for.
+func checkCaller(contract *vm.Contract, role string, addr common.Address) error {
+	if contract.CallerAddress != addr {
+		return fmt.Errorf(ErrDifferentCaller, contract.CallerAddress, role, addr)
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package distribution
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// DecCoin defines a struct that stores the information of a decimal coin in
+// types native to the EVM. The amount is scaled by 10^precision.
+type DecCoin struct {
+	Denom     string
+	Amount    *big.Int
+	Precision uint8
+}
+
+// DelegationDelegatorReward defines the rewards of a delegator from a
+// validator in types native to the EVM.
+type DelegationDelegatorReward struct {
+	ValidatorAddress string
+	Reward           []DecCoin
+}
+
+// NewDecCoinsResponse converts the Cosmos SDK decimal coins into the ABI
+// representation. The amount of the EVM coin is converted to 18 decimals.
+func NewDecCoinsResponse(coins sdk.DecCoins) []DecCoin {
+	evmDenom := evmtypes.GetEVMCoinDenom()
+
+	res := make([]DecCoin, len(coins))
+	for i, coin := range coins {
+		amount := coin.Amount.BigInt()
+		if coin.Denom == evmDenom {
+			amount = evmtypes.ConvertAmountTo18DecimalsBigInt(amount)
+		}
+
+		res[i] = DecCoin{
+			Denom:     coin.Denom,
+			Amount:    amount,
+			Precision: math.LegacyPrecision,
+		}
+	}
+
+	return res
+}
+
+// NewCoinsResponse converts the Cosmos SDK coins into the ABI representation.
+// The amount of the EVM coin is converted to 18 decimals.
+func NewCoinsResponse(coins sdk.Coins) []cmn.Coin {
+	coins = evmtypes.ConvertCoinsTo18Decimals(coins)
+
+	res := make([]cmn.Coin, len(coins))
+	for i, coin := range coins {
+		res[i] = cmn.NewCoinResponse(coin)
+	}
+
+	return res
+}
+
+// NewDelegationTotalRewardsResponse converts the Cosmos SDK response of the
+// DelegationTotalRewards query into the ABI representation.
+func NewDelegationTotalRewardsResponse(res *distributiontypes.QueryDelegationTotalRewardsResponse) ([]DelegationDelegatorReward, []DecCoin) {
+	rewards := make([]DelegationDelegatorReward, len(res.Rewards))
+	for i, reward := range res.Rewards {
+		rewards[i] = DelegationDelegatorReward{
+			ValidatorAddress: reward.ValidatorAddress,
+			Reward:           NewDecCoinsResponse(reward.Reward),
+		}
+	}
+
+	return rewards, NewDecCoinsResponse(res.Total)
+}
+
+// evmCoinAmount returns the amount of the EVM coin in the given coins,
+// converted to 18 decimals.
+func evmCoinAmount(coins sdk.Coins) *big.Int {
+	amount := coins.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt()
+	return evmtypes.ConvertAmountTo18DecimalsBigInt(amount)
+}
+
+// ParseClaimRewardsArgs parses the arguments of the claimRewards method and
+// returns the delegator and the maximum number of validators to claim the
+// rewards from.
+func ParseClaimRewardsArgs(args []interface{}) (common.Address, uint32, error) {
+	if len(args) != 2 {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegatorAddr, err := parseDelegator(args[0])
+	if err != nil {
+		return common.Address{}, 0, err
+	}
+
+	maxRetrieve, ok := args[1].(uint32)
+	if !ok {
+		return common.Address{}, 0, fmt.Errorf(ErrInvalidMaxRetrieve, args[1])
+	}
+
+	return delegatorAddr, maxRetrieve, nil
+}
+
+// ParseSetWithdrawAddressArgs parses the arguments of the setWithdrawAddress
+// method and returns the delegator and the withdrawer address. The
+// withdrawer can be given either in bech32 or in hex format.
+func ParseSetWithdrawAddressArgs(args []interface{}) (common.Address, sdk.AccAddress, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegatorAddr, err := parseDelegator(args[0])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	withdrawerAddress, ok := args[1].(string)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidWithdrawer, args[1])
+	}
+
+	if common.IsHexAddress(withdrawerAddress) {
+		return delegatorAddr, common.HexToAddress(withdrawerAddress).Bytes(), nil
+	}
+
+	withdrawerAddr, err := sdk.AccAddressFromBech32(withdrawerAddress)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidWithdrawer, withdrawerAddress)
+	}
+
+	return delegatorAddr, withdrawerAddr, nil
+}
+
+// ParseWithdrawDelegatorRewardsArgs parses the arguments of the
+// withdrawDelegatorRewards method and the delegationRewards query and
+// returns the delegator and the validator.
+func ParseWithdrawDelegatorRewardsArgs(args []interface{}) (common.Address, sdk.ValAddress, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegatorAddr, err := parseDelegator(args[0])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	validatorAddr, err := parseValidator(args[1])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return delegatorAddr, validatorAddr, nil
+}
+
+// ParseValidatorArgs parses the arguments of the withdrawValidatorCommission
+// method and the validatorOutstandingRewards query.
+func ParseValidatorArgs(args []interface{}) (sdk.ValAddress, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return parseValidator(args[0])
+}
+
+// ParseFundCommunityPoolArgs parses the arguments of the fundCommunityPool
+// method and returns the depositor and the amount of the EVM coin in 18
+// decimals.
+func ParseFundCommunityPoolArgs(args []interface{}) (common.Address, *big.Int, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	depositorAddr, ok := args[0].(common.Address)
+	if !ok || depositorAddr == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidDepositor, args[0])
+	}
+
+	amount, ok := args[1].(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidAmount, args[1])
+	}
+
+	return depositorAddr, amount, nil
+}
+
+// ParseDelegationTotalRewardsArgs parses the arguments of the
+// delegationTotalRewards query.
+func ParseDelegationTotalRewardsArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return parseDelegator(args[0])
+}
+
+func parseDelegator(arg interface{}) (common.Address, error) {
+	delegatorAddr, ok := arg.(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidDelegator, arg)
+	}
+
+	return delegatorAddr, nil
+}
+
+func parseValidator(arg interface{}) (sdk.ValAddress, error) {
+	validatorAddress, ok := arg.(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidValidator, arg)
+	}
+
+	validatorAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidValidator, validatorAddress)
+	}
+
+	return validatorAddr, nil
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @author Evmos Team
+/// @title ERC-20 Precompiled Contract
+/// @dev The interface of the dynamic ERC-20 precompiles, which expose the
+/// bank balances of a native Cosmos coin as ERC-20 tokens. Each native coin
+/// with a registered token pair has its precompile at the address derived
+/// from its denomination.
+interface IERC20 {
+    /// @dev Emitted when `value` tokens are moved from one account (`from`) to
+    /// another (`to`).
+    event Transfer(address indexed from, address indexed to, uint256 value);
+
+    /// @dev Emitted when the allowance of a `spender` for an `owner` is set by
+    /// a call to {approve}. `value` is the new allowance.
+    event Approval(address indexed owner, address indexed spender, uint256 value);
+
+    /// @dev Returns the name of the token, taken from the bank metadata.
+    function name() external view returns (string memory);
+
+    /// @dev Returns the symbol of the token, taken from the bank metadata.
+    function symbol() external view returns (string memory);
+
+    /// @dev Returns the decimals places of the token, i.e. the exponent of the
+    /// display denomination of the bank metadata.
+    function decimals() external view returns (uint8);
+
+    /// @dev Returns the amount of tokens in existence.
+    function totalSupply() external view returns (uint256);
+
+    /// @dev Returns the amount of tokens owned by `account`.
+    function balanceOf(address account) external view returns (uint256);
+
+    /// @dev Returns the remaining number of tokens that `spender` will be
+    /// allowed to spend on behalf of `owner` through {transferFrom}.
+    function allowance(address owner, address spender) external view returns (uint256);
+
+    /// @dev Moves `amount` tokens from the caller's account to `to`.
+    function transfer(address to, uint256 amount) external returns (bool);
+
+    /// @dev Sets `amount` as the allowance of `spender` over the caller's tokens.
+    function approve(address spender, uint256 amount) external returns (bool);
+
+    /// @dev Moves `amount` tokens from `from` to `to` using the allowance
+    /// mechanism. `amount` is then deducted from the caller's allowance.
+    function transferFrom(address from, address to, uint256 amount) external returns (bool);
+
+    /// @dev Atomically increases the allowance granted to `spender` by the caller.
+    function increaseAllowance(address spender, uint256 addedValue) external returns (bool);
+
+    /// @dev Atomically decreases the allowance granted to `spender` by the caller.
+    function decreaseAllowance(address spender, uint256 subtractedValue) external returns (bool);
+}
@@ -0,0 +1,146 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package erc20
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract of an ERC-20 token whose
+// balances are the bank balances of a native Cosmos coin. Its amounts are
+// expressed in the base denomination of the coin.
+type Precompile struct {
+	cmn.Precompile
+	denom       string
+	bankKeeper  BankKeeper
+	erc20Keeper Erc20Keeper
+}
+
+// NewPrecompile creates a new ERC-20 Precompile instance for the given
+// denomination at the given address as a PrecompiledContract interface.
+func NewPrecompile(
+	address common.Address,
+	denom string,
+	bankKeeper BankKeeper,
+	erc20Keeper Erc20Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.NewPrecompile(
+			newABI,
+			address,
+			storetypes.KVGasConfig(),
+			storetypes.TransientGasConfig(),
+		),
+		denom:       denom,
+		bankKeeper:  bankKeeper,
+		erc20Keeper: erc20Keeper,
+	}, nil
+}
+
+// Denom returns the denomination of the native coin of the precompile.
+func (p Precompile) Denom() string {
+	return p.denom
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract ERC-20 methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, readOnly, p.IsTransaction, p.HandleMethod)
+}
+
+// HandleMethod dispatches the call to the ERC-20 method with the given
+// arguments. It is exported so that the precompiles extending the ERC-20 one
+// can delegate the standard methods to it.
+func (p Precompile) HandleMethod(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// ERC-20 transactions
+	case TransferMethod:
+		return p.Transfer(ctx, contract, stateDB, method, args)
+	case TransferFromMethod:
+		return p.TransferFrom(ctx, contract, stateDB, method, args)
+	case ApproveMethod:
+		return p.Approve(ctx, contract, stateDB, method, args)
+	case IncreaseAllowanceMethod:
+		return p.IncreaseAllowance(ctx, contract, stateDB, method, args)
+	case DecreaseAllowanceMethod:
+		return p.DecreaseAllowance(ctx, contract, stateDB, method, args)
+	// ERC-20 queries
+	case NameMethod:
+		return p.Name(ctx, method, args)
+	case SymbolMethod:
+		return p.Symbol(ctx, method, args)
+	case DecimalsMethod:
+		return p.Decimals(ctx, method, args)
+	case TotalSupplyMethod:
+		return p.TotalSupply(ctx, method, args)
+	case BalanceOfMethod:
+		return p.BalanceOf(ctx, method, args)
+	case AllowanceMethod:
+		return p.Allowance(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available ERC-20 transactions are:
+//   - Transfer
+//   - TransferFrom
+//   - Approve
+//   - IncreaseAllowance
+//   - DecreaseAllowance
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case TransferMethod,
+		TransferFromMethod,
+		ApproveMethod,
+		IncreaseAllowanceMethod,
+		DecreaseAllowanceMethod:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,322 @@
+package erc20_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/precompiles/erc20"
+	"github.com/green901612/cosevm/utils"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+const denom = "ufoo"
+
+var (
+	owner   = common.Address{0x1}
+	spender = common.Address{0x2}
+	blocked = common.Address{0x3}
+)
+
+// mockBankKeeper implements the bank methods used by the precompile with
+// in-memory balances.
+type mockBankKeeper struct {
+	balances map[string]math.Int
+	metadata banktypes.Metadata
+}
+
+func (m *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	amount, ok := m.balances[addr.String()]
+	if !ok {
+		amount = math.ZeroInt()
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+func (m *mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	supply := math.ZeroInt()
+	for _, amount := range m.balances {
+		supply = supply.Add(amount)
+	}
+	return sdk.NewCoin(denom, supply)
+}
+
+func (m *mockBankKeeper) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	return m.metadata, m.metadata.Base == denom
+}
+
+func (m *mockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	amount := amt.AmountOf(denom)
+	balance := m.GetBalance(ctx, fromAddr, denom).Amount
+	if balance.LT(amount) {
+		return errors.New("insufficient funds")
+	}
+
+	m.balances[fromAddr.String()] = balance.Sub(amount)
+	m.balances[toAddr.String()] = m.GetBalance(ctx, toAddr, denom).Amount.Add(amount)
+	return nil
+}
+
+func (m *mockBankKeeper) IsSendEnabledCoins(_ context.Context, _ ...sdk.Coin) error {
+	return nil
+}
+
+func (m *mockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return common.BytesToAddress(addr) == blocked
+}
+
+// mockErc20Keeper stores the allowances in memory.
+type mockErc20Keeper struct {
+	allowances map[[3]common.Address]*big.Int
+}
+
+func (m *mockErc20Keeper) GetAllowance(_ sdk.Context, erc20, owner, spender common.Address) *big.Int {
+	if allowance, ok := m.allowances[[3]common.Address{erc20, owner, spender}]; ok {
+		return allowance
+	}
+	return big.NewInt(0)
+}
+
+func (m *mockErc20Keeper) SetAllowance(_ sdk.Context, erc20, owner, spender common.Address, value *big.Int) {
+	m.allowances[[3]common.Address{erc20, owner, spender}] = value
+}
+
+// mockStateDB records the logs emitted by the precompile.
+type mockStateDB struct {
+	vm.StateDB
+	logs []*ethtypes.Log
+}
+
+func (m *mockStateDB) AddLog(log *ethtypes.Log) {
+	m.logs = append(m.logs, log)
+}
+
+func setupPrecompile(t *testing.T) (*erc20.Precompile, *mockBankKeeper, sdk.Context) {
+	t.Helper()
+
+	bk := &mockBankKeeper{
+		balances: map[string]math.Int{
+			sdk.AccAddress(owner.Bytes()).String(): math.NewInt(1_000),
+		},
+		metadata: banktypes.Metadata{
+			Base:    denom,
+			Display: "foo",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: denom, Exponent: 0},
+				{Denom: "foo", Exponent: 6},
+			},
+		},
+	}
+	ek := &mockErc20Keeper{allowances: make(map[[3]common.Address]*big.Int)}
+
+	p, err := erc20.NewPrecompile(utils.GetDenomAddress(denom), denom, bk, ek)
+	require.NoError(t, err)
+
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	return p, bk, ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+}
+
+func newContract(p *erc20.Precompile, caller common.Address) *vm.Contract {
+	return vm.NewContract(vm.AccountRef(caller), vm.AccountRef(p.Address()), big.NewInt(0), 100_000)
+}
+
+func TestIsTransaction(t *testing.T) {
+	p, _, _ := setupPrecompile(t)
+
+	for _, method := range []string{
+		erc20.TransferMethod,
+		erc20.TransferFromMethod,
+		erc20.ApproveMethod,
+		erc20.IncreaseAllowanceMethod,
+		erc20.DecreaseAllowanceMethod,
+	} {
+		require.True(t, p.IsTransaction(method), method)
+	}
+
+	for _, method := range []string{
+		erc20.NameMethod,
+		erc20.SymbolMethod,
+		erc20.DecimalsMethod,
+		erc20.TotalSupplyMethod,
+		erc20.BalanceOfMethod,
+		erc20.AllowanceMethod,
+	} {
+		require.False(t, p.IsTransaction(method), method)
+	}
+}
+
+func TestQueries(t *testing.T) {
+	p, bk, ctx := setupPrecompile(t)
+
+	t.Run("name and symbol", func(t *testing.T) {
+		method := p.Methods[erc20.NameMethod]
+		bz, err := p.Name(ctx, &method, nil)
+		require.NoError(t, err)
+		out, err := method.Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Equal(t, "foo", out[0])
+
+		method = p.Methods[erc20.SymbolMethod]
+		bz, err = p.Symbol(ctx, &method, nil)
+		require.NoError(t, err)
+		out, err = method.Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Equal(t, "FOO", out[0])
+	})
+
+	t.Run("decimals", func(t *testing.T) {
+		method := p.Methods[erc20.DecimalsMethod]
+		bz, err := p.Decimals(ctx, &method, nil)
+		require.NoError(t, err)
+		out, err := method.Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Equal(t, uint8(6), out[0])
+	})
+
+	t.Run("totalSupply and balanceOf", func(t *testing.T) {
+		method := p.Methods[erc20.TotalSupplyMethod]
+		bz, err := p.TotalSupply(ctx, &method, nil)
+		require.NoError(t, err)
+		out, err := method.Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1_000), out[0])
+
+		method = p.Methods[erc20.BalanceOfMethod]
+		bz, err = p.BalanceOf(ctx, &method, []interface{}{owner})
+		require.NoError(t, err)
+		out, err = method.Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1_000), out[0])
+	})
+
+	t.Run("no metadata", func(t *testing.T) {
+		metadata := bk.metadata
+		bk.metadata = banktypes.Metadata{}
+		defer func() { bk.metadata = metadata }()
+
+		method := p.Methods[erc20.NameMethod]
+		_, err := p.Name(ctx, &method, nil)
+		require.ErrorContains(t, err, "denom metadata not found")
+	})
+}
+
+func TestTransfer(t *testing.T) {
+	testCases := []struct {
+		name   string
+		to     common.Address
+		amount *big.Int
+		expErr string
+	}{
+		{"success", spender, big.NewInt(100), ""},
+		{"zero amount", spender, big.NewInt(0), ""},
+		{"fail - zero recipient", common.Address{}, big.NewInt(100), "invalid zero address for recipient"},
+		{"fail - blocked recipient", blocked, big.NewInt(100), "is not allowed to receive funds"},
+		{"fail - insufficient funds", spender, big.NewInt(2_000), "insufficient funds"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, bk, ctx := setupPrecompile(t)
+			stateDB := &mockStateDB{}
+			method := p.Methods[erc20.TransferMethod]
+
+			_, err := p.Transfer(ctx, newContract(p, owner), stateDB, &method, []interface{}{tc.to, tc.amount})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, stateDB.logs, 1)
+			require.Equal(t, p.Events[erc20.EventTypeTransfer].ID, stateDB.logs[0].Topics[0])
+			require.Equal(t, big.NewInt(tc.amount.Int64()), bk.GetBalance(ctx, tc.to.Bytes(), denom).Amount.BigInt())
+		})
+	}
+}
+
+func TestTransferFrom(t *testing.T) {
+	p, bk, ctx := setupPrecompile(t)
+	method := p.Methods[erc20.TransferFromMethod]
+	approve := p.Methods[erc20.ApproveMethod]
+	receiver := common.Address{0x4}
+
+	// no allowance
+	_, err := p.TransferFrom(ctx, newContract(p, spender), &mockStateDB{}, &method, []interface{}{owner, receiver, big.NewInt(100)})
+	require.ErrorContains(t, err, "insufficient allowance")
+
+	_, err = p.Approve(ctx, newContract(p, owner), &mockStateDB{}, &approve, []interface{}{spender, big.NewInt(150)})
+	require.NoError(t, err)
+
+	_, err = p.TransferFrom(ctx, newContract(p, spender), &mockStateDB{}, &method, []interface{}{owner, receiver, big.NewInt(100)})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), bk.GetBalance(ctx, receiver.Bytes(), denom).Amount.Int64())
+
+	allowance := p.Methods[erc20.AllowanceMethod]
+	bz, err := p.Allowance(ctx, &allowance, []interface{}{owner, spender})
+	require.NoError(t, err)
+	out, err := allowance.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(50), out[0])
+
+	// infinite allowance is not spent
+	_, err = p.Approve(ctx, newContract(p, owner), &mockStateDB{}, &approve, []interface{}{spender, abi.MaxUint256})
+	require.NoError(t, err)
+
+	_, err = p.TransferFrom(ctx, newContract(p, spender), &mockStateDB{}, &method, []interface{}{owner, receiver, big.NewInt(100)})
+	require.NoError(t, err)
+
+	bz, err = p.Allowance(ctx, &allowance, []interface{}{owner, spender})
+	require.NoError(t, err)
+	out, err = allowance.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, abi.MaxUint256, out[0])
+
+	// the owner does not need an allowance
+	_, err = p.TransferFrom(ctx, newContract(p, owner), &mockStateDB{}, &method, []interface{}{owner, receiver, big.NewInt(100)})
+	require.NoError(t, err)
+	require.Equal(t, int64(300), bk.GetBalance(ctx, receiver.Bytes(), denom).Amount.Int64())
+}
+
+func TestAllowances(t *testing.T) {
+	p, _, ctx := setupPrecompile(t)
+	increase := p.Methods[erc20.IncreaseAllowanceMethod]
+	decrease := p.Methods[erc20.DecreaseAllowanceMethod]
+	approve := p.Methods[erc20.ApproveMethod]
+	contract := newContract(p, owner)
+
+	_, err := p.Approve(ctx, contract, &mockStateDB{}, &approve, []interface{}{common.Address{}, big.NewInt(1)})
+	require.ErrorContains(t, err, "invalid zero address for spender")
+
+	stateDB := &mockStateDB{}
+	_, err = p.IncreaseAllowance(ctx, contract, stateDB, &increase, []interface{}{spender, big.NewInt(100)})
+	require.NoError(t, err)
+	require.Len(t, stateDB.logs, 1)
+	require.Equal(t, p.Events[erc20.EventTypeApproval].ID, stateDB.logs[0].Topics[0])
+
+	_, err = p.IncreaseAllowance(ctx, contract, &mockStateDB{}, &increase, []interface{}{spender, abi.MaxUint256})
+	require.ErrorContains(t, err, "allowance overflows uint256")
+
+	_, err = p.DecreaseAllowance(ctx, contract, &mockStateDB{}, &decrease, []interface{}{spender, big.NewInt(101)})
+	require.ErrorContains(t, err, "decreased allowance below zero")
+
+	_, err = p.DecreaseAllowance(ctx, contract, &mockStateDB{}, &decrease, []interface{}{spender, big.NewInt(40)})
+	require.NoError(t, err)
+
+	allowance := p.Methods[erc20.AllowanceMethod]
+	bz, err := p.Allowance(ctx, &allowance, []interface{}{owner, spender})
+	require.NoError(t, err)
+	out, err := allowance.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(60), out[0])
+}
@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package erc20
+
+const (
+	// ErrNoMetadata is raised when the native coin of the precompile has no
+	// bank metadata.
+	ErrNoMetadata = "denom metadata not found: %s"
+	// ErrInvalidDisplayDenom is raised when the display denomination of the
+	// bank metadata has no denomination unit.
+	ErrInvalidDisplayDenom = "display denomination %s not found in the denom units of %s"
+	// ErrDecimalsOverflow is raised when the exponent of the display
+	// denomination does not fit in the uint8 decimals of an ERC-20 token.
+	ErrDecimalsOverflow = "exponent of the display denomination %s overflows uint8: %d"
+	// ErrZeroAddress is raised when the zero address is given as the argument
+	// of an allowance method.
+	ErrZeroAddress = "invalid zero address for %s"
+	// ErrBlockedAddress is raised when the recipient of a transfer is not
+	// allowed to receive funds.
+	ErrBlockedAddress = "%s is not allowed to receive funds"
+	// ErrInsufficientAllowance is raised when the allowance of the spender is
+	// lower than the transferred amount.
+	ErrInsufficientAllowance = "ERC20: insufficient allowance: allowance %s, needed %s"
+	// ErrDecreasedAllowanceBelowZero is raised when the allowance is decreased
+	// by more than its current value.
+	ErrDecreasedAllowanceBelowZero = "ERC20: decreased allowance below zero: allowance %s, decreased by %s"
+	// ErrAllowanceOverflow is raised when the allowance is increased above the
+	// maximum uint256 value.
+	ErrAllowanceOverflow = "ERC20: allowance overflows uint256"
+)
@@ -0,0 +1,39 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package erc20
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+const (
+	// EventTypeTransfer defines the event type for the ERC-20 transfer and
+	// transferFrom transactions.
+	EventTypeTransfer = "Transfer"
+	// EventTypeApproval defines the event type for the ERC-20 approve,
+	// increaseAllowance and decreaseAllowance transactions.
+	EventTypeApproval = "Approval"
+)
+
+// EmitTransferEvent creates a new Transfer event emitted on transfer and
+// transferFrom transactions.
+func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, value *big.Int) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeTransfer,
+		[]interface{}{from, to},
+		value,
+	)
+}
+
+// EmitApprovalEvent creates a new Approval event emitted on approve,
+// increaseAllowance and decreaseAllowance transactions.
+func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner, spender common.Address, value *big.Int) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeApproval,
+		[]interface{}{owner, spender},
+		value,
+	)
+}
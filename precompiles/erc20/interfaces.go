@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package erc20
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BankKeeper defines the bank methods used by the ERC-20 precompile to manage
+// the balances of its native coin.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// Erc20Keeper defines the methods used by the ERC-20 precompile to manage the
+// allowances of its tokens.
+type Erc20Keeper interface {
+	GetAllowance(ctx sdk.Context, erc20, owner, spender common.Address) *big.Int
+	SetAllowance(ctx sdk.Context, erc20, owner, spender common.Address, value *big.Int)
+}
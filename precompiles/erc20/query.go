@@ -0,0 +1,151 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package erc20
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// NameMethod defines the ABI method name for the ERC-20 name query.
+	NameMethod = "name"
+	// SymbolMethod defines the ABI method name for the ERC-20 symbol query.
+	SymbolMethod = "symbol"
+	// DecimalsMethod defines the ABI method name for the ERC-20 decimals
+	// query.
+	DecimalsMethod = "decimals"
+	// TotalSupplyMethod defines the ABI method name for the ERC-20 totalSupply
+	// query.
+	TotalSupplyMethod = "totalSupply"
+	// BalanceOfMethod defines the ABI method name for the ERC-20 balanceOf
+	// query.
+	BalanceOfMethod = "balanceOf"
+	// AllowanceMethod defines the ABI method name for the ERC-20 allowance
+	// query.
+	AllowanceMethod = "allowance"
+)
+
+// Name returns the name of the token, which is the name of the bank metadata
+// of the coin, or its display denomination if the name is not set.
+func (p Precompile) Name(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	metadata, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := metadata.Name
+	if name == "" {
+		name = metadata.Display
+	}
+
+	return method.Outputs.Pack(name)
+}
+
+// Symbol returns the symbol of the token, which is the symbol of the bank
+// metadata of the coin, or its upper-cased display denomination if the symbol
+// is not set.
+func (p Precompile) Symbol(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	metadata, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := metadata.Symbol
+	if symbol == "" {
+		symbol = strings.ToUpper(metadata.Display)
+	}
+
+	return method.Outputs.Pack(symbol)
+}
+
+// Decimals returns the decimals of the token, which is the exponent of the
+// display denomination unit of the bank metadata of the coin.
+func (p Precompile) Decimals(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	metadata, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom != metadata.Display {
+			continue
+		}
+
+		if unit.Exponent > math.MaxUint8 {
+			return nil, fmt.Errorf(ErrDecimalsOverflow, unit.Denom, unit.Exponent)
+		}
+
+		return method.Outputs.Pack(uint8(unit.Exponent))
+	}
+
+	return nil, fmt.Errorf(ErrInvalidDisplayDenom, metadata.Display, p.denom)
+}
+
+// TotalSupply returns the total supply of the coin.
+func (p Precompile) TotalSupply(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	supply := p.bankKeeper.GetSupply(ctx, p.denom)
+	return method.Outputs.Pack(supply.Amount.BigInt())
+}
+
+// BalanceOf returns the bank balance of the coin of the given account.
+func (p Precompile) BalanceOf(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, err := ParseBalanceOfArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := p.bankKeeper.GetBalance(ctx, account.Bytes(), p.denom)
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
+
+// Allowance returns the amount of tokens that the spender is allowed to
+// transfer on behalf of the owner.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, err := ParseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance := p.erc20Keeper.GetAllowance(ctx, p.Address(), owner, spender)
+	return method.Outputs.Pack(allowance)
+}
+
+// metadata returns the bank metadata of the coin of the precompile.
+func (p Precompile) metadata(ctx sdk.Context) (banktypes.Metadata, error) {
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.denom)
+	if !found {
+		return banktypes.Metadata{}, fmt.Errorf(ErrNoMetadata, p.denom)
+	}
+
+	return metadata, nil
+}
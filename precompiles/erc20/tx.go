@@ -0,0 +1,217 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package erc20
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+const (
+	// TransferMethod defines the ABI method name for the ERC-20 transfer
+	// transaction.
+	TransferMethod = "transfer"
+	// TransferFromMethod defines the ABI method name for the ERC-20
+	// transferFrom transaction.
+	TransferFromMethod = "transferFrom"
+	// ApproveMethod defines the ABI method name for the ERC-20 approve
+	// transaction.
+	ApproveMethod = "approve"
+	// IncreaseAllowanceMethod defines the ABI method name for the ERC-20
+	// increaseAllowance transaction.
+	IncreaseAllowanceMethod = "increaseAllowance"
+	// DecreaseAllowanceMethod defines the ABI method name for the ERC-20
+	// decreaseAllowance transaction.
+	DecreaseAllowanceMethod = "decreaseAllowance"
+)
+
+// Transfer moves the given amount of tokens from the caller to the recipient.
+func (p Precompile) Transfer(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to, amount, err := ParseTransferArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.transfer(ctx, stateDB, method, contract.CallerAddress, to, amount)
+}
+
+// TransferFrom moves the given amount of tokens from the owner to the
+// recipient, deducting it from the allowance of the caller unless the caller
+// is the owner.
+func (p Precompile) TransferFrom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from, to, amount, err := ParseTransferFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	spender := contract.CallerAddress
+	if spender != from {
+		if err := p.spendAllowance(ctx, from, spender, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.transfer(ctx, stateDB, method, from, to, amount)
+}
+
+// Approve sets the given amount as the allowance of the spender over the
+// tokens of the caller.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, amount, err := ParseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.approve(ctx, stateDB, method, contract.CallerAddress, spender, amount)
+}
+
+// IncreaseAllowance increases the allowance of the spender over the tokens
+// of the caller by the given amount.
+func (p Precompile) IncreaseAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, addedValue, err := ParseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+	allowance := p.erc20Keeper.GetAllowance(ctx, p.Address(), owner, spender)
+
+	newAllowance := new(big.Int).Add(allowance, addedValue)
+	if newAllowance.Cmp(abi.MaxUint256) > 0 {
+		return nil, errors.New(ErrAllowanceOverflow)
+	}
+
+	return p.approve(ctx, stateDB, method, owner, spender, newAllowance)
+}
+
+// DecreaseAllowance decreases the allowance of the spender over the tokens
+// of the caller by the given amount.
+func (p Precompile) DecreaseAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, subtractedValue, err := ParseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+	allowance := p.erc20Keeper.GetAllowance(ctx, p.Address(), owner, spender)
+
+	if allowance.Cmp(subtractedValue) < 0 {
+		return nil, fmt.Errorf(ErrDecreasedAllowanceBelowZero, allowance, subtractedValue)
+	}
+
+	return p.approve(ctx, stateDB, method, owner, spender, new(big.Int).Sub(allowance, subtractedValue))
+}
+
+// transfer sends the given amount of the coin from the sender to the
+// recipient through the bank keeper and emits the Transfer event. Zero
+// transfers only emit the event, as required by the ERC-20 standard.
+func (p Precompile) transfer(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	from, to common.Address,
+	amount *big.Int,
+) ([]byte, error) {
+	if to == (common.Address{}) {
+		return nil, fmt.Errorf(ErrZeroAddress, "recipient")
+	}
+
+	if amount.Sign() > 0 {
+		coins := sdk.Coins{sdk.NewCoin(p.denom, math.NewIntFromBigInt(amount))}
+
+		if err := p.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return nil, err
+		}
+
+		if p.bankKeeper.BlockedAddr(to.Bytes()) {
+			return nil, fmt.Errorf(ErrBlockedAddress, to)
+		}
+
+		if err := p.bankKeeper.SendCoins(ctx, from.Bytes(), to.Bytes(), coins); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.EmitTransferEvent(ctx, stateDB, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// approve sets the allowance of the spender over the tokens of the owner and
+// emits the Approval event.
+func (p Precompile) approve(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	owner, spender common.Address,
+	amount *big.Int,
+) ([]byte, error) {
+	if spender == (common.Address{}) {
+		return nil, fmt.Errorf(ErrZeroAddress, "spender")
+	}
+
+	p.erc20Keeper.SetAllowance(ctx, p.Address(), owner, spender, amount)
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// spendAllowance deducts the given amount from the allowance of the spender
+// over the tokens of the owner. An allowance of the maximum uint256 value is
+// considered infinite and is not updated.
+func (p Precompile) spendAllowance(ctx sdk.Context, owner, spender common.Address, amount *big.Int) error {
+	allowance := p.erc20Keeper.GetAllowance(ctx, p.Address(), owner, spender)
+	if allowance.Cmp(abi.MaxUint256) == 0 {
+		return nil
+	}
+
+	if allowance.Cmp(amount) < 0 {
+		return fmt.Errorf(ErrInsufficientAllowance, allowance, amount)
+	}
+
+	p.erc20Keeper.SetAllowance(ctx, p.Address(), owner, spender, new(big.Int).Sub(allowance, amount))
+	return nil
+}
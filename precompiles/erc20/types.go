@@ -0,0 +1,107 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package erc20
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+)
+
+// ParseBalanceOfArgs parses the arguments of the balanceOf query and returns
+// the account address.
+func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "account", common.Address{}, args[0])
+	}
+
+	return account, nil
+}
+
+// ParseAllowanceArgs parses the arguments of the allowance query and returns
+// the owner and spender addresses.
+func ParseAllowanceArgs(args []interface{}) (owner, spender common.Address, err error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "owner", common.Address{}, args[0])
+	}
+
+	spender, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "spender", common.Address{}, args[1])
+	}
+
+	return owner, spender, nil
+}
+
+// ParseTransferArgs parses the arguments of the transfer method and returns
+// the recipient address and the amount.
+func ParseTransferArgs(args []interface{}) (to common.Address, amount *big.Int, err error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "to", common.Address{}, args[0])
+	}
+
+	amount, ok = args[1].(*big.Int)
+	if !ok || amount == nil {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "amount", &big.Int{}, args[1])
+	}
+
+	return to, amount, nil
+}
+
+// ParseTransferFromArgs parses the arguments of the transferFrom method and
+// returns the sender and recipient addresses and the amount.
+func ParseTransferFromArgs(args []interface{}) (from, to common.Address, amount *big.Int, err error) {
+	if len(args) != 3 {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "from", common.Address{}, args[0])
+	}
+
+	to, amount, err = ParseTransferArgs(args[1:])
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, err
+	}
+
+	return from, to, amount, nil
+}
+
+// ParseApproveArgs parses the arguments of the approve, increaseAllowance and
+// decreaseAllowance methods and returns the spender address and the amount.
+func ParseApproveArgs(args []interface{}) (spender common.Address, amount *big.Int, err error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	spender, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "spender", common.Address{}, args[0])
+	}
+
+	amount, ok = args[1].(*big.Int)
+	if !ok || amount == nil {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "amount", &big.Int{}, args[1])
+	}
+
+	return spender, amount, nil
+}
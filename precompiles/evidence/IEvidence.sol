@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IEvidence contract's address.
+address constant EVIDENCE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IEvidence contract's instance.
+IEvidence constant EVIDENCE_CONTRACT = IEvidence(EVIDENCE_PRECOMPILE_ADDRESS);
+
+/// @dev Equivocation represents evidence of a validator signing two
+/// conflicting blocks at the same height.
+struct Equivocation {
+    /// @dev The height at which the equivocation occurred
+    int64 height;
+    /// @dev The timestamp at which the equivocation occurred
+    int64 time;
+    /// @dev The validator's voting power at the time of the equivocation
+    int64 power;
+    /// @dev The validator consensus address in bech32 format
+    string consensusAddress;
+}
+
+/// @author Evmos Team
+/// @title Evidence Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// Cosmos SDK x/evidence module.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface IEvidence {
+    /// QUERIES
+
+    /// @dev Returns the evidence with the given hash.
+    /// @param evidenceHash The hash of the evidence
+    /// @return evidence The equivocation evidence
+    function evidence(
+        bytes32 evidenceHash
+    ) external view returns (Equivocation memory evidence);
+
+    /// @dev Returns all the evidence submitted to the chain.
+    /// @param pagination Pagination configuration for the query
+    /// @return evidence The list of equivocation evidence
+    /// @return pageResponse Pagination information for the response
+    function getAllEvidence(
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            Equivocation[] memory evidence,
+            PageResponse memory pageResponse
+        );
+}
@@ -0,0 +1,10 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evidence
+
+const (
+	// ErrInvalidEvidenceHash is raised when the evidence hash is not valid.
+	ErrInvalidEvidenceHash = "invalid evidence hash: %v"
+	// ErrUnsupportedEvidence is raised when the stored evidence is not an equivocation.
+	ErrUnsupportedEvidence = "unsupported evidence type: %T"
+)
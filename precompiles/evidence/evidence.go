@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evidence
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for evidence.
+type Precompile struct {
+	cmn.Precompile
+	evidenceKeeper evidencekeeper.Keeper
+}
+
+// NewPrecompile creates a new evidence Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(evidenceKeeper evidencekeeper.Keeper) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.NewPrecompile(
+			newABI,
+			common.HexToAddress(evmtypes.EvidencePrecompileAddress),
+			storetypes.KVGasConfig(),
+			storetypes.TransientGasConfig(),
+		),
+		evidenceKeeper: evidenceKeeper,
+	}, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract evidence methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, readOnly, p.IsTransaction, p.execute)
+}
+
+// execute dispatches the call to the evidence method with the given arguments.
+func (p Precompile) execute(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// evidence queries
+	case EvidenceMethod:
+		return p.Evidence(ctx, method, args)
+	case GetAllEvidenceMethod:
+		return p.GetAllEvidence(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// The evidence precompile only exposes queries.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
@@ -0,0 +1,77 @@
+package evidence_test
+
+import (
+	"testing"
+
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/precompiles/evidence"
+	"github.com/green901612/cosevm/testutil"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var precompileAddr = common.HexToAddress(evmtypes.EvidencePrecompileAddress)
+
+func TestEvidence(t *testing.T) {
+	caller := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(caller, sdk.DefaultPowerReduction))
+
+	p, err := evidence.NewPrecompile(miniApp.EvidenceKeeper)
+	require.NoError(t, err)
+
+	// the equivocation of a validator, as submitted by CometBFT
+	consAddr := sdk.ConsAddress(common.HexToAddress("0x1").Bytes())
+	equivocation := &evidencetypes.Equivocation{
+		Height:           1,
+		Time:             ctx.BlockTime().UTC(),
+		Power:            100,
+		ConsensusAddress: consAddr.String(),
+	}
+	require.NoError(t, miniApp.EvidenceKeeper.Evidences.Set(ctx, equivocation.Hash(), equivocation))
+
+	expEquivocation := evidence.Equivocation{
+		Height:           1,
+		Time:             ctx.BlockTime().Unix(),
+		Power:            100,
+		ConsensusAddress: consAddr.String(),
+	}
+
+	var hash [32]byte
+	copy(hash[:], equivocation.Hash())
+
+	res, _, err := testutil.CallContract(ctx, miniApp, caller.Address, precompileAddr, p.ABI, evidence.EvidenceMethod, hash)
+	require.NoError(t, err)
+	var out struct{ Evidence evidence.Equivocation }
+	require.NoError(t, p.UnpackIntoInterface(&out, evidence.EvidenceMethod, res.Ret))
+	require.Equal(t, expEquivocation, out.Evidence)
+
+	// the evidence of an unknown hash doesn't exist
+	_, _, err = testutil.CallContract(ctx, miniApp, caller.Address, precompileAddr, p.ABI, evidence.EvidenceMethod, [32]byte{1})
+	require.Error(t, err)
+
+	res, _, err = testutil.CallContract(
+		ctx, miniApp, caller.Address, precompileAddr, p.ABI, evidence.GetAllEvidenceMethod, cmn.PageRequest{Limit: 10, CountTotal: true},
+	)
+	require.NoError(t, err)
+	var page struct {
+		Evidence     []evidence.Equivocation
+		PageResponse cmn.PageResponse
+	}
+	require.NoError(t, p.UnpackIntoInterface(&page, evidence.GetAllEvidenceMethod, res.Ret))
+	require.Equal(t, []evidence.Equivocation{expEquivocation}, page.Evidence)
+	require.Equal(t, uint64(1), page.PageResponse.Total)
+}
+
+func TestPrecompile(t *testing.T) {
+	p, err := evidence.NewPrecompile(evidencekeeper.Keeper{})
+	require.NoError(t, err)
+
+	for _, name := range []string{evidence.EvidenceMethod, evidence.GetAllEvidenceMethod} {
+		require.False(t, p.IsTransaction(name), name)
+	}
+}
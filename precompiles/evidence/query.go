@@ -0,0 +1,78 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evidence
+
+import (
+	"encoding/hex"
+
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+)
+
+const (
+	// EvidenceMethod defines the ABI method name for the evidence Evidence
+	// query.
+	EvidenceMethod = "evidence"
+	// GetAllEvidenceMethod defines the ABI method name for the evidence
+	// AllEvidence query.
+	GetAllEvidenceMethod = "getAllEvidence"
+)
+
+// Evidence returns the evidence with the given hash.
+func (p Precompile) Evidence(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	hash, err := ParseEvidenceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := evidencekeeper.NewQuerier(&p.evidenceKeeper).Evidence(ctx, &evidencetypes.QueryEvidenceRequest{
+		Hash: hex.EncodeToString(hash[:]),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	equivocation, err := NewEquivocation(res.Evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(equivocation)
+}
+
+// GetAllEvidence returns all the evidence submitted to the chain.
+func (p Precompile) GetAllEvidence(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	pageRequest, err := ParseGetAllEvidenceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := evidencekeeper.NewQuerier(&p.evidenceKeeper).AllEvidence(ctx, &evidencetypes.QueryAllEvidenceRequest{
+		Pagination: pageRequest.ToPageRequest(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	evidence := make([]Equivocation, len(res.Evidence))
+	for i, evidenceAny := range res.Evidence {
+		evidence[i], err = NewEquivocation(evidenceAny)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(evidence, cmn.NewPageResponse(res.Pagination))
+}
@@ -0,0 +1,79 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evidence
+
+import (
+	"fmt"
+
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+)
+
+// Equivocation defines the evidence of a validator double signing in types
+// native to the EVM. The time is given as a unix timestamp in seconds.
+type Equivocation struct {
+	Height           int64
+	Time             int64
+	Power            int64
+	ConsensusAddress string
+}
+
+// NewEquivocation returns the ABI representation of the given evidence.
+// Only equivocation evidence is supported.
+func NewEquivocation(evidenceAny *codectypes.Any) (Equivocation, error) {
+	if evidenceAny == nil {
+		return Equivocation{}, fmt.Errorf(ErrUnsupportedEvidence, evidenceAny)
+	}
+
+	equivocation, ok := evidenceAny.GetCachedValue().(*evidencetypes.Equivocation)
+	if !ok {
+		return Equivocation{}, fmt.Errorf(ErrUnsupportedEvidence, evidenceAny.GetCachedValue())
+	}
+
+	return Equivocation{
+		Height:           equivocation.Height,
+		Time:             equivocation.Time.UTC().Unix(),
+		Power:            equivocation.Power,
+		ConsensusAddress: equivocation.ConsensusAddress,
+	}, nil
+}
+
+// ParseEvidenceArgs parses the arguments of the evidence query and returns
+// the evidence hash.
+func ParseEvidenceArgs(args []interface{}) ([32]byte, error) {
+	if len(args) != 1 {
+		return [32]byte{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	hash, ok := args[0].([32]byte)
+	if !ok || hash == ([32]byte{}) {
+		return [32]byte{}, fmt.Errorf(ErrInvalidEvidenceHash, args[0])
+	}
+
+	return hash, nil
+}
+
+// ParseGetAllEvidenceArgs parses the arguments of the getAllEvidence query
+// and returns the pagination request.
+func ParseGetAllEvidenceArgs(args []interface{}) (cmn.PageRequest, error) {
+	if len(args) != 1 {
+		return cmn.PageRequest{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// the ABI decodes the tuple into an anonymous struct with the same
+	// fields as the PageRequest type
+	pageRequest, ok := args[0].(struct {
+		Key        []byte `json:"key"`
+		Offset     uint64 `json:"offset"`
+		Limit      uint64 `json:"limit"`
+		CountTotal bool   `json:"countTotal"`
+		Reverse    bool   `json:"reverse"`
+	})
+	if !ok {
+		return cmn.PageRequest{}, fmt.Errorf(cmn.ErrInvalidType, "pagination", cmn.PageRequest{}, args[0])
+	}
+
+	return cmn.PageRequest(pageRequest), nil
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IGov contract's address.
+address constant GOV_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+/// @dev The IGov contract's instance.
+IGov constant GOV_CONTRACT = IGov(GOV_PRECOMPILE_ADDRESS);
+
+/// @dev VoteOption enumerates the valid vote options for a given governance proposal.
+enum VoteOption {
+    // Unspecified defines a no-op vote option.
+    Unspecified,
+    // Yes defines a yes vote option.
+    Yes,
+    // Abstain defines an abstain vote option.
+    Abstain,
+    // No defines a no vote option.
+    No,
+    // NoWithVeto defines a no with veto vote option.
+    NoWithVeto
+}
+
+/// @dev WeightedVoteOption defines a unit of vote for vote split.
+struct WeightedVoteOption {
+    VoteOption option;
+    string weight;
+}
+
+/// @dev WeightedVote represents a vote on a governance proposal
+struct WeightedVote {
+    uint64 proposalId;
+    address voter;
+    WeightedVoteOption[] options;
+    string metadata;
+}
+
+/// @dev TallyResultData represents the tally result of a proposal
+struct TallyResultData {
+    string yes;
+    string abstain;
+    string no;
+    string noWithVeto;
+}
+
+/// @dev ProposalData represents a governance proposal
+struct ProposalData {
+    uint64 id;
+    string[] messages;
+    uint32 status;
+    TallyResultData finalTallyResult;
+    uint64 submitTime;
+    uint64 depositEndTime;
+    Coin[] totalDeposit;
+    uint64 votingStartTime;
+    uint64 votingEndTime;
+    string metadata;
+    string title;
+    string summary;
+    address proposer;
+}
+
+/// @author Evmos Team
+/// @title Gov Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// Cosmos SDK x/gov module. The votes can only be cast by the voter itself,
+/// so that a contract votes with its own voting power.
+/// @custom:address 0x0000000000000000000000000000000000000805
+interface IGov {
+    /// @dev Vote defines an Event emitted when a proposal voted.
+    /// @param voter the address of the voter
+    /// @param proposalId the proposal of id
+    /// @param option the option for voter
+    event Vote(address indexed voter, uint64 proposalId, uint8 option);
+
+    /// @dev VoteWeighted defines an Event emitted when a proposal voted.
+    /// @param voter the address of the voter
+    /// @param proposalId the proposal of id
+    /// @param options the options for voter
+    event VoteWeighted(
+        address indexed voter,
+        uint64 proposalId,
+        WeightedVoteOption[] options
+    );
+
+    /// TRANSACTIONS
+
+    /// @dev vote defines a method to add a vote on a specific proposal.
+    /// @param voter The address of the voter
+    /// @param proposalId the proposal of id
+    /// @param option the option for voter
+    /// @param metadata the metadata for voter send
+    /// @return success Whether the transaction was successful or not
+    function vote(
+        address voter,
+        uint64 proposalId,
+        VoteOption option,
+        string memory metadata
+    ) external returns (bool success);
+
+    /// @dev voteWeighted defines a method to add a vote on a specific proposal.
+    /// @param voter The address of the voter
+    /// @param proposalId The proposal id
+    /// @param options The options for voter
+    /// @param metadata The metadata for voter send
+    /// @return success Whether the transaction was successful or not
+    function voteWeighted(
+        address voter,
+        uint64 proposalId,
+        WeightedVoteOption[] calldata options,
+        string memory metadata
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev getVote returns the vote of a single voter for a
+    /// given proposalId.
+    /// @param proposalId The proposal id
+    /// @param voter The voter on the proposal
+    /// @return vote Voter's vote for the proposal
+    function getVote(
+        uint64 proposalId,
+        address voter
+    ) external view returns (WeightedVote memory vote);
+
+    /// @dev getProposal returns proposal details based on proposal id
+    /// @param proposalId The proposal id
+    /// @return proposal The proposal data
+    function getProposal(
+        uint64 proposalId
+    ) external view returns (ProposalData memory proposal);
+
+    /// @dev getProposals returns proposals with matching status
+    /// @param proposalStatus The proposal status to filter by
+    /// @param voter The voter address to filter by, if any
+    /// @param depositor The depositor address to filter by, if any
+    /// @param pagination The pagination config
+    /// @return proposals The proposals matching the filter criteria
+    /// @return pageResponse The pagination response
+    function getProposals(
+        uint32 proposalStatus,
+        address voter,
+        address depositor,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            ProposalData[] memory proposals,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev getTallyResult returns the tally result of a proposal
+    /// @param proposalId The proposal id
+    /// @return tallyResult The tally result of the proposal
+    function getTallyResult(
+        uint64 proposalId
+    ) external view returns (TallyResultData memory tallyResult);
+}
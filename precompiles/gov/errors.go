@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+const (
+	// ErrInvalidVoter is raised when the voter address is not valid.
+	ErrInvalidVoter = "invalid voter address: %v"
+	// ErrInvalidProposalID is raised when the proposal ID is not valid.
+	ErrInvalidProposalID = "invalid proposal id: %v"
+	// ErrInvalidOption is raised when the vote option is not valid.
+	ErrInvalidOption = "invalid option: %v"
+	// ErrInvalidMetadata is raised when the metadata is not valid.
+	ErrInvalidMetadata = "invalid metadata: %v"
+	// ErrInvalidWeightedVoteOptions is raised when the weighted vote options
+	// are not valid.
+	ErrInvalidWeightedVoteOptions = "invalid weighted vote options: %v"
+	// ErrInvalidProposalStatus is raised when the proposal status is not valid.
+	ErrInvalidProposalStatus = "invalid proposal status: %v"
+	// ErrDifferentVoter is raised when the caller of the precompile is not the voter.
+	ErrDifferentVoter = "caller address %s does not match the voter address %s"
+)
@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+const (
+	// EventTypeVote defines the event type for the gov Vote transaction.
+	EventTypeVote = "Vote"
+	// EventTypeVoteWeighted defines the event type for the gov VoteWeighted transaction.
+	EventTypeVoteWeighted = "VoteWeighted"
+)
+
+// EmitVoteEvent creates a new event emitted on a Vote transaction.
+func (p Precompile) EmitVoteEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	voterAddr common.Address,
+	proposalID uint64,
+	option uint8,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeVote,
+		[]interface{}{voterAddr},
+		proposalID, option,
+	)
+}
+
+// EmitVoteWeightedEvent creates a new event emitted on a VoteWeighted transaction.
+func (p Precompile) EmitVoteWeightedEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	voterAddr common.Address,
+	proposalID uint64,
+	options []WeightedVoteOption,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeVoteWeighted,
+		[]interface{}{voterAddr},
+		proposalID, options,
+	)
+}
@@ -0,0 +1,113 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for gov.
+type Precompile struct {
+	cmn.Precompile
+	govKeeper *govkeeper.Keeper
+}
+
+// NewPrecompile creates a new gov Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(govKeeper *govkeeper.Keeper) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.NewPrecompile(
+			newABI,
+			common.HexToAddress(evmtypes.GovPrecompileAddress),
+			storetypes.KVGasConfig(),
+			storetypes.TransientGasConfig(),
+		),
+		govKeeper: govKeeper,
+	}, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract gov methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, readOnly, p.IsTransaction, p.execute)
+}
+
+// execute dispatches the call to the gov method with the given arguments.
+func (p Precompile) execute(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// gov transactions
+	case VoteMethod:
+		return p.Vote(ctx, contract, stateDB, method, args)
+	case VoteWeightedMethod:
+		return p.VoteWeighted(ctx, contract, stateDB, method, args)
+	// gov queries
+	case GetVoteMethod:
+		return p.GetVote(ctx, method, args)
+	case GetProposalMethod:
+		return p.GetProposal(ctx, method, args)
+	case GetProposalsMethod:
+		return p.GetProposals(ctx, method, args)
+	case GetTallyResultMethod:
+		return p.GetTallyResult(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available gov transactions are:
+//   - Vote
+//   - VoteWeighted
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case VoteMethod, VoteWeightedMethod:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,188 @@
+package gov_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/precompiles/gov"
+	"github.com/green901612/cosevm/testutil"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var (
+	precompileAddr = common.HexToAddress(evmtypes.GovPrecompileAddress)
+	// amount is the amount of the delegation of the voter
+	amount = sdk.DefaultPowerReduction
+)
+
+type testSuite struct {
+	miniApp    *app.MiniApp
+	ctx        sdk.Context
+	abi        abi.ABI
+	voter      testutil.Account
+	proposalID uint64
+}
+
+// setupTest returns an application with a proposal in voting period and a
+// voter that delegated to the genesis validator.
+func setupTest(t *testing.T) *testSuite {
+	t.Helper()
+
+	voter := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(voter, amount.MulRaw(10)))
+
+	p, err := gov.NewPrecompile(miniApp.GovKeeper)
+	require.NoError(t, err)
+
+	validator := testutil.GenesisValidator(t, miniApp, ctx)
+	_, err = stakingkeeper.NewMsgServerImpl(miniApp.StakingKeeper).Delegate(ctx, stakingtypes.NewMsgDelegate(
+		voter.AccAddr.String(), validator.String(), sdk.NewCoin(testutil.Denom, amount),
+	))
+	require.NoError(t, err)
+
+	proposal, err := miniApp.GovKeeper.SubmitProposal(ctx, nil, "metadata", "title", "summary", voter.AccAddr, false)
+	require.NoError(t, err)
+	require.NoError(t, miniApp.GovKeeper.ActivateVotingPeriod(ctx, proposal))
+
+	return &testSuite{
+		miniApp:    miniApp,
+		ctx:        ctx,
+		abi:        p.ABI,
+		voter:      voter,
+		proposalID: proposal.Id,
+	}
+}
+
+func (s *testSuite) call(from common.Address, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, _, err := testutil.CallContract(s.ctx, s.miniApp, from, precompileAddr, s.abi, method, args...)
+	return res, err
+}
+
+func (s *testSuite) getVote(t *testing.T) gov.WeightedVote {
+	t.Helper()
+
+	res, err := s.call(s.voter.Address, gov.GetVoteMethod, s.proposalID, s.voter.Address)
+	require.NoError(t, err)
+
+	var out struct{ Vote gov.WeightedVote }
+	require.NoError(t, s.abi.UnpackIntoInterface(&out, gov.GetVoteMethod, res.Ret))
+	return out.Vote
+}
+
+func TestVote(t *testing.T) {
+	s := setupTest(t)
+	option := uint8(govv1.OptionYes)
+
+	// only the voter can cast its vote
+	other := testutil.NewAccount(t)
+	require.NoError(t, testutil.FundAccount(s.ctx, s.miniApp, other, amount))
+	_, err := s.call(other.Address, gov.VoteMethod, s.voter.Address, s.proposalID, option, "")
+	require.ErrorContains(t, err, "does not match the voter address")
+
+	// the proposal must exist
+	_, err = s.call(s.voter.Address, gov.VoteMethod, s.voter.Address, s.proposalID+1, option, "")
+	require.Error(t, err)
+
+	_, err = s.call(s.voter.Address, gov.VoteMethod, s.voter.Address, s.proposalID, option, "metadata")
+	require.NoError(t, err)
+
+	require.Equal(t, gov.WeightedVote{
+		ProposalId: s.proposalID,
+		Voter:      s.voter.Address,
+		Options:    []gov.WeightedVoteOption{{Option: option, Weight: sdkmath.LegacyOneDec().String()}},
+		Metadata:   "metadata",
+	}, s.getVote(t))
+}
+
+func TestVoteWeighted(t *testing.T) {
+	s := setupTest(t)
+	options := []gov.WeightedVoteOption{
+		{Option: uint8(govv1.OptionYes), Weight: "0.6"},
+		{Option: uint8(govv1.OptionNo), Weight: "0.4"},
+	}
+
+	// the weights must add up to one
+	_, err := s.call(s.voter.Address, gov.VoteWeightedMethod, s.voter.Address, s.proposalID, options[:1], "")
+	require.Error(t, err)
+
+	_, err = s.call(s.voter.Address, gov.VoteWeightedMethod, s.voter.Address, s.proposalID, options, "")
+	require.NoError(t, err)
+
+	require.Equal(t, options, s.getVote(t).Options)
+}
+
+func TestGetTallyResult(t *testing.T) {
+	s := setupTest(t)
+
+	_, err := s.call(s.voter.Address, gov.VoteMethod, s.voter.Address, s.proposalID, uint8(govv1.OptionYes), "")
+	require.NoError(t, err)
+
+	res, err := s.call(s.voter.Address, gov.GetTallyResultMethod, s.proposalID)
+	require.NoError(t, err)
+
+	var out struct{ TallyResult gov.TallyResultData }
+	require.NoError(t, s.abi.UnpackIntoInterface(&out, gov.GetTallyResultMethod, res.Ret))
+	require.Equal(t, gov.TallyResultData{
+		Yes:        amount.String(),
+		Abstain:    "0",
+		No:         "0",
+		NoWithVeto: "0",
+	}, out.TallyResult)
+
+	// the tally of the query doesn't remove the votes of the proposal
+	require.Equal(t, s.voter.Address, s.getVote(t).Voter)
+}
+
+func TestGetProposals(t *testing.T) {
+	s := setupTest(t)
+
+	res, err := s.call(s.voter.Address, gov.GetProposalMethod, s.proposalID)
+	require.NoError(t, err)
+
+	var out struct{ Proposal gov.ProposalData }
+	require.NoError(t, s.abi.UnpackIntoInterface(&out, gov.GetProposalMethod, res.Ret))
+	require.Equal(t, s.proposalID, out.Proposal.Id)
+	require.Equal(t, uint32(govv1.StatusVotingPeriod), out.Proposal.Status)
+	require.Equal(t, "title", out.Proposal.Title)
+	require.Equal(t, "summary", out.Proposal.Summary)
+	require.Equal(t, s.voter.Address, out.Proposal.Proposer)
+
+	_, err = s.call(s.voter.Address, gov.GetProposalMethod, s.proposalID+1)
+	require.Error(t, err)
+
+	res, err = s.call(
+		s.voter.Address, gov.GetProposalsMethod,
+		uint32(govv1.StatusVotingPeriod), common.Address{}, common.Address{}, cmn.PageRequest{Limit: 10, CountTotal: true},
+	)
+	require.NoError(t, err)
+
+	var page struct {
+		Proposals    []gov.ProposalData
+		PageResponse cmn.PageResponse
+	}
+	require.NoError(t, s.abi.UnpackIntoInterface(&page, gov.GetProposalsMethod, res.Ret))
+	require.Len(t, page.Proposals, 1)
+	require.Equal(t, s.proposalID, page.Proposals[0].Id)
+	require.Equal(t, uint64(1), page.PageResponse.Total)
+}
+
+func TestPrecompile(t *testing.T) {
+	p, err := gov.NewPrecompile(nil)
+	require.NoError(t, err)
+
+	require.True(t, p.IsTransaction(gov.VoteMethod))
+	require.True(t, p.IsTransaction(gov.VoteWeightedMethod))
+	for _, name := range []string{gov.GetVoteMethod, gov.GetProposalMethod, gov.GetProposalsMethod, gov.GetTallyResultMethod} {
+		require.False(t, p.IsTransaction(name), name)
+	}
+}
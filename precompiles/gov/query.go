@@ -0,0 +1,129 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+)
+
+const (
+	// GetVoteMethod defines the ABI method name for the gov Vote query.
+	GetVoteMethod = "getVote"
+	// GetProposalMethod defines the ABI method name for the gov Proposal query.
+	GetProposalMethod = "getProposal"
+	// GetProposalsMethod defines the ABI method name for the gov Proposals query.
+	GetProposalsMethod = "getProposals"
+	// GetTallyResultMethod defines the ABI method name for the gov TallyResult query.
+	GetTallyResultMethod = "getTallyResult"
+)
+
+// GetVote returns the vote of a voter on a proposal.
+func (p Precompile) GetVote(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	proposalID, voterAddr, err := ParseGetVoteArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := govkeeper.NewQueryServer(p.govKeeper).Vote(ctx, &govv1.QueryVoteRequest{
+		ProposalId: proposalID,
+		Voter:      sdk.AccAddress(voterAddr.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vote, err := NewWeightedVote(*res.Vote)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(vote)
+}
+
+// GetProposal returns the proposal with the given ID.
+func (p Precompile) GetProposal(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	proposalID, err := ParseProposalIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := govkeeper.NewQueryServer(p.govKeeper).Proposal(ctx, &govv1.QueryProposalRequest{
+		ProposalId: proposalID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := NewProposalData(res.Proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(proposal)
+}
+
+// GetProposals returns the proposals matching the given status, voter and
+// depositor filters.
+func (p Precompile) GetProposals(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGetProposalsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := govkeeper.NewQueryServer(p.govKeeper).Proposals(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]ProposalData, len(res.Proposals))
+	for i, proposal := range res.Proposals {
+		proposals[i], err = NewProposalData(proposal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(proposals, cmn.NewPageResponse(res.Pagination))
+}
+
+// GetTallyResult returns the tally result of a proposal. The tally of a
+// proposal in its voting period is computed from the current votes.
+func (p Precompile) GetTallyResult(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	proposalID, err := ParseProposalIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the tally of a proposal in voting period removes its votes, so it
+	// runs on a cache context whose writes are discarded
+	cacheCtx, _ := ctx.CacheContext()
+	res, err := govkeeper.NewQueryServer(p.govKeeper).TallyResult(cacheCtx, &govv1.QueryTallyResultRequest{
+		ProposalId: proposalID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewTallyResultData(res.Tally))
+}
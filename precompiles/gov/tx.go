@@ -0,0 +1,99 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+const (
+	// VoteMethod defines the ABI method name for the gov Vote transaction.
+	VoteMethod = "vote"
+	// VoteWeightedMethod defines the ABI method name for the gov VoteWeighted transaction.
+	VoteWeightedMethod = "voteWeighted"
+)
+
+// Vote casts the vote of the voter on a proposal with a single option.
+func (p Precompile) Vote(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	voterAddr, proposalID, option, metadata, err := ParseVoteArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkVoter(contract, voterAddr); err != nil {
+		return nil, err
+	}
+
+	msg := govv1.NewMsgVote(voterAddr.Bytes(), proposalID, option, metadata)
+	if _, err := govkeeper.NewMsgServerImpl(p.govKeeper).Vote(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitVoteEvent(ctx, stateDB, voterAddr, proposalID, uint8(option)); err != nil { //#nosec G115 -- vote option is a small enum
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// VoteWeighted casts the vote of the voter on a proposal split across
+// several weighted options.
+func (p Precompile) VoteWeighted(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	voterAddr, proposalID, options, metadata, err := ParseVoteWeightedArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkVoter(contract, voterAddr); err != nil {
+		return nil, err
+	}
+
+	weightedOptions := make(govv1.WeightedVoteOptions, len(options))
+	for i, option := range options {
+		weightedOptions[i] = &govv1.WeightedVoteOption{
+			Option: govv1.VoteOption(option.Option),
+			Weight: option.Weight,
+		}
+	}
+
+	msg := govv1.NewMsgVoteWeighted(voterAddr.Bytes(), proposalID, weightedOptions, metadata)
+	if _, err := govkeeper.NewMsgServerImpl(p.govKeeper).VoteWeighted(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitVoteWeightedEvent(ctx, stateDB, voterAddr, proposalID, options); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// checkVoter checks that the caller of the precompile is the voter, so that
+// contracts can only vote with their own voting power.
+func checkVoter(contract *vm.Contract, voterAddr common.Address) error {
+	if contract.CallerAddress != voterAddr {
+		return fmt.Errorf(ErrDifferentVoter, contract.CallerAddress, voterAddr)
+	}
+
+	return nil
+}
@@ -0,0 +1,311 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// WeightedVoteOption defines a unit of vote for vote split in types native
+// to the EVM.
+type WeightedVoteOption struct {
+	Option uint8
+	Weight string
+}
+
+// WeightedVote defines the vote of a voter on a proposal in types native to
+// the EVM.
+type WeightedVote struct {
+	ProposalId uint64 //nolint:revive,stylecheck
+	Voter      common.Address
+	Options    []WeightedVoteOption
+	Metadata   string
+}
+
+// TallyResultData defines the tally result of a proposal in types native to
+// the EVM.
+type TallyResultData struct {
+	Yes        string
+	Abstain    string
+	No         string
+	NoWithVeto string
+}
+
+// ProposalData defines a governance proposal in types native to the EVM.
+// The messages are represented by their type URLs and the times by their
+// Unix timestamps.
+type ProposalData struct {
+	Id               uint64 //nolint:revive,stylecheck
+	Messages         []string
+	Status           uint32
+	FinalTallyResult TallyResultData
+	SubmitTime       uint64
+	DepositEndTime   uint64
+	TotalDeposit     []cmn.Coin
+	VotingStartTime  uint64
+	VotingEndTime    uint64
+	Metadata         string
+	Title            string
+	Summary          string
+	Proposer         common.Address
+}
+
+// NewWeightedVote returns the ABI representation of a vote.
+func NewWeightedVote(vote govv1.Vote) (WeightedVote, error) {
+	voterAddr, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return WeightedVote{}, err
+	}
+
+	options := make([]WeightedVoteOption, len(vote.Options))
+	for i, option := range vote.Options {
+		options[i] = WeightedVoteOption{
+			Option: uint8(option.Option), //#nosec G115 -- vote option is a small enum
+			Weight: option.Weight,
+		}
+	}
+
+	return WeightedVote{
+		ProposalId: vote.ProposalId,
+		Voter:      common.BytesToAddress(voterAddr),
+		Options:    options,
+		Metadata:   vote.Metadata,
+	}, nil
+}
+
+// NewTallyResultData returns the ABI representation of a tally result.
+func NewTallyResultData(tally *govv1.TallyResult) TallyResultData {
+	if tally == nil {
+		return TallyResultData{}
+	}
+
+	return TallyResultData{
+		Yes:        tally.YesCount,
+		Abstain:    tally.AbstainCount,
+		No:         tally.NoCount,
+		NoWithVeto: tally.NoWithVetoCount,
+	}
+}
+
+// NewProposalData returns the ABI representation of a proposal. The deposit
+// of the EVM coin is converted to 18 decimals.
+func NewProposalData(proposal *govv1.Proposal) (ProposalData, error) {
+	messages := make([]string, len(proposal.Messages))
+	for i, msg := range proposal.Messages {
+		messages[i] = msg.TypeUrl
+	}
+
+	totalDeposit := evmtypes.ConvertCoinsTo18Decimals(proposal.TotalDeposit)
+	coins := make([]cmn.Coin, len(totalDeposit))
+	for i, coin := range totalDeposit {
+		coins[i] = cmn.NewCoinResponse(coin)
+	}
+
+	var proposer common.Address
+	if proposal.Proposer != "" {
+		proposerAddr, err := sdk.AccAddressFromBech32(proposal.Proposer)
+		if err != nil {
+			return ProposalData{}, err
+		}
+		proposer = common.BytesToAddress(proposerAddr)
+	}
+
+	return ProposalData{
+		Id:               proposal.Id,
+		Messages:         messages,
+		Status:           uint32(proposal.Status), //#nosec G115 -- proposal status is a small enum
+		FinalTallyResult: NewTallyResultData(proposal.FinalTallyResult),
+		SubmitTime:       unixTime(proposal.SubmitTime),
+		DepositEndTime:   unixTime(proposal.DepositEndTime),
+		TotalDeposit:     coins,
+		VotingStartTime:  unixTime(proposal.VotingStartTime),
+		VotingEndTime:    unixTime(proposal.VotingEndTime),
+		Metadata:         proposal.Metadata,
+		Title:            proposal.Title,
+		Summary:          proposal.Summary,
+		Proposer:         proposer,
+	}, nil
+}
+
+// ParseVoteArgs parses the arguments of the vote method and returns the
+// voter, the proposal ID, the vote option and the metadata.
+func ParseVoteArgs(args []interface{}) (common.Address, uint64, govv1.VoteOption, string, error) {
+	if len(args) != 4 {
+		return common.Address{}, 0, 0, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	voterAddr, err := parseVoter(args[0])
+	if err != nil {
+		return common.Address{}, 0, 0, "", err
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return common.Address{}, 0, 0, "", fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	option, ok := args[2].(uint8)
+	if !ok {
+		return common.Address{}, 0, 0, "", fmt.Errorf(ErrInvalidOption, args[2])
+	}
+
+	metadata, ok := args[3].(string)
+	if !ok {
+		return common.Address{}, 0, 0, "", fmt.Errorf(ErrInvalidMetadata, args[3])
+	}
+
+	return voterAddr, proposalID, govv1.VoteOption(option), metadata, nil
+}
+
+// ParseVoteWeightedArgs parses the arguments of the voteWeighted method and
+// returns the voter, the proposal ID, the weighted vote options and the
+// metadata.
+func ParseVoteWeightedArgs(args []interface{}) (common.Address, uint64, []WeightedVoteOption, string, error) {
+	if len(args) != 4 {
+		return common.Address{}, 0, nil, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	voterAddr, err := parseVoter(args[0])
+	if err != nil {
+		return common.Address{}, 0, nil, "", err
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return common.Address{}, 0, nil, "", fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	// the ABI decodes the tuples into anonymous structs with the same
+	// fields as the WeightedVoteOption type
+	options, ok := args[2].([]struct {
+		Option uint8  `json:"option"`
+		Weight string `json:"weight"`
+	})
+	if !ok {
+		return common.Address{}, 0, nil, "", fmt.Errorf(ErrInvalidWeightedVoteOptions, args[2])
+	}
+
+	metadata, ok := args[3].(string)
+	if !ok {
+		return common.Address{}, 0, nil, "", fmt.Errorf(ErrInvalidMetadata, args[3])
+	}
+
+	weightedOptions := make([]WeightedVoteOption, len(options))
+	for i, option := range options {
+		weightedOptions[i] = WeightedVoteOption(option)
+	}
+
+	return voterAddr, proposalID, weightedOptions, metadata, nil
+}
+
+// ParseGetVoteArgs parses the arguments of the getVote query and returns the
+// proposal ID and the voter.
+func ParseGetVoteArgs(args []interface{}) (uint64, common.Address, error) {
+	if len(args) != 2 {
+		return 0, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return 0, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[0])
+	}
+
+	voterAddr, err := parseVoter(args[1])
+	if err != nil {
+		return 0, common.Address{}, err
+	}
+
+	return proposalID, voterAddr, nil
+}
+
+// ParseProposalIDArgs parses the arguments of the getProposal and
+// getTallyResult queries and returns the proposal ID.
+func ParseProposalIDArgs(args []interface{}) (uint64, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return 0, fmt.Errorf(ErrInvalidProposalID, args[0])
+	}
+
+	return proposalID, nil
+}
+
+// ParseGetProposalsArgs parses the arguments of the getProposals query and
+// returns the request to the x/gov module. The zero address disables the
+// voter and depositor filters.
+func ParseGetProposalsArgs(args []interface{}) (*govv1.QueryProposalsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	status, ok := args[0].(uint32)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidProposalStatus, args[0])
+	}
+
+	voterAddr, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidVoter, args[1])
+	}
+
+	depositorAddr, ok := args[2].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "depositor", common.Address{}, args[2])
+	}
+
+	// the ABI decodes the tuple into an anonymous struct with the same
+	// fields as the PageRequest type
+	pageRequest, ok := args[3].(struct {
+		Key        []byte `json:"key"`
+		Offset     uint64 `json:"offset"`
+		Limit      uint64 `json:"limit"`
+		CountTotal bool   `json:"countTotal"`
+		Reverse    bool   `json:"reverse"`
+	})
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "pagination", cmn.PageRequest{}, args[3])
+	}
+
+	req := &govv1.QueryProposalsRequest{
+		ProposalStatus: govv1.ProposalStatus(status), //#nosec G115 -- proposal status is a small enum
+		Pagination:     cmn.PageRequest(pageRequest).ToPageRequest(),
+	}
+	if voterAddr != (common.Address{}) {
+		req.Voter = sdk.AccAddress(voterAddr.Bytes()).String()
+	}
+	if depositorAddr != (common.Address{}) {
+		req.Depositor = sdk.AccAddress(depositorAddr.Bytes()).String()
+	}
+
+	return req, nil
+}
+
+func parseVoter(arg interface{}) (common.Address, error) {
+	voterAddr, ok := arg.(common.Address)
+	if !ok || voterAddr == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidVoter, arg)
+	}
+
+	return voterAddr, nil
+}
+
+// unixTime returns the Unix timestamp of the given time, or zero if it is
+// not set.
+func unixTime(t *time.Time) uint64 {
+	if t == nil {
+		return 0
+	}
+
+	return uint64(t.UTC().Unix()) //#nosec G115 -- proposal times are after the Unix epoch
+}
@@ -0,0 +1,72 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package p256
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/crypto/secp256r1"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+const (
+	// VerifyGas is the secp256r1 elliptic curve signature verifier gas price.
+	VerifyGas uint64 = 3450
+	// VerifyInputLength defines the required input length (160 bytes).
+	VerifyInputLength = 160
+)
+
+// Precompile secp256r1 (P256) signature verification
+// implemented as a native contract as per RIP-7212.
+// See https://github.com/ethereum/RIPs/blob/master/RIPS/rip-7212.md for details
+type Precompile struct{}
+
+// Address defines the address of the p256 precompiled contract.
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(evmtypes.P256PrecompileAddress)
+}
+
+// RequiredGas returns the static gas required to execute the precompiled contract.
+func (p Precompile) RequiredGas(_ []byte) uint64 {
+	return VerifyGas
+}
+
+// Run executes the p256 signature verification using ECDSA.
+//
+// Input data: 160 bytes of data including:
+//   - 32 bytes of the signed data hash
+//   - 32 bytes of the r component of the signature
+//   - 32 bytes of the s component of the signature
+//   - 32 bytes of the x coordinate of the public key
+//   - 32 bytes of the y coordinate of the public key
+//
+// Output data: 32 bytes of result data and error
+//   - If the signature verification process succeeds, it returns 1 in 32 bytes format
+//   - Otherwise, it returns empty data without any error
+func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
+	input := contract.Input
+	// Check the input length
+	if len(input) != VerifyInputLength {
+		// Input length is invalid
+		return nil, nil
+	}
+
+	// Extract the hash, r, s, x, y from the input
+	hash := input[0:32]
+	r, s := new(big.Int).SetBytes(input[32:64]), new(big.Int).SetBytes(input[64:96])
+	x, y := new(big.Int).SetBytes(input[96:128]), new(big.Int).SetBytes(input[128:160])
+
+	// Verify the secp256r1 signature
+	if secp256r1.Verify(hash, r, s, x, y) {
+		// Signature is valid
+		return common.LeftPadBytes(common.Big1.Bytes(), 32), nil
+	}
+
+	// Signature is invalid
+	return nil, nil
+}
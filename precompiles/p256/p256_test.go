@@ -0,0 +1,66 @@
+package p256_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/precompiles/p256"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+// signInput returns the 160 bytes input of the precompile for a signature of
+// the given message.
+func signInput(t *testing.T, priv *ecdsa.PrivateKey, msg []byte) []byte {
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, priv, hash[:])
+	require.NoError(t, err)
+
+	input := make([]byte, 0, p256.VerifyInputLength)
+	input = append(input, hash[:]...)
+	input = append(input, common.LeftPadBytes(r.Bytes(), 32)...)
+	input = append(input, common.LeftPadBytes(s.Bytes(), 32)...)
+	input = append(input, common.LeftPadBytes(priv.X.Bytes(), 32)...)
+	input = append(input, common.LeftPadBytes(priv.Y.Bytes(), 32)...)
+	return input
+}
+
+func TestRun(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	valid := signInput(t, priv, []byte("hello world"))
+	wrongKey := append(append([]byte{}, valid[:96]...), signInput(t, other, []byte("hello world"))[96:]...)
+
+	testCases := []struct {
+		name   string
+		input  []byte
+		expRes []byte
+	}{
+		{"valid signature", valid, common.LeftPadBytes([]byte{1}, 32)},
+		{"wrong public key", wrongKey, nil},
+		{"invalid input length", valid[:159], nil},
+		{"empty input", nil, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			precompile := &p256.Precompile{}
+			require.Equal(t, p256.VerifyGas, precompile.RequiredGas(tc.input))
+
+			contract := vm.NewPrecompile(vm.AccountRef(common.Address{}), precompile, common.Big0, p256.VerifyGas)
+			contract.Input = tc.input
+
+			bz, err := precompile.Run(nil, contract, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.expRes, bz)
+		})
+	}
+}
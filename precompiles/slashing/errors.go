@@ -0,0 +1,13 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package slashing
+
+const (
+	// ErrInvalidValidator is raised when the validator address is not valid.
+	ErrInvalidValidator = "invalid validator address: %v"
+	// ErrInvalidConsAddress is raised when the consensus address is not valid.
+	ErrInvalidConsAddress = "invalid consensus address: %v"
+	// ErrDifferentOperator is raised when the caller of the precompile is not
+	// the validator operator.
+	ErrDifferentOperator = "caller address %s does not match the validator operator address %s"
+)
@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+// EventTypeValidatorUnjailed defines the event type for the slashing Unjail transaction.
+const EventTypeValidatorUnjailed = "ValidatorUnjailed"
+
+// EmitValidatorUnjailedEvent creates a new event emitted on an Unjail transaction.
+func (p Precompile) EmitValidatorUnjailedEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	validatorAddr common.Address,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeValidatorUnjailed,
+		[]interface{}{validatorAddr},
+	)
+}
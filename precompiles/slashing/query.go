@@ -0,0 +1,94 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+)
+
+const (
+	// GetSigningInfoMethod defines the ABI method name for the slashing
+	// SigningInfo query.
+	GetSigningInfoMethod = "getSigningInfo"
+	// GetSigningInfosMethod defines the ABI method name for the slashing
+	// SigningInfos query.
+	GetSigningInfosMethod = "getSigningInfos"
+	// GetParamsMethod defines the ABI method name for the slashing Params
+	// query.
+	GetParamsMethod = "getParams"
+)
+
+// GetSigningInfo returns the signing info of the validator with the given
+// consensus address.
+func (p Precompile) GetSigningInfo(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	consAddr, err := ParseSigningInfoArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := slashingkeeper.NewQuerier(p.slashingKeeper).SigningInfo(ctx, &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: consAddr.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signingInfo, err := NewSigningInfo(res.ValSigningInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(signingInfo)
+}
+
+// GetSigningInfos returns the signing info of all the validators.
+func (p Precompile) GetSigningInfos(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	pageRequest, err := ParseSigningInfosArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := slashingkeeper.NewQuerier(p.slashingKeeper).SigningInfos(ctx, &slashingtypes.QuerySigningInfosRequest{
+		Pagination: pageRequest.ToPageRequest(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signingInfos := make([]SigningInfo, len(res.Info))
+	for i, info := range res.Info {
+		signingInfos[i], err = NewSigningInfo(info)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(signingInfos, cmn.NewPageResponse(res.Pagination))
+}
+
+// GetParams returns the parameters of the slashing module.
+func (p Precompile) GetParams(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	params, err := p.slashingKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewParams(params))
+}
@@ -0,0 +1,103 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package slashing
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for slashing.
+type Precompile struct {
+	cmn.Precompile
+	slashingKeeper slashingkeeper.Keeper
+}
+
+// NewPrecompile creates a new slashing Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(slashingKeeper slashingkeeper.Keeper) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.NewPrecompile(
+			newABI,
+			common.HexToAddress(evmtypes.SlashingPrecompileAddress),
+			storetypes.KVGasConfig(),
+			storetypes.TransientGasConfig(),
+		),
+		slashingKeeper: slashingKeeper,
+	}, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract slashing methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, readOnly, p.IsTransaction, p.execute)
+}
+
+// execute dispatches the call to the slashing method with the given arguments.
+func (p Precompile) execute(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// slashing transactions
+	case UnjailMethod:
+		return p.Unjail(ctx, contract, stateDB, method, args)
+	// slashing queries
+	case GetSigningInfoMethod:
+		return p.GetSigningInfo(ctx, method, args)
+	case GetSigningInfosMethod:
+		return p.GetSigningInfos(ctx, method, args)
+	case GetParamsMethod:
+		return p.GetParams(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available slashing transactions are:
+//   - Unjail
+func (Precompile) IsTransaction(method string) bool {
+	return method == UnjailMethod
+}
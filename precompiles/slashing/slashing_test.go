@@ -0,0 +1,152 @@
+package slashing_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/precompiles/slashing"
+	"github.com/green901612/cosevm/testutil"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var precompileAddr = common.HexToAddress(evmtypes.SlashingPrecompileAddress)
+
+type testSuite struct {
+	miniApp   *app.MiniApp
+	ctx       sdk.Context
+	abi       abi.ABI
+	validator sdk.ValAddress
+	consAddr  sdk.ConsAddress
+}
+
+// setupTest returns an application where the genesis validator is jailed for
+// downtime until the given time.
+func setupTest(t *testing.T, jailedUntil func(blockTime time.Time) time.Time) *testSuite {
+	t.Helper()
+
+	miniApp, ctx := testutil.Setup(t)
+
+	p, err := slashing.NewPrecompile(miniApp.SlashingKeeper)
+	require.NoError(t, err)
+
+	valAddr := testutil.GenesisValidator(t, miniApp, ctx)
+	validator, err := miniApp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	require.NoError(t, miniApp.SlashingKeeper.Jail(ctx, consAddr))
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, 1, 0, jailedUntil(ctx.BlockTime()), false, 0)
+	require.NoError(t, miniApp.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info))
+
+	return &testSuite{
+		miniApp:   miniApp,
+		ctx:       ctx,
+		abi:       p.ABI,
+		validator: valAddr,
+		consAddr:  consAddr,
+	}
+}
+
+func (s *testSuite) call(from common.Address, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, _, err := testutil.CallContract(s.ctx, s.miniApp, from, precompileAddr, s.abi, method, args...)
+	return res, err
+}
+
+func (s *testSuite) jailed(t *testing.T) bool {
+	t.Helper()
+
+	validator, err := s.miniApp.StakingKeeper.GetValidator(s.ctx, s.validator)
+	require.NoError(t, err)
+	return validator.IsJailed()
+}
+
+func TestUnjail(t *testing.T) {
+	s := setupTest(t, func(blockTime time.Time) time.Time { return blockTime })
+	operator := common.BytesToAddress(s.validator)
+
+	// only the operator can unjail its validator
+	other := testutil.NewAccount(t)
+	require.NoError(t, testutil.FundAccount(s.ctx, s.miniApp, other, sdk.DefaultPowerReduction))
+	_, err := s.call(other.Address, slashing.UnjailMethod, operator)
+	require.ErrorContains(t, err, "does not match")
+	require.True(t, s.jailed(t))
+
+	_, err = s.call(operator, slashing.UnjailMethod, operator)
+	require.NoError(t, err)
+	require.False(t, s.jailed(t))
+
+	// a validator that is not jailed can't be unjailed
+	_, err = s.call(operator, slashing.UnjailMethod, operator)
+	require.ErrorContains(t, err, slashingtypes.ErrValidatorNotJailed.Error())
+}
+
+func TestUnjailBeforeJailPeriod(t *testing.T) {
+	s := setupTest(t, func(blockTime time.Time) time.Time { return blockTime.Add(time.Hour) })
+	operator := common.BytesToAddress(s.validator)
+
+	_, err := s.call(operator, slashing.UnjailMethod, operator)
+	require.ErrorContains(t, err, slashingtypes.ErrValidatorJailed.Error())
+	require.True(t, s.jailed(t))
+}
+
+func TestGetSigningInfo(t *testing.T) {
+	s := setupTest(t, func(blockTime time.Time) time.Time { return blockTime })
+	expInfo := slashing.SigningInfo{
+		ValidatorAddress: common.BytesToAddress(s.consAddr),
+		StartHeight:      1,
+		JailedUntil:      s.ctx.BlockTime().Unix(),
+	}
+
+	res, err := s.call(common.BytesToAddress(s.validator), slashing.GetSigningInfoMethod, common.BytesToAddress(s.consAddr))
+	require.NoError(t, err)
+	var out struct{ SigningInfo slashing.SigningInfo }
+	require.NoError(t, s.abi.UnpackIntoInterface(&out, slashing.GetSigningInfoMethod, res.Ret))
+	require.Equal(t, expInfo, out.SigningInfo)
+
+	res, err = s.call(common.BytesToAddress(s.validator), slashing.GetSigningInfosMethod, cmn.PageRequest{Limit: 10, CountTotal: true})
+	require.NoError(t, err)
+	var page struct {
+		SigningInfos []slashing.SigningInfo
+		PageResponse cmn.PageResponse
+	}
+	require.NoError(t, s.abi.UnpackIntoInterface(&page, slashing.GetSigningInfosMethod, res.Ret))
+	require.Equal(t, []slashing.SigningInfo{expInfo}, page.SigningInfos)
+	require.Equal(t, uint64(1), page.PageResponse.Total)
+
+	// the signing info of an unknown consensus address doesn't exist
+	_, err = s.call(common.BytesToAddress(s.validator), slashing.GetSigningInfoMethod, common.Address{0x1})
+	require.Error(t, err)
+}
+
+func TestGetParams(t *testing.T) {
+	s := setupTest(t, func(blockTime time.Time) time.Time { return blockTime })
+
+	params, err := s.miniApp.SlashingKeeper.GetParams(s.ctx)
+	require.NoError(t, err)
+
+	res, err := s.call(common.BytesToAddress(s.validator), slashing.GetParamsMethod)
+	require.NoError(t, err)
+	var out struct{ Params slashing.Params }
+	require.NoError(t, s.abi.UnpackIntoInterface(&out, slashing.GetParamsMethod, res.Ret))
+	require.Equal(t, slashing.NewParams(params), out.Params)
+}
+
+func TestPrecompile(t *testing.T) {
+	p, err := slashing.NewPrecompile(slashingkeeper.Keeper{})
+	require.NoError(t, err)
+
+	require.True(t, p.IsTransaction(slashing.UnjailMethod))
+	for _, name := range []string{slashing.GetSigningInfoMethod, slashing.GetSigningInfosMethod, slashing.GetParamsMethod} {
+		require.False(t, p.IsTransaction(name), name)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+// UnjailMethod defines the ABI method name for the slashing Unjail
+// transaction.
+const UnjailMethod = "unjail"
+
+// Unjail unjails a validator jailed for downtime. Only the validator
+// operator can unjail its validator.
+func (p Precompile) Unjail(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	validatorAddr, err := ParseUnjailArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.CallerAddress != validatorAddr {
+		return nil, fmt.Errorf(ErrDifferentOperator, contract.CallerAddress, validatorAddr)
+	}
+
+	msg := slashingtypes.NewMsgUnjail(sdk.ValAddress(validatorAddr.Bytes()).String())
+	if _, err := slashingkeeper.NewMsgServerImpl(p.slashingKeeper).Unjail(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitValidatorUnjailedEvent(ctx, stateDB, validatorAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
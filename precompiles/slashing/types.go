@@ -0,0 +1,116 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+)
+
+// SigningInfo defines the signing info of a validator in types native to
+// the EVM. The validator is identified by its consensus address.
+type SigningInfo struct {
+	ValidatorAddress    common.Address
+	StartHeight         int64
+	IndexOffset         int64
+	JailedUntil         int64
+	Tombstoned          bool
+	MissedBlocksCounter int64
+}
+
+// Params defines the parameters of the slashing module in types native to
+// the EVM. The jail duration is given in seconds.
+type Params struct {
+	SignedBlocksWindow      int64
+	MinSignedPerWindow      cmn.Dec
+	DowntimeJailDuration    int64
+	SlashFractionDoubleSign cmn.Dec
+	SlashFractionDowntime   cmn.Dec
+}
+
+// NewSigningInfo returns the ABI representation of the signing info of a
+// validator.
+func NewSigningInfo(info slashingtypes.ValidatorSigningInfo) (SigningInfo, error) {
+	consAddr, err := sdk.ConsAddressFromBech32(info.Address)
+	if err != nil {
+		return SigningInfo{}, err
+	}
+
+	return SigningInfo{
+		ValidatorAddress:    common.BytesToAddress(consAddr),
+		StartHeight:         info.StartHeight,
+		IndexOffset:         info.IndexOffset,
+		JailedUntil:         info.JailedUntil.UTC().Unix(),
+		Tombstoned:          info.Tombstoned,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+	}, nil
+}
+
+// NewParams returns the ABI representation of the slashing parameters.
+func NewParams(params slashingtypes.Params) Params {
+	return Params{
+		SignedBlocksWindow:      params.SignedBlocksWindow,
+		MinSignedPerWindow:      cmn.NewDec(params.MinSignedPerWindow),
+		DowntimeJailDuration:    int64(params.DowntimeJailDuration.Seconds()),
+		SlashFractionDoubleSign: cmn.NewDec(params.SlashFractionDoubleSign),
+		SlashFractionDowntime:   cmn.NewDec(params.SlashFractionDowntime),
+	}
+}
+
+// ParseUnjailArgs parses the arguments of the unjail method and returns the
+// validator operator address.
+func ParseUnjailArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	validatorAddr, ok := args[0].(common.Address)
+	if !ok || validatorAddr == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidValidator, args[0])
+	}
+
+	return validatorAddr, nil
+}
+
+// ParseSigningInfoArgs parses the arguments of the getSigningInfo query and
+// returns the consensus address of the validator.
+func ParseSigningInfoArgs(args []interface{}) (sdk.ConsAddress, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	consAddr, ok := args[0].(common.Address)
+	if !ok || consAddr == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidConsAddress, args[0])
+	}
+
+	return consAddr.Bytes(), nil
+}
+
+// ParseSigningInfosArgs parses the arguments of the getSigningInfos query
+// and returns the pagination request.
+func ParseSigningInfosArgs(args []interface{}) (cmn.PageRequest, error) {
+	if len(args) != 1 {
+		return cmn.PageRequest{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// the ABI decodes the tuple into an anonymous struct with the same
+	// fields as the PageRequest type
+	pageRequest, ok := args[0].(struct {
+		Key        []byte `json:"key"`
+		Offset     uint64 `json:"offset"`
+		Limit      uint64 `json:"limit"`
+		CountTotal bool   `json:"countTotal"`
+		Reverse    bool   `json:"reverse"`
+	})
+	if !ok {
+		return cmn.PageRequest{}, fmt.Errorf(cmn.ErrInvalidType, "pagination", cmn.PageRequest{}, args[0])
+	}
+
+	return cmn.PageRequest(pageRequest), nil
+}
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../authorization/IAuthorization.sol";
+import "../common/Types.sol";
+
+/// @dev The IStaking contract's address.
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000800;
+
+/// @dev The IStaking contract's instance.
+IStaking constant STAKING_CONTRACT = IStaking(STAKING_PRECOMPILE_ADDRESS);
+
+/// @dev Define all the available staking methods.
+string constant MSG_DELEGATE = "delegate";
+string constant MSG_UNDELEGATE = "undelegate";
+string constant MSG_REDELEGATE = "redelegate";
+string constant MSG_CANCEL_UNDELEGATION = "cancelUnbondingDelegation";
+
+/// @dev Validator defines the validator information. The status follows the
+/// Cosmos SDK BondStatus enum: 0 unspecified, 1 unbonded, 2 unbonding and
+/// 3 bonded.
+struct Validator {
+    string operatorAddress;
+    string consensusPubkey;
+    bool jailed;
+    uint8 status;
+    uint256 tokens;
+    uint256 delegatorShares;
+    string description;
+    int64 unbondingHeight;
+    int64 unbondingTime;
+    uint256 commission;
+    uint256 minSelfDelegation;
+}
+
+/// @dev UnbondingDelegationEntry defines an unbonding delegation entry.
+struct UnbondingDelegationEntry {
+    int64 creationHeight;
+    int64 completionTime;
+    uint256 initialBalance;
+    uint256 balance;
+    uint64 unbondingId;
+    int64 unbondingOnHoldRefCount;
+}
+
+/// @dev UnbondingDelegationOutput defines the unbonding delegation of a
+/// delegator with a validator.
+struct UnbondingDelegationOutput {
+    string delegatorAddress;
+    string validatorAddress;
+    UnbondingDelegationEntry[] entries;
+}
+
+/// @author Evmos Team
+/// @title Staking Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// Cosmos SDK x/staking module. The validator addresses are the bech32
+/// operator addresses. A contract can only act on behalf of the delegator if
+/// it is the caller itself, or if the delegator approved it for the method.
+/// @custom:address 0x0000000000000000000000000000000000000800
+interface IStaking is IAuthorization {
+    /// @dev Defines a method for performing a delegation of coins from a delegator to a validator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount of the bond denomination to be delegated to the validator.
+    /// @return success Whether or not the delegate was successful
+    function delegate(
+        address delegatorAddress,
+        string memory validatorAddress,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Defines a method for performing an undelegation from a delegate and a validator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount of the bond denomination to be undelegated from the validator.
+    /// @return completionTime The time when the undelegation is completed
+    function undelegate(
+        address delegatorAddress,
+        string memory validatorAddress,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @dev Defines a method for performing a redelegation
+    /// of coins from a delegator and source validator to a destination validator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorSrcAddress The validator from which the redelegation is initiated
+    /// @param validatorDstAddress The validator to which the redelegation is destined
+    /// @param amount The amount of the bond denomination to be redelegated to the validator
+    /// @return completionTime The time when the redelegation is completed
+    function redelegate(
+        address delegatorAddress,
+        string memory validatorSrcAddress,
+        string memory validatorDstAddress,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @dev Allows delegators to cancel the unbondingDelegation entry
+    /// and to delegate back to a previous validator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount of the bond denomination to be canceled from the unbonding entry
+    /// @param creationHeight The height at which the unbonding took place
+    /// @return success Whether or not the unbonding delegation was cancelled
+    function cancelUnbondingDelegation(
+        address delegatorAddress,
+        string memory validatorAddress,
+        uint256 amount,
+        uint256 creationHeight
+    ) external returns (bool success);
+
+    /// @dev Queries the given amount of the bond denomination to a validator.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param validatorAddress The address of the validator.
+    /// @return shares The amount of shares, that the delegator has received.
+    /// @return balance The amount in Coin, that the delegator has delegated to the given validator.
+    function delegation(
+        address delegatorAddress,
+        string memory validatorAddress
+    ) external view returns (uint256 shares, Coin calldata balance);
+
+    /// @dev Returns the delegation shares and coins, that are currently
+    /// unbonding for a given delegator and validator pair.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param validatorAddress The address of the validator.
+    /// @return unbondingDelegation The delegations that are currently unbonding.
+    function unbondingDelegation(
+        address delegatorAddress,
+        string memory validatorAddress
+    )
+        external
+        view
+        returns (UnbondingDelegationOutput calldata unbondingDelegation);
+
+    /// @dev Queries validator info for a given validator address.
+    /// @param validatorAddress The address of the validator.
+    /// @return validator The validator info for the given validator address.
+    function validator(
+        string memory validatorAddress
+    ) external view returns (Validator calldata validator);
+
+    /// @dev Queries all validators that match the given status.
+    /// @param status Enables to query for validators matching a given status.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return validators The validators matching the given status.
+    /// @return pageResponse The pagination response of the query.
+    function validators(
+        string memory status,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            Validator[] calldata validators,
+            PageResponse calldata pageResponse
+        );
+
+    /// @dev Delegate defines an Event emitted when a given amount of tokens are delegated from the
+    /// delegator address to the validator address.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount of the bond denomination being delegated
+    /// @param newShares The new delegation shares being held
+    event Delegate(
+        address indexed delegatorAddress,
+        address indexed validatorAddress,
+        uint256 amount,
+        uint256 newShares
+    );
+
+    /// @dev Unbond defines an Event emitted when a given amount of tokens are unbonded from the
+    /// validator address to the delegator address.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount of the bond denomination being unbonded
+    /// @param completionTime The time at which the unbonding is completed
+    event Unbond(
+        address indexed delegatorAddress,
+        address indexed validatorAddress,
+        uint256 amount,
+        uint256 completionTime
+    );
+
+    /// @dev Redelegate defines an Event emitted when a given amount of tokens are redelegated from
+    /// the source validator address to the destination validator address.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorSrcAddress The address of the validator from which the delegation is retracted
+    /// @param validatorDstAddress The address of the validator to which the delegation is directed
+    /// @param amount The amount of the bond denomination being redelegated
+    /// @param completionTime The time at which the redelegation is completed
+    event Redelegate(
+        address indexed delegatorAddress,
+        address indexed validatorSrcAddress,
+        address indexed validatorDstAddress,
+        uint256 amount,
+        uint256 completionTime
+    );
+
+    /// @dev CancelUnbondingDelegation defines an Event emitted when a given amount of tokens
+    /// that are in the process of unbonding from the validator address are bonded again.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount of the bond denomination that was in the unbonding process which is to be canceled
+    /// @param creationHeight The block height at which the unbonding of a delegation was initiated
+    event CancelUnbondingDelegation(
+        address indexed delegatorAddress,
+        address indexed validatorAddress,
+        uint256 amount,
+        uint256 creationHeight
+    );
+}
@@ -0,0 +1,157 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package staking
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/green901612/cosevm/precompiles/authorization"
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+// authorizationTypes maps the staking methods that can be approved to the
+// corresponding x/staking authorization type.
+var authorizationTypes = map[string]stakingtypes.AuthorizationType{
+	DelegateMethod:                  stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+	UndelegateMethod:                stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE,
+	RedelegateMethod:                stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+	CancelUnbondingDelegationMethod: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION,
+}
+
+// Approve grants the spender the right to execute the given staking methods
+// on behalf of the caller, up to the given amount of the bond denomination.
+// The maximum uint256 value grants an unlimited allowance and a zero amount
+// removes the existing grants.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, amount, methods, err := authorization.ParseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	granter := contract.CallerAddress
+	if granter == grantee {
+		return nil, fmt.Errorf(authorization.ErrGranteeIsGranter, granter)
+	}
+
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expiration := ctx.BlockTime().Add(authorization.DefaultExpirationDuration).UTC()
+
+	for _, m := range methods {
+		msgURL, err := authorizationMsgURL(m)
+		if err != nil {
+			return nil, err
+		}
+
+		if amount.Sign() == 0 {
+			if authz, _ := p.authzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), msgURL); authz != nil {
+				if err := p.authzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), msgURL); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		// NOTE: an empty deny list allows the grantee to use any validator
+		stakeAuthz := &stakingtypes.StakeAuthorization{
+			Validators: &stakingtypes.StakeAuthorization_DenyList{
+				DenyList: &stakingtypes.StakeAuthorization_Validators{},
+			},
+			AuthorizationType: authorizationTypes[m],
+		}
+
+		if amount.Cmp(abi.MaxUint256) != 0 {
+			coin, err := cmn.NewSdkCoinFromBigInt(bondDenom, amount)
+			if err != nil {
+				return nil, err
+			}
+			stakeAuthz.MaxTokens = &coin
+		}
+
+		if err := p.authzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), stakeAuthz, &expiration); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := authorization.EmitApprovalEvent(
+		p.ABI.Events[authorization.EventTypeApproval],
+		stateDB,
+		p.Address(),
+		granter,
+		grantee,
+		methods,
+		amount,
+		uint64(ctx.BlockHeight()), //#nosec G115 -- block height is positive
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke removes the grants of the given staking methods previously approved
+// by the caller to the spender.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, methods, err := authorization.ParseRevokeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	granter := contract.CallerAddress
+
+	for _, m := range methods {
+		msgURL, err := authorizationMsgURL(m)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.authzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), msgURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := authorization.EmitRevocationEvent(
+		p.ABI.Events[authorization.EventTypeRevocation],
+		stateDB,
+		p.Address(),
+		granter,
+		grantee,
+		methods,
+		uint64(ctx.BlockHeight()), //#nosec G115 -- block height is positive
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// authorizationMsgURL returns the message type URL of the grants of the given
+// staking method.
+func authorizationMsgURL(method string) (string, error) {
+	authzType, ok := authorizationTypes[method]
+	if !ok {
+		return "", fmt.Errorf(ErrInvalidStakingMethod, method)
+	}
+
+	return stakingtypes.StakeAuthorization{AuthorizationType: authzType}.MsgTypeURL(), nil
+}
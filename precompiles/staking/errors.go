@@ -0,0 +1,18 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package staking
+
+const (
+	// ErrInvalidDelegator is raised when the delegator address is not valid.
+	ErrInvalidDelegator = "invalid delegator address: %v"
+	// ErrInvalidValidator is raised when the validator address is not valid.
+	ErrInvalidValidator = "invalid validator address: %v"
+	// ErrInvalidAmount is raised when the amount is not valid.
+	ErrInvalidAmount = "invalid amount: %v"
+	// ErrInvalidCreationHeight is raised when the creation height of an
+	// unbonding delegation is not valid.
+	ErrInvalidCreationHeight = "invalid creation height: %v"
+	// ErrInvalidStakingMethod is raised when the given method cannot be
+	// approved for a grantee.
+	ErrInvalidStakingMethod = "invalid staking method: %s"
+)
@@ -0,0 +1,80 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package staking
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+const (
+	// EventTypeDelegate defines the event type for the staking Delegate transaction.
+	EventTypeDelegate = "Delegate"
+	// EventTypeUnbond defines the event type for the staking Undelegate transaction.
+	EventTypeUnbond = "Unbond"
+	// EventTypeRedelegate defines the event type for the staking Redelegate transaction.
+	EventTypeRedelegate = "Redelegate"
+	// EventTypeCancelUnbondingDelegation defines the event type for the staking CancelUnbondingDelegation transaction.
+	EventTypeCancelUnbondingDelegation = "CancelUnbondingDelegation"
+)
+
+// EmitDelegateEvent creates a new delegate event emitted on a Delegate transaction.
+func (p Precompile) EmitDelegateEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	delegatorAddr common.Address,
+	validatorAddr sdk.ValAddress,
+	amount, newShares *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeDelegate,
+		[]interface{}{delegatorAddr, common.BytesToAddress(validatorAddr)},
+		amount, newShares,
+	)
+}
+
+// EmitUnbondEvent creates a new unbond event emitted on an Undelegate transaction.
+func (p Precompile) EmitUnbondEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	delegatorAddr common.Address,
+	validatorAddr sdk.ValAddress,
+	amount, completionTime *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeUnbond,
+		[]interface{}{delegatorAddr, common.BytesToAddress(validatorAddr)},
+		amount, completionTime,
+	)
+}
+
+// EmitRedelegateEvent creates a new redelegate event emitted on a Redelegate transaction.
+func (p Precompile) EmitRedelegateEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	delegatorAddr common.Address,
+	validatorSrcAddr, validatorDstAddr sdk.ValAddress,
+	amount, completionTime *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeRedelegate,
+		[]interface{}{delegatorAddr, common.BytesToAddress(validatorSrcAddr), common.BytesToAddress(validatorDstAddr)},
+		amount, completionTime,
+	)
+}
+
+// EmitCancelUnbondingDelegationEvent creates a new cancel unbonding delegation event emitted on a
+// CancelUnbondingDelegation transaction.
+func (p Precompile) EmitCancelUnbondingDelegationEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	delegatorAddr common.Address,
+	validatorAddr sdk.ValAddress,
+	amount, creationHeight *big.Int,
+) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeCancelUnbondingDelegation,
+		[]interface{}{delegatorAddr, common.BytesToAddress(validatorAddr)},
+		amount, creationHeight,
+	)
+}
@@ -0,0 +1,171 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package staking
+
+import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/green901612/cosevm/precompiles/authorization"
+	cmn "github.com/green901612/cosevm/precompiles/common"
+)
+
+const (
+	// DelegationMethod defines the ABI method name for the staking Delegation
+	// query.
+	DelegationMethod = "delegation"
+	// UnbondingDelegationMethod defines the ABI method name for the staking
+	// UnbondingDelegationMethod query.
+	UnbondingDelegationMethod = "unbondingDelegation"
+	// ValidatorMethod defines the ABI method name for the staking
+	// Validator query.
+	ValidatorMethod = "validator"
+	// ValidatorsMethod defines the ABI method name for the staking
+	// Validators query.
+	ValidatorsMethod = "validators"
+)
+
+// Delegation returns the delegation that a delegator has with a specific validator.
+// If the delegation does not exist, zero shares and balance are returned.
+func (p Precompile) Delegation(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, validatorAddr, err := ParseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := p.stakingKeeper.GetDelegation(ctx, delegatorAddr.Bytes(), validatorAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoDelegation) {
+			return method.Outputs.Pack(big.NewInt(0), cmn.Coin{Denom: bondDenom, Amount: big.NewInt(0)})
+		}
+		return nil, err
+	}
+
+	validator, err := p.stakingKeeper.GetValidator(ctx, validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	return method.Outputs.Pack(delegation.Shares.BigInt(), cmn.NewCoinResponse(balance))
+}
+
+// UnbondingDelegation returns the delegation currently being unbonded for a delegator from
+// a specific validator. If the unbonding delegation does not exist, an
+// output without entries is returned.
+func (p Precompile) UnbondingDelegation(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, validatorAddr, err := ParseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ubd, err := p.stakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr.Bytes(), validatorAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoUnbondingDelegation) {
+			return method.Outputs.Pack(UnbondingDelegationOutput{Entries: []UnbondingDelegationEntry{}})
+		}
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewUnbondingDelegationOutput(ubd))
+}
+
+// Validator returns the validator information for a given validator address.
+// If the validator does not exist, an empty validator is returned.
+func (p Precompile) Validator(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	validatorAddr, err := ParseValidatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := p.stakingKeeper.GetValidator(ctx, validatorAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return method.Outputs.Pack(DefaultValidatorInfo())
+		}
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewValidatorInfo(validator))
+}
+
+// Validators returns the validators information with a provided status & pagination (optional).
+func (p Precompile) Validators(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	status, pageRequest, err := ParseValidatorsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	querier := stakingkeeper.Querier{Keeper: p.stakingKeeper}
+	res, err := querier.Validators(ctx, &stakingtypes.QueryValidatorsRequest{
+		Status:     status,
+		Pagination: pageRequest.ToPageRequest(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]ValidatorInfo, len(res.Validators))
+	for i, validator := range res.Validators {
+		validators[i] = NewValidatorInfo(validator)
+	}
+
+	return method.Outputs.Pack(validators, cmn.NewPageResponse(res.Pagination))
+}
+
+// Allowance returns the remaining amount of the bond denomination that the
+// grantee can use through the given method on behalf of the granter.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, granter, stakingMethod, err := authorization.ParseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgURL, err := authorizationMsgURL(stakingMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	authz, _ := p.authzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), msgURL)
+	stakeAuthz, ok := authz.(*stakingtypes.StakeAuthorization)
+	if !ok {
+		return method.Outputs.Pack(big.NewInt(0))
+	}
+
+	if stakeAuthz.MaxTokens == nil {
+		return method.Outputs.Pack(abi.MaxUint256)
+	}
+
+	return method.Outputs.Pack(stakeAuthz.MaxTokens.Amount.BigInt())
+}
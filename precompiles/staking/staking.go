@@ -0,0 +1,153 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package staking
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/precompiles/authorization"
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for staking.
+type Precompile struct {
+	cmn.Precompile
+	stakingKeeper *stakingkeeper.Keeper
+	authzKeeper   authzkeeper.Keeper
+}
+
+// NewPrecompile creates a new staking Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	stakingKeeper *stakingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.NewPrecompile(
+			newABI,
+			common.HexToAddress(evmtypes.StakingPrecompileAddress),
+			storetypes.KVGasConfig(),
+			storetypes.TransientGasConfig(),
+		),
+		stakingKeeper: stakingKeeper,
+		authzKeeper:   authzKeeper,
+	}, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract staking methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, readOnly, p.IsTransaction, p.execute)
+}
+
+// execute dispatches the call to the staking method with the given arguments.
+func (p Precompile) execute(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	// Authorization transactions
+	case authorization.ApproveMethod:
+		return p.Approve(ctx, contract, stateDB, method, args)
+	case authorization.RevokeMethod:
+		return p.Revoke(ctx, contract, stateDB, method, args)
+	// Staking transactions
+	case DelegateMethod:
+		return p.Delegate(ctx, contract, stateDB, method, args)
+	case UndelegateMethod:
+		return p.Undelegate(ctx, contract, stateDB, method, args)
+	case RedelegateMethod:
+		return p.Redelegate(ctx, contract, stateDB, method, args)
+	case CancelUnbondingDelegationMethod:
+		return p.CancelUnbondingDelegation(ctx, contract, stateDB, method, args)
+	// Staking queries
+	case DelegationMethod:
+		return p.Delegation(ctx, method, args)
+	case UnbondingDelegationMethod:
+		return p.UnbondingDelegation(ctx, method, args)
+	case ValidatorMethod:
+		return p.Validator(ctx, method, args)
+	case ValidatorsMethod:
+		return p.Validators(ctx, method, args)
+	case authorization.AllowanceMethod:
+		return p.Allowance(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available staking transactions are:
+//   - Delegate
+//   - Undelegate
+//   - Redelegate
+//   - CancelUnbondingDelegation
+//
+// Available authorization transactions are:
+//   - Approve
+//   - Revoke
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case DelegateMethod,
+		UndelegateMethod,
+		RedelegateMethod,
+		CancelUnbondingDelegationMethod,
+		authorization.ApproveMethod,
+		authorization.RevokeMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkDelegator checks that the caller of the precompile is allowed to
+// execute the message on behalf of the delegator. The caller can always act
+// for itself, any other caller needs a grant of the delegator for the message.
+func (p Precompile) checkDelegator(ctx sdk.Context, contract *vm.Contract, delegatorAddr common.Address, msg sdk.Msg) error {
+	if contract.CallerAddress == delegatorAddr {
+		return nil
+	}
+
+	return authorization.AcceptGrant(ctx, p.authzKeeper, contract.CallerAddress, delegatorAddr, msg)
+}
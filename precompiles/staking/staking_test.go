@@ -0,0 +1,250 @@
+package staking_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	"github.com/green901612/cosevm/precompiles/staking"
+	"github.com/green901612/cosevm/testutil"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var (
+	precompileAddr = common.HexToAddress(evmtypes.StakingPrecompileAddress)
+	// amount is the amount of the bond denomination of the delegations
+	amount = sdk.DefaultPowerReduction
+)
+
+type testSuite struct {
+	miniApp   *app.MiniApp
+	ctx       sdk.Context
+	abi       abi.ABI
+	delegator testutil.Account
+	grantee   testutil.Account
+	validator sdk.ValAddress
+}
+
+func setupTest(t *testing.T) *testSuite {
+	t.Helper()
+
+	delegator := testutil.NewAccount(t)
+	grantee := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(
+		t,
+		testutil.NewBalance(delegator, amount.MulRaw(10)),
+		testutil.NewBalance(grantee, amount),
+	)
+
+	p, err := staking.NewPrecompile(miniApp.StakingKeeper, miniApp.AuthzKeeper)
+	require.NoError(t, err)
+
+	return &testSuite{
+		miniApp:   miniApp,
+		ctx:       ctx,
+		abi:       p.ABI,
+		delegator: delegator,
+		grantee:   grantee,
+		validator: testutil.GenesisValidator(t, miniApp, ctx),
+	}
+}
+
+func (s *testSuite) call(from testutil.Account, method string, args ...interface{}) ([]interface{}, error) {
+	_, outputs, err := testutil.CallContract(s.ctx, s.miniApp, from.Address, precompileAddr, s.abi, method, args...)
+	return outputs, err
+}
+
+func (s *testSuite) balance(acc testutil.Account) sdkmath.Int {
+	return s.miniApp.BankKeeper.GetBalance(s.ctx, acc.AccAddr, testutil.Denom).Amount
+}
+
+func (s *testSuite) delegation(t *testing.T, valAddr sdk.ValAddress) sdkmath.Int {
+	t.Helper()
+
+	delegation, err := s.miniApp.StakingKeeper.GetDelegation(s.ctx, s.delegator.AccAddr, valAddr)
+	if err != nil {
+		require.ErrorIs(t, err, stakingtypes.ErrNoDelegation)
+		return sdkmath.ZeroInt()
+	}
+
+	validator, err := s.miniApp.StakingKeeper.GetValidator(s.ctx, valAddr)
+	require.NoError(t, err)
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+func TestDelegate(t *testing.T) {
+	s := setupTest(t)
+	balanceBefore := s.balance(s.delegator)
+
+	outputs, err := s.call(s.delegator, staking.DelegateMethod, s.delegator.Address, s.validator.String(), amount.BigInt())
+	require.NoError(t, err)
+	require.Equal(t, true, outputs[0])
+
+	// the delegated coins are taken from the bank and the EVM balances
+	require.Equal(t, amount, s.delegation(t, s.validator))
+	require.Equal(t, balanceBefore.Sub(amount), s.balance(s.delegator))
+	require.Equal(t, balanceBefore.Sub(amount).BigInt(), s.miniApp.EvmKeeper.GetBalance(s.ctx, s.delegator.Address))
+
+	// the delegation can't exceed the balance
+	_, err = s.call(s.delegator, staking.DelegateMethod, s.delegator.Address, s.validator.String(), balanceBefore.BigInt())
+	require.ErrorContains(t, err, "insufficient funds")
+	require.Equal(t, balanceBefore.Sub(amount), s.balance(s.delegator))
+
+	// another account can't delegate on behalf of the delegator without a grant
+	_, err = s.call(s.grantee, staking.DelegateMethod, s.delegator.Address, s.validator.String(), amount.BigInt())
+	require.ErrorContains(t, err, "does not exist or is expired")
+	require.Equal(t, amount, s.delegation(t, s.validator))
+}
+
+func TestUndelegate(t *testing.T) {
+	s := setupTest(t)
+	balanceBefore := s.balance(s.delegator)
+
+	_, err := s.call(s.delegator, staking.DelegateMethod, s.delegator.Address, s.validator.String(), amount.MulRaw(2).BigInt())
+	require.NoError(t, err)
+
+	outputs, err := s.call(s.delegator, staking.UndelegateMethod, s.delegator.Address, s.validator.String(), amount.BigInt())
+	require.NoError(t, err)
+
+	unbondingTime, err := s.miniApp.StakingKeeper.UnbondingTime(s.ctx)
+	require.NoError(t, err)
+	completionTime := s.ctx.BlockTime().Add(unbondingTime).UTC().Unix()
+	require.Equal(t, completionTime, outputs[0])
+
+	// the undelegated coins are held by the unbonding delegation
+	require.Equal(t, amount, s.delegation(t, s.validator))
+	ubd, err := s.miniApp.StakingKeeper.GetUnbondingDelegation(s.ctx, s.delegator.AccAddr, s.validator)
+	require.NoError(t, err)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, amount, ubd.Entries[0].Balance)
+	require.Equal(t, balanceBefore.Sub(amount.MulRaw(2)), s.balance(s.delegator))
+
+	// the undelegation can't exceed the delegation
+	_, err = s.call(s.delegator, staking.UndelegateMethod, s.delegator.Address, s.validator.String(), amount.MulRaw(2).BigInt())
+	require.Error(t, err)
+	require.Equal(t, amount, s.delegation(t, s.validator))
+
+	// the canceled unbonding is delegated back to the validator
+	height := big.NewInt(s.ctx.BlockHeight())
+	_, err = s.call(s.delegator, staking.CancelUnbondingDelegationMethod, s.delegator.Address, s.validator.String(), amount.BigInt(), height)
+	require.NoError(t, err)
+	require.Equal(t, amount.MulRaw(2), s.delegation(t, s.validator))
+	_, err = s.miniApp.StakingKeeper.GetUnbondingDelegation(s.ctx, s.delegator.AccAddr, s.validator)
+	require.ErrorIs(t, err, stakingtypes.ErrNoUnbondingDelegation)
+}
+
+func TestRedelegate(t *testing.T) {
+	s := setupTest(t)
+	operator := testutil.NewAccount(t)
+	require.NoError(t, testutil.FundAccount(s.ctx, s.miniApp, operator, amount))
+	dstValidator := testutil.CreateValidator(t, s.miniApp, s.ctx, operator, amount)
+	balanceBefore := s.balance(s.delegator)
+
+	_, err := s.call(s.delegator, staking.DelegateMethod, s.delegator.Address, s.validator.String(), amount.MulRaw(2).BigInt())
+	require.NoError(t, err)
+
+	outputs, err := s.call(s.delegator, staking.RedelegateMethod, s.delegator.Address, s.validator.String(), dstValidator.String(), amount.BigInt())
+	require.NoError(t, err)
+
+	unbondingTime, err := s.miniApp.StakingKeeper.UnbondingTime(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, s.ctx.BlockTime().Add(unbondingTime).UTC().Unix(), outputs[0])
+
+	// the redelegated coins move between the validators, not the balances
+	require.Equal(t, amount, s.delegation(t, s.validator))
+	require.Equal(t, amount, s.delegation(t, dstValidator))
+	require.Equal(t, balanceBefore.Sub(amount.MulRaw(2)), s.balance(s.delegator))
+
+	red, err := s.miniApp.StakingKeeper.GetRedelegation(s.ctx, s.delegator.AccAddr, s.validator, dstValidator)
+	require.NoError(t, err)
+	require.Len(t, red.Entries, 1)
+
+	// the redelegation can't exceed the delegation
+	_, err = s.call(s.delegator, staking.RedelegateMethod, s.delegator.Address, s.validator.String(), dstValidator.String(), amount.MulRaw(2).BigInt())
+	require.Error(t, err)
+	require.Equal(t, amount, s.delegation(t, dstValidator))
+}
+
+func TestApprove(t *testing.T) {
+	s := setupTest(t)
+	msgURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	balanceBefore := s.balance(s.delegator)
+	granteeBalanceBefore := s.balance(s.grantee)
+
+	allowance := func() *big.Int {
+		outputs, err := s.call(s.grantee, "allowance", s.grantee.Address, s.delegator.Address, staking.DelegateMethod)
+		require.NoError(t, err)
+		return outputs[0].(*big.Int)
+	}
+
+	outputs, err := s.call(s.delegator, "approve", s.grantee.Address, amount.MulRaw(3).BigInt(), []string{staking.DelegateMethod})
+	require.NoError(t, err)
+	require.Equal(t, true, outputs[0])
+	require.Equal(t, amount.MulRaw(3).BigInt(), allowance())
+
+	// the delegator can't approve itself
+	_, err = s.call(s.delegator, "approve", s.delegator.Address, amount.BigInt(), []string{staking.DelegateMethod})
+	require.ErrorContains(t, err, "cannot be the same address")
+
+	// the grant only covers the approved methods
+	_, err = s.call(s.grantee, staking.UndelegateMethod, s.delegator.Address, s.validator.String(), amount.BigInt())
+	require.ErrorContains(t, err, "does not exist or is expired")
+
+	// the grantee delegates the coins of the delegator within the allowance,
+	// which is spent by the delegation
+	_, err = s.call(s.grantee, staking.DelegateMethod, s.delegator.Address, s.validator.String(), amount.MulRaw(2).BigInt())
+	require.NoError(t, err)
+	require.Equal(t, amount.MulRaw(2), s.delegation(t, s.validator))
+	require.Equal(t, balanceBefore.Sub(amount.MulRaw(2)), s.balance(s.delegator))
+	require.Equal(t, granteeBalanceBefore, s.balance(s.grantee))
+	require.Equal(t, amount.BigInt(), allowance())
+
+	// the delegation can't exceed the remaining allowance
+	_, err = s.call(s.grantee, staking.DelegateMethod, s.delegator.Address, s.validator.String(), amount.MulRaw(2).BigInt())
+	require.Error(t, err)
+	require.Equal(t, amount.MulRaw(2), s.delegation(t, s.validator))
+
+	// the maximum amount grants an unlimited allowance
+	_, err = s.call(s.delegator, "approve", s.grantee.Address, abi.MaxUint256, []string{staking.DelegateMethod})
+	require.NoError(t, err)
+	_, err = s.call(s.grantee, staking.DelegateMethod, s.delegator.Address, s.validator.String(), amount.MulRaw(5).BigInt())
+	require.NoError(t, err)
+	authz, _ := s.miniApp.AuthzKeeper.GetAuthorization(s.ctx, s.grantee.AccAddr, s.delegator.AccAddr, msgURL)
+	require.NotNil(t, authz)
+	require.Nil(t, authz.(*stakingtypes.StakeAuthorization).MaxTokens)
+
+	// a zero amount removes the grant
+	_, err = s.call(s.delegator, "approve", s.grantee.Address, big.NewInt(0), []string{staking.DelegateMethod})
+	require.NoError(t, err)
+	authz, _ = s.miniApp.AuthzKeeper.GetAuthorization(s.ctx, s.grantee.AccAddr, s.delegator.AccAddr, msgURL)
+	require.Nil(t, authz)
+	_, err = s.call(s.grantee, staking.DelegateMethod, s.delegator.Address, s.validator.String(), amount.BigInt())
+	require.ErrorContains(t, err, "does not exist or is expired")
+}
+
+func TestRevoke(t *testing.T) {
+	s := setupTest(t)
+	methods := []string{staking.DelegateMethod, staking.UndelegateMethod}
+
+	_, err := s.call(s.delegator, "approve", s.grantee.Address, amount.BigInt(), methods)
+	require.NoError(t, err)
+
+	_, err = s.call(s.delegator, "revoke", s.grantee.Address, []string{staking.UndelegateMethod})
+	require.NoError(t, err)
+
+	delegateAuthz, _ := s.miniApp.AuthzKeeper.GetAuthorization(s.ctx, s.grantee.AccAddr, s.delegator.AccAddr, sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}))
+	require.NotNil(t, delegateAuthz)
+	undelegateAuthz, _ := s.miniApp.AuthzKeeper.GetAuthorization(s.ctx, s.grantee.AccAddr, s.delegator.AccAddr, sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}))
+	require.Nil(t, undelegateAuthz)
+
+	// a revoked grant can't be revoked again
+	_, err = s.call(s.delegator, "revoke", s.grantee.Address, []string{staking.UndelegateMethod})
+	require.Error(t, err)
+}
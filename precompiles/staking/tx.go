@@ -0,0 +1,205 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package staking
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+const (
+	// DelegateMethod defines the ABI method name for the staking Delegate
+	// transaction.
+	DelegateMethod = "delegate"
+	// UndelegateMethod defines the ABI method name for the staking Undelegate
+	// transaction.
+	UndelegateMethod = "undelegate"
+	// RedelegateMethod defines the ABI method name for the staking Redelegate
+	// transaction.
+	RedelegateMethod = "redelegate"
+	// CancelUnbondingDelegationMethod defines the ABI method name for the staking
+	// CancelUnbondingDelegation transaction.
+	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
+)
+
+// Delegate performs a delegation of coins from a delegator to a validator.
+func (p Precompile) Delegate(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, validatorAddr, amount, err := ParseDelegateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	coin, err := p.bondCoin(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stakingtypes.NewMsgDelegate(sdk.AccAddress(delegatorAddr.Bytes()).String(), validatorAddr.String(), coin)
+	if err := p.checkDelegator(ctx, contract, delegatorAddr, msg); err != nil {
+		return nil, err
+	}
+
+	validator, err := p.stakingKeeper.GetValidator(ctx, validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	newShares, err := validator.SharesFromTokens(coin.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).Delegate(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitDelegateEvent(ctx, stateDB, delegatorAddr, validatorAddr, amount, newShares.BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Undelegate performs the undelegation of coins from a validator for a delegate.
+// The provided amount cannot be negative. This is validated in the msg.ValidateBasic() function.
+func (p Precompile) Undelegate(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, validatorAddr, amount, err := ParseDelegateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	coin, err := p.bondCoin(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stakingtypes.NewMsgUndelegate(sdk.AccAddress(delegatorAddr.Bytes()).String(), validatorAddr.String(), coin)
+	if err := p.checkDelegator(ctx, contract, delegatorAddr, msg); err != nil {
+		return nil, err
+	}
+
+	res, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).Undelegate(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime := res.CompletionTime.UTC().Unix()
+	if err := p.EmitUnbondEvent(ctx, stateDB, delegatorAddr, validatorAddr, amount, big.NewInt(completionTime)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+// Redelegate performs a redelegation of coins for a delegate from a source validator
+// to a destination validator.
+// The provided amount cannot be negative. This is validated in the msg.ValidateBasic() function.
+func (p Precompile) Redelegate(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, validatorSrcAddr, validatorDstAddr, amount, err := ParseRedelegateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	coin, err := p.bondCoin(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stakingtypes.NewMsgBeginRedelegate(
+		sdk.AccAddress(delegatorAddr.Bytes()).String(),
+		validatorSrcAddr.String(),
+		validatorDstAddr.String(),
+		coin,
+	)
+	if err := p.checkDelegator(ctx, contract, delegatorAddr, msg); err != nil {
+		return nil, err
+	}
+
+	res, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).BeginRedelegate(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime := res.CompletionTime.UTC().Unix()
+	if err := p.EmitRedelegateEvent(ctx, stateDB, delegatorAddr, validatorSrcAddr, validatorDstAddr, amount, big.NewInt(completionTime)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+// CancelUnbondingDelegation will cancel the unbonding of a delegation and delegate
+// back to the validator being unbonded from.
+// The provided amount cannot be negative. This is validated in the msg.ValidateBasic() function.
+func (p Precompile) CancelUnbondingDelegation(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, validatorAddr, amount, creationHeight, err := ParseCancelUnbondingDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	coin, err := p.bondCoin(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stakingtypes.NewMsgCancelUnbondingDelegation(
+		sdk.AccAddress(delegatorAddr.Bytes()).String(),
+		validatorAddr.String(),
+		creationHeight,
+		coin,
+	)
+	if err := p.checkDelegator(ctx, contract, delegatorAddr, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).CancelUnbondingDelegation(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCancelUnbondingDelegationEvent(ctx, stateDB, delegatorAddr, validatorAddr, amount, big.NewInt(creationHeight)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// bondCoin returns the coin of the staking bond denomination with the given amount.
+func (p Precompile) bondCoin(ctx sdk.Context, amount *big.Int) (sdk.Coin, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return cmn.NewSdkCoinFromBigInt(bondDenom, amount)
+}
@@ -0,0 +1,293 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package staking
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+)
+
+// ValidatorInfo is a struct to represent the key information from
+// a validator response.
+type ValidatorInfo struct {
+	OperatorAddress   string
+	ConsensusPubkey   string
+	Jailed            bool
+	Status            uint8
+	Tokens            *big.Int
+	DelegatorShares   *big.Int
+	Description       string
+	UnbondingHeight   int64
+	UnbondingTime     int64
+	Commission        *big.Int
+	MinSelfDelegation *big.Int
+}
+
+// UnbondingDelegationEntry is a struct that contains the information about an
+// unbonding delegation entry.
+type UnbondingDelegationEntry struct {
+	CreationHeight          int64
+	CompletionTime          int64
+	InitialBalance          *big.Int
+	Balance                 *big.Int
+	UnbondingId             uint64 //nolint:revive,stylecheck
+	UnbondingOnHoldRefCount int64
+}
+
+// UnbondingDelegationOutput is the output response returned by the
+// unbondingDelegation query method.
+type UnbondingDelegationOutput struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Entries          []UnbondingDelegationEntry
+}
+
+// NewValidatorInfo returns the ABI representation of a validator.
+func NewValidatorInfo(validator stakingtypes.Validator) ValidatorInfo {
+	return ValidatorInfo{
+		OperatorAddress:   validator.OperatorAddress,
+		ConsensusPubkey:   FormatConsensusPubkey(validator.ConsensusPubkey),
+		Jailed:            validator.Jailed,
+		Status:            uint8(validator.Status), //#nosec G115 -- bond status is a small enum
+		Tokens:            validator.Tokens.BigInt(),
+		DelegatorShares:   validator.DelegatorShares.BigInt(),
+		Description:       validator.Description.Details,
+		UnbondingHeight:   validator.UnbondingHeight,
+		UnbondingTime:     validator.UnbondingTime.UTC().Unix(),
+		Commission:        validator.Commission.Rate.BigInt(),
+		MinSelfDelegation: validator.MinSelfDelegation.BigInt(),
+	}
+}
+
+// DefaultValidatorInfo returns the validator information returned when the
+// queried validator does not exist.
+func DefaultValidatorInfo() ValidatorInfo {
+	return ValidatorInfo{
+		Tokens:            big.NewInt(0),
+		DelegatorShares:   big.NewInt(0),
+		Commission:        big.NewInt(0),
+		MinSelfDelegation: big.NewInt(0),
+	}
+}
+
+// FormatConsensusPubkey returns the base64 encoding of the validator
+// consensus public key.
+func FormatConsensusPubkey(consensusPubkey *codectypes.Any) string {
+	if consensusPubkey == nil {
+		return ""
+	}
+
+	pubkey, ok := consensusPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return ""
+	}
+
+	return base64.StdEncoding.EncodeToString(pubkey.Bytes())
+}
+
+// NewUnbondingDelegationOutput returns the ABI representation of an
+// unbonding delegation.
+func NewUnbondingDelegationOutput(ubd stakingtypes.UnbondingDelegation) UnbondingDelegationOutput {
+	entries := make([]UnbondingDelegationEntry, len(ubd.Entries))
+	for i, entry := range ubd.Entries {
+		entries[i] = UnbondingDelegationEntry{
+			CreationHeight:          entry.CreationHeight,
+			CompletionTime:          entry.CompletionTime.UTC().Unix(),
+			InitialBalance:          entry.InitialBalance.BigInt(),
+			Balance:                 entry.Balance.BigInt(),
+			UnbondingId:             entry.UnbondingId,
+			UnbondingOnHoldRefCount: entry.UnbondingOnHoldRefCount,
+		}
+	}
+
+	return UnbondingDelegationOutput{
+		DelegatorAddress: ubd.DelegatorAddress,
+		ValidatorAddress: ubd.ValidatorAddress,
+		Entries:          entries,
+	}
+}
+
+// ParseDelegateArgs parses the arguments of the delegate and undelegate
+// methods and returns the delegator, the validator and the amount.
+func ParseDelegateArgs(args []interface{}) (common.Address, sdk.ValAddress, *big.Int, error) {
+	if len(args) != 3 {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	delegatorAddr, err := parseDelegator(args[0])
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	validatorAddr, err := parseValidator(args[1])
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	amount, err := parseAmount(args[2])
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	return delegatorAddr, validatorAddr, amount, nil
+}
+
+// ParseRedelegateArgs parses the arguments of the redelegate method and
+// returns the delegator, the source and destination validators and the
+// amount.
+func ParseRedelegateArgs(args []interface{}) (common.Address, sdk.ValAddress, sdk.ValAddress, *big.Int, error) {
+	if len(args) != 4 {
+		return common.Address{}, nil, nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	delegatorAddr, err := parseDelegator(args[0])
+	if err != nil {
+		return common.Address{}, nil, nil, nil, err
+	}
+
+	validatorSrcAddr, err := parseValidator(args[1])
+	if err != nil {
+		return common.Address{}, nil, nil, nil, err
+	}
+
+	validatorDstAddr, err := parseValidator(args[2])
+	if err != nil {
+		return common.Address{}, nil, nil, nil, err
+	}
+
+	amount, err := parseAmount(args[3])
+	if err != nil {
+		return common.Address{}, nil, nil, nil, err
+	}
+
+	return delegatorAddr, validatorSrcAddr, validatorDstAddr, amount, nil
+}
+
+// ParseCancelUnbondingDelegationArgs parses the arguments of the
+// cancelUnbondingDelegation method and returns the delegator, the validator,
+// the amount and the creation height of the unbonding entry.
+func ParseCancelUnbondingDelegationArgs(args []interface{}) (common.Address, sdk.ValAddress, *big.Int, int64, error) {
+	if len(args) != 4 {
+		return common.Address{}, nil, nil, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	delegatorAddr, err := parseDelegator(args[0])
+	if err != nil {
+		return common.Address{}, nil, nil, 0, err
+	}
+
+	validatorAddr, err := parseValidator(args[1])
+	if err != nil {
+		return common.Address{}, nil, nil, 0, err
+	}
+
+	amount, err := parseAmount(args[2])
+	if err != nil {
+		return common.Address{}, nil, nil, 0, err
+	}
+
+	creationHeight, ok := args[3].(*big.Int)
+	if !ok || creationHeight == nil || !creationHeight.IsInt64() || creationHeight.Sign() < 0 {
+		return common.Address{}, nil, nil, 0, fmt.Errorf(ErrInvalidCreationHeight, args[3])
+	}
+
+	return delegatorAddr, validatorAddr, amount, creationHeight.Int64(), nil
+}
+
+// ParseDelegationArgs parses the arguments of the delegation and
+// unbondingDelegation queries and returns the delegator and the validator.
+func ParseDelegationArgs(args []interface{}) (common.Address, sdk.ValAddress, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegatorAddr, err := parseDelegator(args[0])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	validatorAddr, err := parseValidator(args[1])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return delegatorAddr, validatorAddr, nil
+}
+
+// ParseValidatorArgs parses the arguments of the validator query.
+func ParseValidatorArgs(args []interface{}) (sdk.ValAddress, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return parseValidator(args[0])
+}
+
+// ParseValidatorsArgs parses the arguments of the validators query and
+// returns the bond status and the pagination request.
+func ParseValidatorsArgs(args []interface{}) (string, cmn.PageRequest, error) {
+	if len(args) != 2 {
+		return "", cmn.PageRequest{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	status, ok := args[0].(string)
+	if !ok {
+		return "", cmn.PageRequest{}, fmt.Errorf(cmn.ErrInvalidType, "status", "", args[0])
+	}
+
+	// the ABI decodes the tuple into an anonymous struct with the same
+	// fields as the PageRequest type
+	pageRequest, ok := args[1].(struct {
+		Key        []byte `json:"key"`
+		Offset     uint64 `json:"offset"`
+		Limit      uint64 `json:"limit"`
+		CountTotal bool   `json:"countTotal"`
+		Reverse    bool   `json:"reverse"`
+	})
+	if !ok {
+		return "", cmn.PageRequest{}, fmt.Errorf(cmn.ErrInvalidType, "pageRequest", cmn.PageRequest{}, args[1])
+	}
+
+	return status, cmn.PageRequest(pageRequest), nil
+}
+
+func parseDelegator(arg interface{}) (common.Address, error) {
+	delegatorAddr, ok := arg.(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidDelegator, arg)
+	}
+
+	return delegatorAddr, nil
+}
+
+func parseValidator(arg interface{}) (sdk.ValAddress, error) {
+	validatorAddress, ok := arg.(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidValidator, arg)
+	}
+
+	validatorAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidValidator, validatorAddress)
+	}
+
+	return validatorAddr, nil
+}
+
+func parseAmount(arg interface{}) (*big.Int, error) {
+	amount, ok := arg.(*big.Int)
+	if !ok || amount == nil || amount.Sign() < 0 {
+		return nil, fmt.Errorf(ErrInvalidAmount, arg)
+	}
+
+	return amount, nil
+}
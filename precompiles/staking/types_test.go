@@ -0,0 +1,96 @@
+package staking_test
+
+import (
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/precompiles/staking"
+)
+
+func TestParseDelegateArgs(t *testing.T) {
+	delegator := common.HexToAddress("0x1")
+	validator := sdk.ValAddress(common.HexToAddress("0x2").Bytes())
+
+	testCases := []struct {
+		name   string
+		args   []interface{}
+		expErr bool
+	}{
+		{"success", []interface{}{delegator, validator.String(), big.NewInt(1)}, false},
+		{"fail - invalid number of args", []interface{}{delegator, validator.String()}, true},
+		{"fail - zero delegator", []interface{}{common.Address{}, validator.String(), big.NewInt(1)}, true},
+		{"fail - invalid validator", []interface{}{delegator, "invalid", big.NewInt(1)}, true},
+		{"fail - negative amount", []interface{}{delegator, validator.String(), big.NewInt(-1)}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			delegatorAddr, validatorAddr, amount, err := staking.ParseDelegateArgs(tc.args)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, delegator, delegatorAddr)
+			require.Equal(t, validator, validatorAddr)
+			require.Equal(t, big.NewInt(1), amount)
+		})
+	}
+}
+
+func TestParseCancelUnbondingDelegationArgs(t *testing.T) {
+	delegator := common.HexToAddress("0x1")
+	validator := sdk.ValAddress(common.HexToAddress("0x2").Bytes()).String()
+
+	_, _, _, height, err := staking.ParseCancelUnbondingDelegationArgs([]interface{}{delegator, validator, big.NewInt(1), big.NewInt(10)})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+
+	tooHigh := new(big.Int).Lsh(big.NewInt(1), 64)
+	_, _, _, _, err = staking.ParseCancelUnbondingDelegationArgs([]interface{}{delegator, validator, big.NewInt(1), tooHigh})
+	require.Error(t, err)
+}
+
+func TestPrecompile(t *testing.T) {
+	p, err := staking.NewPrecompile(nil, authzkeeper.Keeper{})
+	require.NoError(t, err)
+
+	gasConfig := storetypes.KVGasConfig()
+	argsBz := make([]byte, 32)
+
+	for _, name := range []string{
+		staking.DelegateMethod,
+		staking.UndelegateMethod,
+		staking.RedelegateMethod,
+		staking.CancelUnbondingDelegationMethod,
+		"approve",
+		"revoke",
+	} {
+		require.True(t, p.IsTransaction(name), name)
+		method := p.Methods[name]
+		expGas := gasConfig.WriteCostFlat + gasConfig.WriteCostPerByte*uint64(len(argsBz))
+		require.Equal(t, expGas, p.RequiredGas(append(method.ID, argsBz...)), name)
+	}
+
+	for _, name := range []string{
+		staking.DelegationMethod,
+		staking.UnbondingDelegationMethod,
+		staking.ValidatorMethod,
+		staking.ValidatorsMethod,
+		"allowance",
+	} {
+		require.False(t, p.IsTransaction(name), name)
+		method := p.Methods[name]
+		expGas := gasConfig.ReadCostFlat + gasConfig.ReadCostPerByte*uint64(len(argsBz))
+		require.Equal(t, expGas, p.RequiredGas(append(method.ID, argsBz...)), name)
+	}
+
+	require.Zero(t, p.RequiredGas([]byte{0x1}))
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../erc20/IERC20.sol";
+
+/// @author Evmos Team
+/// @title WERC20 Precompiled Contract
+/// @dev The interface of the wrapped EVM coin precompile, which follows the
+/// WETH9 contract. Its balances are the bank balances of the EVM coin in 18
+/// decimals, so the native coin and its wrapped token are always the same
+/// balance: deposits and withdrawals only emit their events.
+interface IWERC20 is IERC20 {
+    /// @dev Emitted when `wad` tokens are deposited by `dst`.
+    event Deposit(address indexed dst, uint256 wad);
+
+    /// @dev Emitted when `wad` tokens are withdrawn by `src`.
+    event Withdrawal(address indexed src, uint256 wad);
+
+    /// @dev Deposits the native coin sent with the call, which is accounted
+    /// back to the caller.
+    receive() external payable;
+
+    /// @dev Deposits the native coin sent with the call, which is accounted
+    /// back to the caller.
+    fallback() external payable;
+
+    /// @dev Deposits the native coin sent with the call, which is accounted
+    /// back to the caller.
+    function deposit() external payable;
+
+    /// @dev Withdraws `wad` tokens of the caller. It fails if the balance of
+    /// the caller is lower than `wad`.
+    function withdraw(uint256 wad) external;
+}
@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package werc20
+
+const (
+	// ErrNonPayableMethod is raised when the native coin is sent to a method
+	// that is not payable.
+	ErrNonPayableMethod = "cannot send native coins to the non-payable method %s"
+	// ErrInsufficientBalance is raised when the withdrawn amount is higher
+	// than the balance of the caller.
+	ErrInsufficientBalance = "WERC20: insufficient balance: balance %s, needed %s"
+	// ErrInvalidAmount is raised when the amount, in 18 decimals, can't be
+	// represented in the decimals of the EVM coin without losing its dust.
+	ErrInvalidAmount = "WERC20: amount %s is not a multiple of %s, the smallest unit of the EVM coin"
+)
@@ -0,0 +1,39 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package werc20
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+const (
+	// EventTypeDeposit defines the event type for the WERC20 deposit
+	// transaction.
+	EventTypeDeposit = "Deposit"
+	// EventTypeWithdrawal defines the event type for the WERC20 withdraw
+	// transaction.
+	EventTypeWithdrawal = "Withdrawal"
+)
+
+// EmitDepositEvent creates a new Deposit event emitted on deposit
+// transactions.
+func (p Precompile) EmitDepositEvent(ctx sdk.Context, stateDB vm.StateDB, dst common.Address, wad *big.Int) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeDeposit,
+		[]interface{}{dst},
+		wad,
+	)
+}
+
+// EmitWithdrawalEvent creates a new Withdrawal event emitted on withdraw
+// transactions.
+func (p Precompile) EmitWithdrawalEvent(ctx sdk.Context, stateDB vm.StateDB, src common.Address, wad *big.Int) error {
+	return p.EmitEvent(ctx, stateDB, EventTypeWithdrawal,
+		[]interface{}{src},
+		wad,
+	)
+}
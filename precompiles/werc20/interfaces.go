@@ -0,0 +1,58 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package werc20
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/green901612/cosevm/precompiles/erc20"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+	"github.com/green901612/cosevm/x/evm/wrappers"
+)
+
+// BankKeeper defines the bank methods used by the WERC20 precompile to manage
+// the balances of the EVM coin.
+type BankKeeper interface {
+	evmtypes.BankKeeper
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+var _ erc20.BankKeeper = evmCoinBankKeeper{}
+
+// evmCoinBankKeeper implements the bank keeper of the ERC-20 precompile for
+// the EVM coin. Its balances, supply and transfers go through the x/evm bank
+// wrapper, so that the amounts are expressed in 18 decimals whatever the
+// decimals of the EVM coin in the bank module.
+type evmCoinBankKeeper struct {
+	BankKeeper
+	wrapper *wrappers.BankWrapper
+}
+
+// newEVMCoinBankKeeper creates a new evmCoinBankKeeper instance.
+func newEVMCoinBankKeeper(bankKeeper BankKeeper) evmCoinBankKeeper {
+	return evmCoinBankKeeper{
+		BankKeeper: bankKeeper,
+		wrapper:    wrappers.NewBankWrapper(bankKeeper),
+	}
+}
+
+// GetBalance returns the balance of the EVM coin of the given account in 18
+// decimals.
+func (k evmCoinBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return k.wrapper.GetBalance(ctx, addr, denom)
+}
+
+// GetSupply returns the total supply of the EVM coin in 18 decimals.
+func (k evmCoinBankKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	return k.wrapper.GetSupply(ctx, denom)
+}
+
+// SendCoins sends the given amount of the EVM coin, expressed in 18 decimals,
+// between the given accounts.
+func (k evmCoinBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.wrapper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
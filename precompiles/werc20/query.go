@@ -0,0 +1,67 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package werc20
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// Name returns the name of the wrapped token, which is the name of the bank
+// metadata of the EVM coin prefixed with "Wrapped". The denomination of the
+// coin is used when it has no metadata.
+func (p Precompile) Name(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	name, _ := p.coinNameAndSymbol(ctx)
+	return method.Outputs.Pack("Wrapped " + name)
+}
+
+// Symbol returns the symbol of the wrapped token, which is the symbol of the
+// bank metadata of the EVM coin prefixed with "W". The upper-cased
+// denomination of the coin is used when it has no metadata.
+func (p Precompile) Symbol(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	_, symbol := p.coinNameAndSymbol(ctx)
+	return method.Outputs.Pack("W" + symbol)
+}
+
+// Decimals returns the decimals of the wrapped token. The balances of the EVM
+// coin are always expressed in 18 decimals in the EVM.
+func (p Precompile) Decimals(
+	_ sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(uint8(evmtypes.EighteenDecimals))
+}
+
+// coinNameAndSymbol returns the name and symbol of the EVM coin taken from its
+// bank metadata, falling back on its display and base denominations.
+func (p Precompile) coinNameAndSymbol(ctx sdk.Context) (name, symbol string) {
+	display := p.Denom()
+
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.Denom())
+	if found && metadata.Display != "" {
+		display = metadata.Display
+	}
+
+	name, symbol = metadata.Name, metadata.Symbol
+	if name == "" {
+		name = display
+	}
+	if symbol == "" {
+		symbol = strings.ToUpper(display)
+	}
+
+	return name, symbol
+}
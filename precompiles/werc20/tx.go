@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package werc20
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+const (
+	// DepositMethod defines the ABI method name for the WERC20 deposit
+	// transaction.
+	DepositMethod = "deposit"
+	// WithdrawMethod defines the ABI method name for the WERC20 withdraw
+	// transaction.
+	WithdrawMethod = "withdraw"
+)
+
+// Deposit wraps the EVM coin sent with the call. The coin was credited to the
+// precompile account by the EVM, so it is sent back to the caller, whose
+// balance is also its balance of wrapped tokens. The amounts with dust below
+// the bank decimals of the coin are rejected.
+func (p Precompile) Deposit(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	caller := contract.CallerAddress
+	amount := contract.Value()
+
+	if err := ValidateAmount(amount, evmtypes.GetEVMCoinDecimals()); err != nil {
+		return nil, err
+	}
+
+	if amount.Sign() > 0 {
+		coins := sdk.Coins{sdk.NewCoin(p.Denom(), math.NewIntFromBigInt(amount))}
+		if err := p.bankKeeper.SendCoins(ctx, p.Address().Bytes(), caller.Bytes(), coins); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.EmitDepositEvent(ctx, stateDB, caller, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Withdraw unwraps the given amount of tokens of the caller. The wrapped
+// tokens and the EVM coin share the same balance, so it only checks that the
+// caller owns enough tokens and that the amount has no dust below the bank
+// decimals of the coin.
+func (p Precompile) Withdraw(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	amount, err := ParseWithdrawArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateAmount(amount, evmtypes.GetEVMCoinDecimals()); err != nil {
+		return nil, err
+	}
+
+	caller := contract.CallerAddress
+
+	balance := p.bankKeeper.GetBalance(ctx, caller.Bytes(), p.Denom()).Amount.BigInt()
+	if balance.Cmp(amount) < 0 {
+		return nil, fmt.Errorf(ErrInsufficientBalance, balance, amount)
+	}
+
+	if err := p.EmitWithdrawalEvent(ctx, stateDB, caller, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
@@ -0,0 +1,38 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package werc20
+
+import (
+	"fmt"
+	"math/big"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// ParseWithdrawArgs parses the arguments of the withdraw transaction and
+// returns the withdrawn amount.
+func ParseWithdrawArgs(args []interface{}) (*big.Int, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	wad, ok := args[0].(*big.Int)
+	if !ok || wad == nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "wad", &big.Int{}, args[0])
+	}
+
+	return wad, nil
+}
+
+// ValidateAmount checks that the given amount, expressed in 18 decimals, is a
+// multiple of the smallest unit of an EVM coin with the given decimals, so
+// that it can be converted to the bank decimals of the coin without dust.
+func ValidateAmount(amount *big.Int, decimals evmtypes.Decimals) error {
+	conversionFactor := decimals.ConversionFactor().BigInt()
+	if new(big.Int).Rem(amount, conversionFactor).Sign() != 0 {
+		return fmt.Errorf(ErrInvalidAmount, amount, conversionFactor)
+	}
+
+	return nil
+}
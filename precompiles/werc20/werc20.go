@@ -0,0 +1,138 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package werc20
+
+import (
+	"embed"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/green901612/cosevm/precompiles/common"
+	"github.com/green901612/cosevm/precompiles/erc20"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract of the wrapped EVM coin, which
+// follows the WETH9 interface. It extends the ERC-20 precompile of the EVM
+// coin, whose balances are the bank balances of the EVM coin expressed in 18
+// decimals. As the coin and its wrapped token share the same balances, the
+// deposits and withdrawals only emit their events.
+type Precompile struct {
+	*erc20.Precompile
+	bankKeeper erc20.BankKeeper
+}
+
+// NewPrecompile creates a new WERC20 Precompile instance at the given address
+// as a PrecompiledContract interface. The EVM coin must be configured before
+// the precompile is created.
+func NewPrecompile(
+	address common.Address,
+	bankKeeper BankKeeper,
+	erc20Keeper erc20.Erc20Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	evmCoinKeeper := newEVMCoinBankKeeper(bankKeeper)
+
+	erc20Precompile, err := erc20.NewPrecompile(address, evmtypes.GetEVMCoinDenom(), evmCoinKeeper, erc20Keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	// the WERC20 ABI extends the ERC-20 one with the WETH9 methods and events
+	erc20Precompile.ABI = newABI
+
+	return &Precompile{
+		Precompile: erc20Precompile,
+		bankKeeper: evmCoinKeeper,
+	}, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract WERC20 methods defined in the ABI. As
+// in the WETH9 contract, the calls that don't match any method, such as the
+// plain transfers of the EVM coin, are deposits.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	if len(contract.Input) < 4 {
+		contract.Input = p.Methods[DepositMethod].ID
+	} else if _, err := p.MethodById(contract.Input[:4]); err != nil {
+		contract.Input = p.Methods[DepositMethod].ID
+	}
+
+	return p.RunNativeAction(evm, contract, readOnly, p.IsTransaction, p.execute)
+}
+
+// execute dispatches the call to the WERC20 method with the given arguments.
+func (p Precompile) execute(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if !method.IsPayable() && contract.Value().Sign() > 0 {
+		return nil, fmt.Errorf(ErrNonPayableMethod, method.Name)
+	}
+
+	switch method.Name {
+	// WERC20 transactions
+	case DepositMethod:
+		return p.Deposit(ctx, contract, stateDB, method, args)
+	case WithdrawMethod:
+		return p.Withdraw(ctx, contract, stateDB, method, args)
+	// ERC-20 metadata queries
+	case erc20.NameMethod:
+		return p.Name(ctx, method, args)
+	case erc20.SymbolMethod:
+		return p.Symbol(ctx, method, args)
+	case erc20.DecimalsMethod:
+		return p.Decimals(ctx, method, args)
+	default:
+		return p.HandleMethod(ctx, contract, stateDB, method, args)
+	}
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available WERC20 transactions are:
+//   - Deposit
+//   - Withdraw
+//   - the ERC-20 transactions
+func (p Precompile) IsTransaction(method string) bool {
+	switch method {
+	case DepositMethod,
+		WithdrawMethod:
+		return true
+	default:
+		return p.Precompile.IsTransaction(method)
+	}
+}
@@ -0,0 +1,245 @@
+package werc20_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	"github.com/green901612/cosevm/precompiles/erc20"
+	"github.com/green901612/cosevm/precompiles/werc20"
+	"github.com/green901612/cosevm/testutil"
+	evmante "github.com/green901612/cosevm/x/evm/ante"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+var (
+	precompileAddr = common.HexToAddress(evmtypes.WERC20PrecompileAddress)
+	// amount is the genesis balance of the owner
+	amount = sdkmath.NewInt(1e18)
+)
+
+type testSuite struct {
+	miniApp  *app.MiniApp
+	ctx      sdk.Context
+	abi      abi.ABI
+	owner    testutil.Account
+	receiver testutil.Account
+}
+
+func setupTest(t *testing.T) *testSuite {
+	t.Helper()
+
+	owner := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(owner, amount))
+
+	p, err := werc20.NewPrecompile(precompileAddr, miniApp.BankKeeper, miniApp.Erc20Keeper)
+	require.NoError(t, err)
+
+	return &testSuite{
+		miniApp:  miniApp,
+		ctx:      ctx,
+		abi:      p.ABI,
+		owner:    owner,
+		receiver: testutil.NewAccount(t),
+	}
+}
+
+func (s *testSuite) call(from testutil.Account, method string, args ...interface{}) ([]interface{}, error) {
+	_, outputs, err := testutil.CallContract(s.ctx, s.miniApp, from.Address, precompileAddr, s.abi, method, args...)
+	return outputs, err
+}
+
+// send sends the given value to the precompile along with the given call
+// data, as the CallContract calls carry no value.
+func (s *testSuite) send(t *testing.T, from testutil.Account, value *big.Int, data []byte) *evmtypes.MsgEthereumTxResponse {
+	t.Helper()
+
+	nonce, err := s.miniApp.AccountKeeper.GetSequence(s.ctx, from.AccAddr)
+	require.NoError(t, err)
+
+	msg := ethtypes.NewMessage(
+		from.Address, &precompileAddr, nonce, value, 100_000,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), data, ethtypes.AccessList{}, false,
+	)
+
+	ctx := evmante.BuildEvmExecutionCtx(s.ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	res, err := s.miniApp.EvmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), true)
+	require.NoError(t, err)
+	return res
+}
+
+func (s *testSuite) balance(acc testutil.Account) sdkmath.Int {
+	return s.miniApp.BankKeeper.GetBalance(s.ctx, acc.AccAddr, testutil.Denom).Amount
+}
+
+func TestIsTransaction(t *testing.T) {
+	s := setupTest(t)
+	p, err := werc20.NewPrecompile(precompileAddr, s.miniApp.BankKeeper, s.miniApp.Erc20Keeper)
+	require.NoError(t, err)
+
+	for _, method := range []string{
+		werc20.DepositMethod,
+		werc20.WithdrawMethod,
+		erc20.TransferMethod,
+		erc20.TransferFromMethod,
+		erc20.ApproveMethod,
+	} {
+		require.True(t, p.IsTransaction(method), method)
+	}
+
+	for _, method := range []string{
+		erc20.NameMethod,
+		erc20.SymbolMethod,
+		erc20.DecimalsMethod,
+		erc20.TotalSupplyMethod,
+		erc20.BalanceOfMethod,
+		erc20.AllowanceMethod,
+	} {
+		require.False(t, p.IsTransaction(method), method)
+	}
+}
+
+func TestQueries(t *testing.T) {
+	s := setupTest(t)
+
+	// without metadata, the name and symbol are derived from the denomination
+	outputs, err := s.call(s.owner, erc20.NameMethod)
+	require.NoError(t, err)
+	require.Equal(t, "Wrapped "+testutil.Denom, outputs[0])
+	outputs, err = s.call(s.owner, erc20.SymbolMethod)
+	require.NoError(t, err)
+	require.Equal(t, "WCOSE", outputs[0])
+
+	s.miniApp.BankKeeper.SetDenomMetaData(s.ctx, banktypes.Metadata{
+		Base:    testutil.Denom,
+		Display: "test",
+		Name:    "Test",
+		Symbol:  "TEST",
+	})
+	outputs, err = s.call(s.owner, erc20.NameMethod)
+	require.NoError(t, err)
+	require.Equal(t, "Wrapped Test", outputs[0])
+	outputs, err = s.call(s.owner, erc20.SymbolMethod)
+	require.NoError(t, err)
+	require.Equal(t, "WTEST", outputs[0])
+
+	outputs, err = s.call(s.owner, erc20.DecimalsMethod)
+	require.NoError(t, err)
+	require.Equal(t, uint8(18), outputs[0])
+
+	outputs, err = s.call(s.owner, erc20.TotalSupplyMethod)
+	require.NoError(t, err)
+	require.Equal(t, s.miniApp.BankKeeper.GetSupply(s.ctx, testutil.Denom).Amount.BigInt(), outputs[0])
+
+	outputs, err = s.call(s.owner, erc20.BalanceOfMethod, s.owner.Address)
+	require.NoError(t, err)
+	require.Equal(t, amount.BigInt(), outputs[0])
+}
+
+func TestDeposit(t *testing.T) {
+	s := setupTest(t)
+	value := big.NewInt(100)
+	depositID := s.abi.Events[werc20.EventTypeDeposit].ID
+
+	// the deposited coins stay with the caller, as they are its wrapped tokens
+	res := s.send(t, s.owner, value, s.abi.Methods[werc20.DepositMethod].ID)
+	require.False(t, res.Failed(), res.VmError)
+	require.Equal(t, amount, s.balance(s.owner))
+	require.True(t, s.miniApp.BankKeeper.GetBalance(s.ctx, precompileAddr.Bytes(), testutil.Denom).Amount.IsZero())
+
+	require.Len(t, res.Logs, 1)
+	require.Equal(t, depositID.Hex(), res.Logs[0].Topics[0])
+	require.Equal(t, common.BytesToHash(s.owner.Address.Bytes()).Hex(), res.Logs[0].Topics[1])
+	require.Equal(t, common.BigToHash(value).Bytes(), res.Logs[0].Data)
+
+	// the calls without a known method are deposits
+	res = s.send(t, s.owner, value, nil)
+	require.False(t, res.Failed(), res.VmError)
+	require.Len(t, res.Logs, 1)
+	require.Equal(t, depositID.Hex(), res.Logs[0].Topics[0])
+	require.Equal(t, amount, s.balance(s.owner))
+
+	// the other methods are not payable
+	data, err := s.abi.Pack(erc20.TransferMethod, s.receiver.Address, value)
+	require.NoError(t, err)
+	res = s.send(t, s.owner, value, data)
+	require.True(t, res.Failed())
+	require.True(t, s.balance(s.receiver).IsZero())
+}
+
+func TestWithdraw(t *testing.T) {
+	testCases := []struct {
+		name   string
+		amount *big.Int
+		expErr string
+	}{
+		{"success", amount.BigInt(), ""},
+		{"success - smallest unit", big.NewInt(1), ""},
+		{"fail - insufficient balance", amount.AddRaw(1).BigInt(), "WERC20: insufficient balance"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := setupTest(t)
+
+			res, _, err := testutil.CallContract(s.ctx, s.miniApp, s.owner.Address, precompileAddr, s.abi, werc20.WithdrawMethod, tc.amount)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, res.Logs, 1)
+			require.Equal(t, s.abi.Events[werc20.EventTypeWithdrawal].ID.Hex(), res.Logs[0].Topics[0])
+			// withdrawals leave the bank balance untouched
+			require.Equal(t, amount, s.balance(s.owner))
+		})
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	s := setupTest(t)
+	value := sdkmath.NewInt(100)
+
+	outputs, err := s.call(s.owner, erc20.TransferMethod, s.receiver.Address, value.BigInt())
+	require.NoError(t, err)
+	require.Equal(t, true, outputs[0])
+
+	require.Equal(t, amount.Sub(value), s.balance(s.owner))
+	require.Equal(t, value, s.balance(s.receiver))
+}
+
+func TestValidateAmount(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amount   *big.Int
+		decimals evmtypes.Decimals
+		expErr   bool
+	}{
+		{"zero amount", big.NewInt(0), evmtypes.SixDecimals, false},
+		{"six decimals - whole units", big.NewInt(3e12), evmtypes.SixDecimals, false},
+		{"six decimals - dust", big.NewInt(3e12 + 1), evmtypes.SixDecimals, true},
+		{"six decimals - below the smallest unit", big.NewInt(1), evmtypes.SixDecimals, true},
+		{"eighteen decimals - no dust", big.NewInt(1), evmtypes.EighteenDecimals, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := werc20.ValidateAmount(tc.amount, tc.decimals)
+			if tc.expErr {
+				require.ErrorContains(t, err, "is not a multiple of")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -110,6 +110,10 @@ type EVMBackend interface {
 	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
 	GasPrice() (*hexutil.Big, error)
 
+	// Tx Pool
+	TxPoolContent() (pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction, err error)
+	TxPoolContentFrom(address common.Address) (pending, queued map[uint64]*rpctypes.RPCTransaction, err error)
+
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
@@ -132,6 +136,7 @@ type Backend struct {
 	chainID             *big.Int
 	cfg                 config.Config
 	allowUnprotectedTxs bool
+	indexer             types.EVMTxIndexer
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -140,6 +145,7 @@ func NewBackend(
 	logger log.Logger,
 	clientCtx client.Context,
 	allowUnprotectedTxs bool,
+	indexer types.EVMTxIndexer,
 ) *Backend {
 	chainID, err := utils.ParseChainID(clientCtx.ChainID)
 	if err != nil {
@@ -165,5 +171,6 @@ func NewBackend(
 		chainID:             chainID,
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
+		indexer:             indexer,
 	}
 }
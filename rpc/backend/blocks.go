@@ -3,6 +3,7 @@
 package backend
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"math/big"
@@ -269,7 +270,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 				continue
 			}
 
-			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
+			ethMsg.Hash = ethMsg.TxHash().Hex()
 			result = append(result, ethMsg)
 		}
 	}
@@ -381,11 +382,10 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			continue
 		}
 
-		tx := ethMsg.AsTransaction()
 		height := uint64(block.Height) //nolint:gosec // G115 G701 -- checked for int overflow already
 		index := uint64(txIndex)       //nolint:gosec // G115 G701 -- checked for int overflow already
-		rpcTx, err := rpctypes.NewRPCTransaction(
-			tx,
+		rpcTx, err := rpctypes.NewTransactionFromMsg(
+			ethMsg,
 			common.BytesToHash(block.Hash()),
 			height,
 			index,
@@ -393,7 +393,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			b.chainID,
 		)
 		if err != nil {
-			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", tx.Hash().Hex(), "error", err.Error())
+			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", ethMsg.Hash, "error", err.Error())
 			continue
 		}
 		ethRPCTxs = append(ethRPCTxs, rpcTx)
@@ -501,7 +501,25 @@ func (b *Backend) EthBlockFromTendermintBlock(
 		txs[i] = ethMsg.AsTransaction()
 	}
 
+	// NOTE: go-ethereum does not support the EIP-7702 transactions, which are
+	// included in the block body as dynamic fee transactions, so the
+	// transactions root is derived from the canonical encoding of the msgs.
+	ethHeader.TxHash = ethtypes.DeriveSha(ethMsgs(msgs), trie.NewStackTrie(nil))
+
 	// TODO: add tx receipts
-	ethBlock := ethtypes.NewBlock(ethHeader, txs, nil, nil, trie.NewStackTrie(nil))
+	ethBlock := ethtypes.NewBlockWithHeader(ethHeader).WithBody(txs, nil)
 	return ethBlock, nil
 }
+
+// ethMsgs implements the DerivableList interface of go-ethereum for the
+// Ethereum transactions of a block.
+type ethMsgs []*evmtypes.MsgEthereumTx
+
+// Len returns the number of transactions.
+func (msgs ethMsgs) Len() int { return len(msgs) }
+
+// EncodeIndex writes the canonical encoding of the i-th transaction to w.
+func (msgs ethMsgs) EncodeIndex(i int, w *bytes.Buffer) {
+	bz, _ := msgs[i].MarshalBinary() // #nosec G703
+	w.Write(bz)
+}
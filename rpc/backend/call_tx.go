@@ -98,23 +98,31 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
-	// RLP decode raw transaction bytes
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(data); err != nil {
-		b.logger.Error("transaction decoding failed", "error", err.Error())
-		return common.Hash{}, err
-	}
+	// RLP decode raw transaction bytes, the EIP-7702 transactions are not
+	// supported by go-ethereum and always protected
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+	if len(data) > 0 && data[0] == evmtypes.SetCodeTxType {
+		if err := ethereumTx.UnmarshalBinary(data); err != nil {
+			b.logger.Error("transaction decoding failed", "error", err.Error())
+			return common.Hash{}, err
+		}
+	} else {
+		tx := &ethtypes.Transaction{}
+		if err := tx.UnmarshalBinary(data); err != nil {
+			b.logger.Error("transaction decoding failed", "error", err.Error())
+			return common.Hash{}, err
+		}
 
-	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() && !tx.Protected() {
-		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-	}
+		// check the local node config in case unprotected txs are disabled
+		if !b.UnprotectedAllowed() && !tx.Protected() {
+			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+			return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		}
 
-	ethereumTx := &evmtypes.MsgEthereumTx{}
-	if err := ethereumTx.FromEthereumTx(tx); err != nil {
-		b.logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, err
+		if err := ethereumTx.FromEthereumTx(tx); err != nil {
+			b.logger.Error("transaction converting failed", "error", err.Error())
+			return common.Hash{}, err
+		}
 	}
 
 	if err := ethereumTx.ValidateBasic(); err != nil {
@@ -137,7 +145,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
-	txHash := ethereumTx.AsTransaction().Hash()
+	txHash := ethereumTx.TxHash()
 
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
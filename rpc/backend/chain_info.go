@@ -15,7 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	rpctypes "github.com/green901612/cosevm/rpc/types"
-	"github.com/green901612/cosevm/types"
+	"github.com/green901612/cosevm/utils"
 	evmtypes "github.com/green901612/cosevm/x/evm/types"
 	feemarkettypes "github.com/green901612/cosevm/x/feemarket/types"
 	"github.com/pkg/errors"
@@ -23,7 +23,7 @@ import (
 
 // ChainID is the EIP-155 replay-protection chain id for the current ethereum chain config.
 func (b *Backend) ChainID() (*hexutil.Big, error) {
-	eip155ChainID, err := types.ParseChainID(b.clientCtx.ChainID)
+	eip155ChainID, err := utils.ParseChainID(b.clientCtx.ChainID)
 	if err != nil {
 		panic(err)
 	}
@@ -41,9 +41,15 @@ func (b *Backend) ChainID() (*hexutil.Big, error) {
 	return nil, fmt.Errorf("chain not synced beyond EIP-155 replay-protection fork block")
 }
 
-// ChainConfig returns the latest ethereum chain configuration
+// ChainConfig returns the latest ethereum chain configuration stored on chain.
+// The chain configuration of the node is returned if it cannot be queried.
 func (b *Backend) ChainConfig() *params.ChainConfig {
-	return evmtypes.GetEthChainConfig()
+	res, err := b.queryClient.Config(b.ctx, &evmtypes.QueryConfigRequest{})
+	if err != nil || res.Config == nil {
+		b.logger.Debug("failed to query the chain config", "error", err)
+		return evmtypes.GetEthChainConfig()
+	}
+	return res.Config.EthereumConfig(nil)
 }
 
 // GlobalMinGasPrice returns MinGasPrice param from FeeMarket
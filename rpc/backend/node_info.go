@@ -22,10 +22,10 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/green901612/cosevm/app/params"
 	"github.com/green901612/cosevm/crypto/ethsecp256k1"
 	rpctypes "github.com/green901612/cosevm/rpc/types"
 	"github.com/green901612/cosevm/server/config"
-	"github.com/green901612/cosevm/types"
 	evmtypes "github.com/green901612/cosevm/x/evm/types"
 )
 
@@ -344,7 +344,7 @@ func (b *Backend) RPCMinGasPrice() *big.Int {
 	minGasPrice := b.cfg.GetMinGasPrices()
 	amt := minGasPrice.AmountOf(baseDenom)
 	if amt.IsNil() || amt.IsZero() {
-		return big.NewInt(types.DefaultGasPrice)
+		return big.NewInt(params.DefaultGasPrice)
 	}
 
 	return evmtypes.ConvertAmountTo18DecimalsLegacy(amt).TruncateInt().BigInt()
@@ -0,0 +1,107 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/green901612/cosevm/rpc/types"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// TxPoolContent returns the Ethereum transactions of the CometBFT mempool
+// grouped by sender and nonce. Transactions that can be executed next on top
+// of the sender's on-chain nonce are returned as pending, the ones after a
+// nonce gap as queued.
+func (b *Backend) TxPoolContent() (
+	pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction,
+	err error,
+) {
+	txsBySender, err := b.mempoolEthTxsBySender()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	queued = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	for sender, txs := range txsBySender {
+		senderPending, senderQueued, err := b.splitSenderTxs(sender, txs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(senderPending) > 0 {
+			pending[sender] = senderPending
+		}
+		if len(senderQueued) > 0 {
+			queued[sender] = senderQueued
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// TxPoolContentFrom returns the pending and queued Ethereum transactions of
+// the CometBFT mempool sent by the given address, indexed by nonce.
+func (b *Backend) TxPoolContentFrom(address common.Address) (
+	pending, queued map[uint64]*rpctypes.RPCTransaction,
+	err error,
+) {
+	txsBySender, err := b.mempoolEthTxsBySender()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return b.splitSenderTxs(address, txsBySender[address])
+}
+
+// mempoolEthTxsBySender decodes the Ethereum transactions of the CometBFT
+// mempool and groups them by sender and nonce.
+func (b *Backend) mempoolEthTxsBySender() (map[common.Address]map[uint64]*rpctypes.RPCTransaction, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			rpcTx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, 0, 0, nil, b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to convert mempool tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+
+			if result[rpcTx.From] == nil {
+				result[rpcTx.From] = make(map[uint64]*rpctypes.RPCTransaction)
+			}
+			result[rpcTx.From][uint64(rpcTx.Nonce)] = rpcTx
+		}
+	}
+
+	return result, nil
+}
+
+// splitSenderTxs splits the mempool transactions of a sender into pending and
+// queued ones based on the sender's nonce in the EVM state.
+func (b *Backend) splitSenderTxs(
+	sender common.Address,
+	txs map[uint64]*rpctypes.RPCTransaction,
+) (pending, queued map[uint64]*rpctypes.RPCTransaction, err error) {
+	if len(txs) == 0 {
+		return make(map[uint64]*rpctypes.RPCTransaction), make(map[uint64]*rpctypes.RPCTransaction), nil
+	}
+
+	res, err := b.queryClient.Account(b.ctx, &evmtypes.QueryAccountRequest{Address: sender.Hex()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending, queued = rpctypes.SplitTxsByNonce(txs, res.Nonce)
+	return pending, queued, nil
+}
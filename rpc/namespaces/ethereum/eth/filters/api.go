@@ -176,7 +176,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 					for _, msg := range tx.GetMsgs() {
 						ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
 						if ok {
-							f.hashes = append(f.hashes, ethTx.AsTransaction().Hash())
+							f.hashes = append(f.hashes, ethTx.TxHash())
 						}
 					}
 				}
@@ -240,7 +240,7 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 				for _, msg := range tx.GetMsgs() {
 					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
 					if ok {
-						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash()) // #nosec G703
+						_ = notifier.Notify(rpcSub.ID, ethTx.TxHash()) // #nosec G703
 					}
 				}
 			case <-rpcSub.Err():
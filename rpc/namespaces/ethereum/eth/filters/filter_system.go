@@ -307,6 +307,11 @@ func (es *EventSystem) consumeEvents() {
 			}
 		}
 
+		// the responses channel is closed once the client is stopped
+		if !es.tmWSClient.IsRunning() {
+			return
+		}
+
 		time.Sleep(time.Second)
 	}
 }
@@ -8,7 +8,7 @@ import (
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/green901612/cosevm/types"
+	"github.com/green901612/cosevm/utils"
 )
 
 // PublicAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec.
@@ -20,7 +20,7 @@ type PublicAPI struct {
 // NewPublicAPI creates an instance of the public Net Web3 API.
 func NewPublicAPI(clientCtx client.Context) *PublicAPI {
 	// parse the chainID from a integer string
-	chainIDEpoch, err := types.ParseChainID(clientCtx.ChainID)
+	chainIDEpoch, err := utils.ParseChainID(clientCtx.ChainID)
 	if err != nil {
 		panic(err)
 	}
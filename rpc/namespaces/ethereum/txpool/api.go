@@ -3,51 +3,138 @@
 package txpool
 
 import (
+	"fmt"
+
 	"cosmossdk.io/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/green901612/cosevm/rpc/backend"
 	"github.com/green901612/cosevm/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The transaction pool is backed by the CometBFT mempool: pending transactions are the ones that
+// can be executed next on top of the sender's on-chain nonce, queued transactions are the ones
+// after a nonce gap.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+		"pending": make(map[string]map[string]*types.RPCTransaction, len(pending)),
+		"queued":  make(map[string]map[string]*types.RPCTransaction, len(queued)),
+	}
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = formatByNonce(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = formatByNonce(txs)
 	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool
+// that were sent by the given address.
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+
+	pending, queued, err := api.backend.TxPoolContentFrom(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": formatByNonce(pending),
+		"queued":  formatByNonce(queued),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+		"pending": make(map[string]map[string]string, len(pending)),
+		"queued":  make(map[string]map[string]string, len(queued)),
+	}
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = inspectByNonce(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = inspectByNonce(txs)
 	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingCount, queuedCount int
+	for _, txs := range pending {
+		pendingCount += len(txs)
+	}
+	for _, txs := range queued {
+		queuedCount += len(txs)
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(pendingCount), // #nosec G115 -- counts are never negative
+		"queued":  hexutil.Uint(queuedCount),  // #nosec G115 -- counts are never negative
+	}, nil
+}
+
+// formatByNonce keys the transactions of a sender by their decimal nonce.
+func formatByNonce(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	result := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprint(nonce)] = tx
+	}
+	return result
+}
+
+// inspectByNonce summarizes the transactions of a sender keyed by their decimal nonce.
+func inspectByNonce(txs map[uint64]*types.RPCTransaction) map[string]string {
+	result := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		if tx.To != nil {
+			result[fmt.Sprint(nonce)] = fmt.Sprintf("%s: %v wei + %v gas × %v wei",
+				tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+		} else {
+			result[fmt.Sprint(nonce)] = fmt.Sprintf("contract creation: %v wei + %v gas × %v wei",
+				tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+		}
 	}
+	return result
 }
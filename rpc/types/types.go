@@ -52,6 +52,19 @@ type RPCTransaction struct {
 	V                *hexutil.Big         `json:"v"`
 	R                *hexutil.Big         `json:"r"`
 	S                *hexutil.Big         `json:"s"`
+
+	AuthorizationList []RPCSetCodeAuthorization `json:"authorizationList,omitempty"`
+}
+
+// RPCSetCodeAuthorization represents an EIP-7702 authorization of a set code
+// transaction.
+type RPCSetCodeAuthorization struct {
+	ChainID *hexutil.Big   `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	YParity hexutil.Uint64 `json:"yParity"`
+	R       *hexutil.Big   `json:"r"`
+	S       *hexutil.Big   `json:"s"`
 }
 
 // StateOverride is the collection of overridden accounts.
@@ -48,7 +48,7 @@ func RawTxToEthTx(clientCtx client.Context, txBz cmttypes.Tx) ([]*evmtypes.MsgEt
 		if !ok {
 			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgEthereumTx{})
 		}
-		ethTx.Hash = ethTx.AsTransaction().Hash().Hex()
+		ethTx.Hash = ethTx.TxHash().Hex()
 		ethTxs[i] = ethTx
 	}
 	return ethTxs, nil
@@ -77,12 +77,19 @@ func EthHeaderFromTendermint(header cmttypes.Header, bloom ethtypes.Bloom, baseF
 		GasUsed:     0,
 		Time:        time,
 		Extra:       []byte{},
-		MixDigest:   common.Hash{},
+		MixDigest:   MixDigestFromTendermint(header),
 		Nonce:       ethtypes.BlockNonce{},
 		BaseFee:     baseFee,
 	}
 }
 
+// MixDigestFromTendermint returns the mix digest of the Ethereum header, i.e.
+// the PREVRANDAO value of the block, derived from the hash of the previous
+// block as in the EVM execution.
+func MixDigestFromTendermint(header cmttypes.Header) common.Hash {
+	return evmtypes.BlockRandom(common.BytesToHash(header.LastBlockID.Hash))
+}
+
 // BlockMaxGasFromConsensusParams returns the gas limit for the current block from the chain consensus params.
 func BlockMaxGasFromConsensusParams(goCtx context.Context, clientCtx client.Context, blockHeight int64) (int64, error) {
 	tmrpcClient, ok := clientCtx.Client.(tmrpcclient.Client)
@@ -129,7 +136,7 @@ func FormatBlock(
 		"logsBloom":        bloom,
 		"stateRoot":        hexutil.Bytes(header.AppHash),
 		"miner":            validatorAddr,
-		"mixHash":          common.Hash{},
+		"mixHash":          MixDigestFromTendermint(header),
 		"difficulty":       (*hexutil.Big)(big.NewInt(0)),
 		"extraData":        "0x",
 		"size":             hexutil.Uint64(size),     //nolint:gosec // G115
@@ -161,7 +168,45 @@ func NewTransactionFromMsg(
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
 	tx := msg.AsTransaction()
-	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	result, err := NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// go-ethereum does not support the EIP-7702 transactions, whose
+	// transaction is a dynamic fee one without the authorization list
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		result.From, _ = setCodeTx.Sender(setCodeTx.GetChainID()) // #nosec G703
+		result.Type = hexutil.Uint64(evmtypes.SetCodeTxType)
+		result.Hash = setCodeTx.Hash()
+		result.AuthorizationList = NewRPCAuthorizationList(setCodeTx.GetAuthorizationList())
+	}
+
+	return result, nil
+}
+
+// NewRPCAuthorizationList returns the RPC representation of the given EIP-7702
+// authorization list.
+func NewRPCAuthorizationList(authList evmtypes.AuthorizationList) []RPCSetCodeAuthorization {
+	result := make([]RPCSetCodeAuthorization, len(authList))
+	for i, auth := range authList {
+		v, r, s := auth.GetRawSignatureValues()
+		result[i] = RPCSetCodeAuthorization{
+			ChainID: (*hexutil.Big)(auth.GetChainID()),
+			Address: auth.GetAddress(),
+			Nonce:   hexutil.Uint64(auth.Nonce),
+			R:       (*hexutil.Big)(r),
+			S:       (*hexutil.Big)(s),
+		}
+		if v != nil {
+			result[i].YParity = hexutil.Uint64(v.Uint64())
+		}
+	}
+	return result
 }
 
 // NewTransactionFromData returns a transaction that will serialize to the RPC
@@ -286,3 +331,30 @@ func TxStateDBCommitError(res *abci.ExecTxResult) bool {
 func TxSucessOrExpectedFailure(res *abci.ExecTxResult) bool {
 	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res)
 }
+
+// SplitTxsByNonce splits the mempool transactions of a single sender, indexed by
+// nonce, into pending transactions (the gapless nonce sequence that starts at the
+// sender's on-chain nonce) and queued transactions (everything after a nonce gap).
+// Transactions with a nonce lower than the on-chain nonce are stale and dropped.
+func SplitTxsByNonce(txs map[uint64]*RPCTransaction, nonce uint64) (pending, queued map[uint64]*RPCTransaction) {
+	pending = make(map[uint64]*RPCTransaction)
+	queued = make(map[uint64]*RPCTransaction)
+
+	next := nonce
+	for {
+		tx, ok := txs[next]
+		if !ok {
+			break
+		}
+		pending[next] = tx
+		next++
+	}
+
+	for n, tx := range txs {
+		if n >= next {
+			queued[n] = tx
+		}
+	}
+
+	return pending, queued
+}
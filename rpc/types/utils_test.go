@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+func TestSplitTxsByNonce(t *testing.T) {
+	newTxs := func(nonces ...uint64) map[uint64]*RPCTransaction {
+		txs := make(map[uint64]*RPCTransaction, len(nonces))
+		for _, n := range nonces {
+			txs[n] = &RPCTransaction{Nonce: hexutil.Uint64(n)}
+		}
+		return txs
+	}
+
+	testCases := []struct {
+		name       string
+		txs        map[uint64]*RPCTransaction
+		nonce      uint64
+		expPending []uint64
+		expQueued  []uint64
+	}{
+		{"no txs", newTxs(), 0, nil, nil},
+		{"gapless sequence from the on-chain nonce", newTxs(3, 4, 5), 3, []uint64{3, 4, 5}, nil},
+		{"nonce gap after pending txs", newTxs(3, 4, 6, 7), 3, []uint64{3, 4}, []uint64{6, 7}},
+		{"all txs after a nonce gap", newTxs(5, 6), 3, nil, []uint64{5, 6}},
+		{"stale txs are dropped", newTxs(1, 2, 3, 5), 3, []uint64{3}, []uint64{5}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pending, queued := SplitTxsByNonce(tc.txs, tc.nonce)
+
+			require.Len(t, pending, len(tc.expPending))
+			for _, n := range tc.expPending {
+				require.Contains(t, pending, n)
+			}
+			require.Len(t, queued, len(tc.expQueued))
+			for _, n := range tc.expQueued {
+				require.Contains(t, queued, n)
+			}
+		})
+	}
+}
+
+func TestMixDigestFromTendermint(t *testing.T) {
+	lastBlockHash := common.HexToHash("0x02")
+	header := cmttypes.Header{
+		ChainID:        "cosevm_9000-1",
+		Height:         10,
+		Time:           time.Unix(1700000000, 0),
+		LastBlockID:    cmttypes.BlockID{Hash: lastBlockHash.Bytes()},
+		ValidatorsHash: common.HexToHash("0x01").Bytes(),
+	}
+
+	mixDigest := MixDigestFromTendermint(header)
+	require.Equal(t, evmtypes.BlockRandom(lastBlockHash), mixDigest)
+	require.NotEqual(t, common.Hash{}, mixDigest)
+
+	ethHeader := EthHeaderFromTendermint(header, ethtypes.Bloom{}, nil)
+	require.Equal(t, mixDigest, ethHeader.MixDigest)
+
+	block := FormatBlock(header, 0, 0, nil, nil, ethtypes.Bloom{}, common.Address{}, nil)
+	require.Equal(t, mixDigest, block["mixHash"])
+
+	// the contents of the block don't change it
+	header.Time = header.Time.Add(time.Second)
+	header.DataHash = common.HexToHash("0x03").Bytes()
+	require.Equal(t, mixDigest, MixDigestFromTendermint(header))
+
+	// the first block has no previous block
+	header.LastBlockID = cmttypes.BlockID{}
+	require.Equal(t, common.Hash{}, MixDigestFromTendermint(header))
+}
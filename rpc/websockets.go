@@ -38,6 +38,7 @@ import (
 
 type WebsocketsServer interface {
 	Start()
+	Stop()
 }
 
 type SubscriptionResponseJSON struct {
@@ -69,12 +70,14 @@ type ErrorMessageJSON struct {
 }
 
 type websocketsServer struct {
-	rpcAddr  string // listen address of rest-server
-	wsAddr   string // listen address of ws server
-	certFile string
-	keyFile  string
-	api      *pubSubAPI
-	logger   log.Logger
+	rpcAddr    string // listen address of rest-server
+	wsAddr     string // listen address of ws server
+	certFile   string
+	keyFile    string
+	api        *pubSubAPI
+	tmWSClient *rpcclient.WSClient
+	srv        *http.Server
+	logger     log.Logger
 }
 
 func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
@@ -82,12 +85,13 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
 	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
-		wsAddr:   cfg.JSONRPC.WsAddress,
-		certFile: cfg.TLS.CertificatePath,
-		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
-		logger:   logger,
+		rpcAddr:    "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
+		wsAddr:     cfg.JSONRPC.WsAddress,
+		certFile:   cfg.TLS.CertificatePath,
+		keyFile:    cfg.TLS.KeyPath,
+		api:        newPubSubAPI(clientCtx, logger, tmWSClient),
+		tmWSClient: tmWSClient,
+		logger:     logger,
 	}
 }
 
@@ -95,14 +99,14 @@ func (s *websocketsServer) Start() {
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
 
+	//#nosec G112 -- the websocket connections are long lived
+	s.srv = &http.Server{Addr: s.wsAddr, Handler: ws}
 	go func() {
 		var err error
 		if s.certFile == "" || s.keyFile == "" {
-			//#nosec G114 -- http functions have no support for timeouts
-			err = http.ListenAndServe(s.wsAddr, ws)
+			err = s.srv.ListenAndServe()
 		} else {
-			//#nosec G114 -- http functions have no support for timeouts
-			err = http.ListenAndServeTLS(s.wsAddr, s.certFile, s.keyFile, ws)
+			err = s.srv.ListenAndServeTLS(s.certFile, s.keyFile)
 		}
 
 		if err != nil {
@@ -115,6 +119,22 @@ func (s *websocketsServer) Start() {
 	}()
 }
 
+// Stop closes the server listener and stops the CometBFT websocket client
+// used by the subscriptions.
+func (s *websocketsServer) Stop() {
+	if s.srv != nil {
+		if err := s.srv.Close(); err != nil {
+			s.logger.Error("failed to close HTTP server for WS", "error", err.Error())
+		}
+	}
+
+	if s.tmWSClient != nil && s.tmWSClient.IsRunning() {
+		if err := s.tmWSClient.Stop(); err != nil {
+			s.logger.Error("failed to stop CometBFT WS client", "error", err.Error())
+		}
+	}
+}
+
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	upgrader := websocket.Upgrader{
 		CheckOrigin: func(_ *http.Request) bool {
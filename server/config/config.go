@@ -22,6 +22,8 @@ import (
 
 	"github.com/crypto-org-chain/cronos/memiavl"
 	memiavlcfg "github.com/crypto-org-chain/cronos/store/config"
+
+	"github.com/green901612/cosevm/mempool"
 )
 
 const (
@@ -68,6 +70,24 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultMempoolPriceBump is the default minimum price bump percentage to replace a pending eth tx
+	DefaultMempoolPriceBump = mempool.DefaultPriceBump
+
+	// DefaultMempoolAccountSlots is the default number of executable eth txs held per account
+	DefaultMempoolAccountSlots = mempool.DefaultAccountSlots
+
+	// DefaultMempoolGlobalSlots is the default maximum number of executable eth txs held across all accounts
+	DefaultMempoolGlobalSlots = mempool.DefaultGlobalSlots
+
+	// DefaultMempoolAccountQueue is the default number of future nonce eth txs held per account
+	DefaultMempoolAccountQueue = mempool.DefaultAccountQueue
+
+	// DefaultMempoolGlobalQueue is the default maximum number of future nonce eth txs held across all accounts
+	DefaultMempoolGlobalQueue = mempool.DefaultGlobalQueue
+
+	// DefaultJumpDestCacheSize is the default number of contract codes whose JUMPDEST analysis is cached
+	DefaultJumpDestCacheSize = 1024
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -174,6 +194,19 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// MempoolPriceBump defines the minimum price bump percentage to replace a pending eth tx with the same nonce.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolAccountSlots defines the number of executable eth txs held per account in the mempool.
+	MempoolAccountSlots uint64 `mapstructure:"mempool-account-slots"`
+	// MempoolGlobalSlots defines the maximum number of executable eth txs held in the mempool.
+	MempoolGlobalSlots uint64 `mapstructure:"mempool-global-slots"`
+	// MempoolAccountQueue defines the number of future nonce eth txs held per account in the mempool.
+	MempoolAccountQueue uint64 `mapstructure:"mempool-account-queue"`
+	// MempoolGlobalQueue defines the maximum number of future nonce eth txs held in the mempool.
+	MempoolGlobalQueue uint64 `mapstructure:"mempool-global-queue"`
+	// JumpDestCacheSize defines the number of contract codes whose JUMPDEST analysis is cached across transactions.
+	// The cache is disabled if it is zero.
+	JumpDestCacheSize int `mapstructure:"jumpdest-cache-size"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -300,8 +333,14 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:              DefaultEVMTracer,
+		MaxTxGasWanted:      DefaultMaxTxGasWanted,
+		MempoolPriceBump:    DefaultMempoolPriceBump,
+		MempoolAccountSlots: DefaultMempoolAccountSlots,
+		MempoolGlobalSlots:  DefaultMempoolGlobalSlots,
+		MempoolAccountQueue: DefaultMempoolAccountQueue,
+		MempoolGlobalQueue:  DefaultMempoolGlobalQueue,
+		JumpDestCacheSize:   DefaultJumpDestCacheSize,
 	}
 }
 
@@ -311,6 +350,18 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.MempoolAccountSlots > c.MempoolGlobalSlots {
+		return fmt.Errorf("mempool account slots (%d) cannot exceed the global slots (%d)", c.MempoolAccountSlots, c.MempoolGlobalSlots)
+	}
+
+	if c.MempoolAccountQueue > c.MempoolGlobalQueue {
+		return fmt.Errorf("mempool account queue (%d) cannot exceed the global queue (%d)", c.MempoolAccountQueue, c.MempoolGlobalQueue)
+	}
+
+	if c.JumpDestCacheSize < 0 {
+		return fmt.Errorf("jumpdest cache size cannot be negative: %d", c.JumpDestCacheSize)
+	}
+
 	return nil
 }
 
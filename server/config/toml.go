@@ -18,6 +18,25 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# MempoolPriceBump defines the minimum price bump percentage to replace a pending eth tx with the same nonce.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# MempoolAccountSlots defines the number of executable eth txs held per account in the mempool.
+mempool-account-slots = {{ .EVM.MempoolAccountSlots }}
+
+# MempoolGlobalSlots defines the maximum number of executable eth txs held in the mempool.
+mempool-global-slots = {{ .EVM.MempoolGlobalSlots }}
+
+# MempoolAccountQueue defines the number of future nonce eth txs held per account in the mempool.
+mempool-account-queue = {{ .EVM.MempoolAccountQueue }}
+
+# MempoolGlobalQueue defines the maximum number of future nonce eth txs held in the mempool.
+mempool-global-queue = {{ .EVM.MempoolGlobalQueue }}
+
+# JumpDestCacheSize defines the number of contract codes whose JUMPDEST analysis is cached across transactions.
+# The cache is disabled if it is zero.
+jumpdest-cache-size = {{ .EVM.JumpDestCacheSize }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
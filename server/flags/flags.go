@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package flags
+
+// JSON-RPC flags
+const (
+	JSONRPCEnable                   = "json-rpc.enable"
+	JSONRPCAPI                      = "json-rpc.api"
+	JSONRPCAddress                  = "json-rpc.address"
+	JSONWsAddress                   = "json-rpc.ws-address"
+	JSONRPCGasCap                   = "json-rpc.gas-cap"
+	JSONRPCAllowInsecureUnlock      = "json-rpc.allow-insecure-unlock"
+	JSONRPCEVMTimeout               = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap                 = "json-rpc.txfee-cap"
+	JSONRPCFilterCap                = "json-rpc.filter-cap"
+	JSONRPCFeeHistoryCap            = "json-rpc.feehistory-cap"
+	JSONRPCLogsCap                  = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap            = "json-rpc.block-range-cap"
+	JSONRPCHTTPTimeout              = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout          = "json-rpc.http-idle-timeout"
+	JSONRPCAllowUnprotectedTxs      = "json-rpc.allow-unprotected-txs"
+	JSONRPCMaxOpenConnections       = "json-rpc.max-open-connections"
+	JSONRPCEnableIndexer            = "json-rpc.enable-indexer"
+	JSONRPCMetricsAddress           = "json-rpc.metrics-address"
+	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	// JSONRPCEnableMetrics enables EVM RPC metrics server.
+	// Set to `metrics` which is hardcoded flag from go-ethereum.
+	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
+	JSONRPCEnableMetrics = "metrics"
+)
+
+// EVM flags
+const (
+	EVMTracer              = "evm.tracer"
+	EVMMaxTxGasWanted      = "evm.max-tx-gas-wanted"
+	EVMMempoolPriceBump    = "evm.mempool-price-bump"
+	EVMMempoolAccountSlots = "evm.mempool-account-slots"
+	EVMMempoolGlobalSlots  = "evm.mempool-global-slots"
+	EVMMempoolAccountQueue = "evm.mempool-account-queue"
+	EVMMempoolGlobalQueue  = "evm.mempool-global-queue"
+	EVMJumpDestCacheSize   = "evm.jumpdest-cache-size"
+)
+
+// TLS flags
+const (
+	TLSCertPath = "tls.certificate-path"
+	TLSKeyPath  = "tls.key-path"
+)
@@ -0,0 +1,106 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package server
+
+import (
+	"fmt"
+	"strconv"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+
+	"github.com/green901612/cosevm/indexer"
+)
+
+// NewIndexTxCmd returns the command that backfills the EVM tx indexer db
+// from the local CometBFT block and state stores.
+func NewIndexTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-eth-tx [start-height] [end-height]",
+		Short: "Index historical eth txs",
+		Long: `Index the eth txs of the blocks in the range [start-height, end-height] into the EVM tx indexer db.
+If end-height is omitted, the blocks are indexed up to the latest block in the local block store.
+
+The command reads the local CometBFT databases directly, so the node must be stopped while it runs.
+When the node is started with the indexer enabled, it resumes from the latest indexed block.
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height %s: %w", args[0], err)
+			}
+
+			cfg := serverCtx.Config
+			home := cfg.RootDir
+			logger := serverCtx.Logger
+			idxDB, err := OpenIndexerDB(home, sdkserver.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				return err
+			}
+			defer idxDB.Close()
+
+			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+
+			// open local CometBFT db, because the local rpc won't be available.
+			cmtdb, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := store.NewBlockStore(cmtdb)
+			defer blockStore.Close()
+
+			stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+			})
+			defer stateStore.Close()
+
+			endHeight := blockStore.Height()
+			if len(args) == 2 {
+				endHeight, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid end height %s: %w", args[1], err)
+				}
+			}
+
+			if startHeight < blockStore.Base() || endHeight > blockStore.Height() || startHeight > endHeight {
+				return fmt.Errorf(
+					"invalid height range [%d, %d], available blocks: [%d, %d]",
+					startHeight, endHeight, blockStore.Base(), blockStore.Height(),
+				)
+			}
+
+			for height := startHeight; height <= endHeight; height++ {
+				blk := blockStore.LoadBlock(height)
+				if blk == nil {
+					return fmt.Errorf("block not found %d", height)
+				}
+				resBlk, err := stateStore.LoadFinalizeBlockResponse(height)
+				if err != nil {
+					return err
+				}
+				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
+					return err
+				}
+				cmd.Println(height)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,136 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/green901612/cosevm/types"
+)
+
+const (
+	ServiceName = "EVMIndexerService"
+
+	NewBlockWaitTimeout = 60 * time.Second
+)
+
+// EVMIndexerService indexes transactions for json-rpc service.
+type EVMIndexerService struct {
+	service.BaseService
+
+	txIdxr types.EVMTxIndexer
+	client rpcclient.Client
+}
+
+// NewEVMIndexerService returns a new service instance.
+func NewEVMIndexerService(
+	txIdxr types.EVMTxIndexer,
+	client rpcclient.Client,
+) *EVMIndexerService {
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
+	return is
+}
+
+// OnStart implements service.Service by subscribing for new blocks
+// and indexing them by events.
+func (eis *EVMIndexerService) OnStart() error {
+	ctx := context.Background()
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	latestBlock := status.SyncInfo.LatestBlockHeight
+
+	blockHeadersChan, err := eis.client.Subscribe(
+		ctx,
+		ServiceName,
+		cmttypes.QueryForEvent(cmttypes.EventNewBlockHeader).String(),
+		0)
+	if err != nil {
+		return err
+	}
+
+	lastBlock, err := eis.txIdxr.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if lastBlock == -1 {
+		lastBlock = latestBlock
+	}
+
+	newBlockSignal := make(chan struct{}, 1)
+	go func() {
+		for {
+			select {
+			case <-eis.Quit():
+				return
+			case msg := <-blockHeadersChan:
+				if _, ok := msg.Data.(cmttypes.EventDataNewBlockHeader); !ok {
+					continue
+				}
+				// notify
+				select {
+				case newBlockSignal <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	go eis.indexLoop(ctx, lastBlock, newBlockSignal)
+
+	return nil
+}
+
+// OnStop implements service.Service by unsubscribing from new block events.
+func (eis *EVMIndexerService) OnStop() {
+	if err := eis.client.UnsubscribeAll(context.Background(), ServiceName); err != nil {
+		eis.Logger.Error("failed to unsubscribe from new block events", "err", err)
+	}
+}
+
+// indexLoop indexes every block after lastBlock up to the latest committed
+// block, then waits for the next new block signal. The last indexed block
+// only advances once a block has been successfully indexed.
+func (eis *EVMIndexerService) indexLoop(ctx context.Context, lastBlock int64, newBlockSignal <-chan struct{}) {
+	for {
+		status, err := eis.client.Status(ctx)
+		if err != nil {
+			eis.Logger.Error("failed to fetch node status", "err", err)
+		} else {
+			for i := lastBlock + 1; i <= status.SyncInfo.LatestBlockHeight; i++ {
+				block, err := eis.client.Block(ctx, &i)
+				if err != nil {
+					eis.Logger.Error("failed to fetch block", "height", i, "err", err)
+					break
+				}
+				blockResult, err := eis.client.BlockResults(ctx, &i)
+				if err != nil {
+					eis.Logger.Error("failed to fetch block result", "height", i, "err", err)
+					break
+				}
+				// the block is indexed again after the next signal, so that no
+				// block is skipped
+				if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+					eis.Logger.Error("failed to index block", "height", i, "err", err)
+					break
+				}
+				lastBlock = blockResult.Height
+			}
+		}
+
+		// nothing (left) to index, wait for the signal of a new block
+		select {
+		case <-eis.Quit():
+			return
+		case <-newBlockSignal:
+		case <-time.After(NewBlockWaitTimeout):
+		}
+	}
+}
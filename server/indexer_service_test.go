@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/types"
+)
+
+// mockClient implements the CometBFT client methods used by the indexer
+// service for a chain at the given height.
+type mockClient struct {
+	rpcclient.Client
+	height int64
+	events chan coretypes.ResultEvent
+}
+
+func (c mockClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: c.height}}, nil
+}
+
+func (c mockClient) Subscribe(context.Context, string, string, ...int) (<-chan coretypes.ResultEvent, error) {
+	return c.events, nil
+}
+
+func (c mockClient) UnsubscribeAll(context.Context, string) error {
+	return nil
+}
+
+func (c mockClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{Block: &cmttypes.Block{Header: cmttypes.Header{Height: *height}}}, nil
+}
+
+func (c mockClient) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	return &coretypes.ResultBlockResults{Height: *height}, nil
+}
+
+// mockIndexer records the indexed blocks and fails to index the block at the
+// given height once.
+type mockIndexer struct {
+	types.EVMTxIndexer
+	mu         sync.Mutex
+	failHeight int64
+	indexed    []int64
+}
+
+func (idx *mockIndexer) LastIndexedBlock() (int64, error) {
+	return 0, nil
+}
+
+func (idx *mockIndexer) IndexBlock(block *cmttypes.Block, _ []*abci.ExecTxResult) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if block.Height == idx.failHeight {
+		idx.failHeight = 0
+		return errors.New("index failure")
+	}
+	idx.indexed = append(idx.indexed, block.Height)
+	return nil
+}
+
+func (idx *mockIndexer) indexedBlocks() []int64 {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	return append([]int64{}, idx.indexed...)
+}
+
+func TestIndexerServiceRetriesFailedBlock(t *testing.T) {
+	client := mockClient{height: 3, events: make(chan coretypes.ResultEvent)}
+	idxer := &mockIndexer{failHeight: 2}
+
+	service := NewEVMIndexerService(idxer, client)
+	require.NoError(t, service.Start())
+	t.Cleanup(func() { require.NoError(t, service.Stop()) })
+
+	// the indexing stops at the failed block until the next new block
+	require.Eventually(t, func() bool {
+		return len(idxer.indexedBlocks()) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []int64{1}, idxer.indexedBlocks())
+
+	client.events <- coretypes.ResultEvent{Data: cmttypes.EventDataNewBlockHeader{}}
+	require.Eventually(t, func() bool {
+		return len(idxer.indexedBlocks()) == 3
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []int64{1, 2, 3}, idxer.indexedBlocks())
+}
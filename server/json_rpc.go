@@ -0,0 +1,126 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	ethlog "github.com/ethereum/go-ethereum/log"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/mux"
+	"github.com/rs/cors"
+
+	"github.com/green901612/cosevm/rpc"
+	"github.com/green901612/cosevm/server/config"
+	"github.com/green901612/cosevm/types"
+)
+
+// StartJSONRPC starts the Ethereum JSON-RPC HTTP server and the WebSocket
+// server. It returns the HTTP server together with a channel that is closed
+// once the server has been shut down, and a function that stops the WebSocket
+// server and the CometBFT websocket clients.
+func StartJSONRPC(ctx *sdkserver.Context,
+	clientCtx client.Context,
+	tmRPCAddr,
+	tmEndpoint string,
+	cfg *config.Config,
+	indexer types.EVMTxIndexer,
+) (*http.Server, chan struct{}, func(), error) {
+	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
+
+	logger := ctx.Logger.With("module", "geth")
+	ethlog.Root().SetHandler(ethlog.FuncHandler(func(r *ethlog.Record) error {
+		switch r.Lvl {
+		case ethlog.LvlTrace, ethlog.LvlDebug:
+			logger.Debug(r.Msg, r.Ctx...)
+		case ethlog.LvlInfo, ethlog.LvlWarn:
+			logger.Info(r.Msg, r.Ctx...)
+		case ethlog.LvlError, ethlog.LvlCrit:
+			logger.Error(r.Msg, r.Ctx...)
+		}
+		return nil
+	}))
+
+	rpcServer := ethrpc.NewServer()
+
+	allowUnprotectedTxs := cfg.JSONRPC.AllowUnprotectedTxs
+	rpcAPIArr := cfg.JSONRPC.API
+
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+
+	for _, api := range apis {
+		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
+			ctx.Logger.Error(
+				"failed to register service in JSON RPC namespace",
+				"namespace", api.Namespace,
+				"service", api.Service,
+			)
+			return nil, nil, nil, err
+		}
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+
+	handlerWithCors := cors.Default()
+	if cfg.API.EnableUnsafeCORS {
+		handlerWithCors = cors.AllowAll()
+	}
+
+	httpSrv := &http.Server{
+		Addr:              cfg.JSONRPC.Address,
+		Handler:           handlerWithCors.Handler(r),
+		ReadHeaderTimeout: cfg.JSONRPC.HTTPTimeout,
+		ReadTimeout:       cfg.JSONRPC.HTTPTimeout,
+		WriteTimeout:      cfg.JSONRPC.HTTPTimeout,
+		IdleTimeout:       cfg.JSONRPC.HTTPIdleTimeout,
+	}
+	httpSrvDone := make(chan struct{}, 1)
+
+	ln, err := Listen(httpSrv.Addr, cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	errCh := make(chan error)
+	go func() {
+		ctx.Logger.Info("Starting JSON-RPC server", "address", cfg.JSONRPC.Address)
+		if err := httpSrv.Serve(ln); err != nil {
+			if errors.Is(err, http.ErrServerClosed) {
+				close(httpSrvDone)
+				return
+			}
+
+			ctx.Logger.Error("failed to start JSON-RPC server", "error", err.Error())
+			errCh <- err
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		ctx.Logger.Error("failed to boot JSON-RPC server", "error", err.Error())
+		return nil, nil, nil, err
+	case <-time.After(config.ServerStartTime): // assume JSON RPC server started successfully
+	}
+
+	ctx.Logger.Info("Starting JSON WebSocket server", "address", cfg.JSONRPC.WsAddress)
+
+	// allocate separate WS connection to CometBFT
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger), cfg)
+	wsSrv.Start()
+
+	stopWS := func() {
+		wsSrv.Stop()
+		if tmWsClient != nil && tmWsClient.IsRunning() {
+			if err := tmWsClient.Stop(); err != nil {
+				ctx.Logger.Error("failed to stop CometBFT WS client", "error", err.Error())
+			}
+		}
+	}
+
+	return httpSrv, httpSrvDone, stopWS, nil
+}
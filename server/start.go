@@ -0,0 +1,186 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package server
+
+import (
+	"context"
+	"fmt"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/green901612/cosevm/indexer"
+	"github.com/green901612/cosevm/server/config"
+	srvflags "github.com/green901612/cosevm/server/flags"
+	"github.com/green901612/cosevm/types"
+)
+
+// NewStartCmdOptions returns the options for the SDK start command that
+// launch the Ethereum JSON-RPC and WebSocket servers next to the in-process
+// CometBFT node.
+func NewStartCmdOptions() sdkserver.StartCmdOptions {
+	return sdkserver.StartCmdOptions{
+		PostSetup: startEVMServices,
+		AddFlags:  AddStartCmdFlags,
+	}
+}
+
+// AddStartCmdFlags registers the EVM, JSON-RPC and TLS flags on the start command.
+func AddStartCmdFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(srvflags.JSONRPCEnable, config.DefaultJSONRPCEnable, "Define if the JSON-RPC server should be enabled")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, config.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCAddress, config.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
+	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, config.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aevmos (0=infinite)")
+	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, config.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http")
+	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, config.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")
+	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
+	cmd.Flags().Int32(srvflags.JSONRPCFeeHistoryCap, config.DefaultFeeHistoryCap, "Sets the global cap for total number of blocks that can be fetched")
+	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, config.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, config.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
+	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, config.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled")
+	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
+	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCMetricsAddress, config.DefaultJSONRPCMetricsAddress, "the EVM rpc metrics server address to listen on")
+
+	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)")
+	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, config.DefaultMempoolPriceBump, "the minimum price bump percentage to replace a pending eth tx with the same nonce")
+	cmd.Flags().Uint64(srvflags.EVMMempoolAccountSlots, config.DefaultMempoolAccountSlots, "the number of executable eth txs held per account in the mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalSlots, config.DefaultMempoolGlobalSlots, "the maximum number of executable eth txs held in the mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolAccountQueue, config.DefaultMempoolAccountQueue, "the number of future nonce eth txs held per account in the mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalQueue, config.DefaultMempoolGlobalQueue, "the maximum number of future nonce eth txs held in the mempool")
+	cmd.Flags().Int(srvflags.EVMJumpDestCacheSize, config.DefaultJumpDestCacheSize, "the number of contract codes whose JUMPDEST analysis is cached across transactions (0 disables the cache)")
+
+	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
+	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
+}
+
+// startEVMServices starts the EVM tx indexer and the JSON-RPC and WebSocket
+// servers (and the EVM metrics server if requested) and registers their
+// graceful shutdown in the start command's error group.
+func startEVMServices(svrCtx *sdkserver.Context, clientCtx client.Context, ctx context.Context, g *errgroup.Group) error {
+	cfg, err := config.GetConfig(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !cfg.JSONRPC.Enable && !cfg.JSONRPC.EnableIndexer {
+		return nil
+	}
+
+	cmtCfg := svrCtx.Config
+	tmRPCAddr := cmtCfg.RPC.ListenAddress
+	tmEndpoint := "/websocket"
+
+	if clientCtx.ChainID == "" {
+		appGenesis, err := genutiltypes.AppGenesisFromFile(cmtCfg.GenesisFile())
+		if err != nil {
+			return err
+		}
+		clientCtx = clientCtx.WithChainID(appGenesis.ChainID)
+	}
+
+	// the SDK only sets up a local CometBFT client when the API or gRPC server
+	// is enabled, fall back to the node's RPC endpoint otherwise
+	if clientCtx.Client == nil {
+		rpcClient, err := rpchttp.New(tmRPCAddr, tmEndpoint)
+		if err != nil {
+			return fmt.Errorf("failed to create CometBFT RPC client: %w", err)
+		}
+		clientCtx = clientCtx.WithClient(rpcClient)
+	}
+
+	// the HTTP client needs its websocket connection running for event subscriptions
+	if httpClient, ok := clientCtx.Client.(*rpchttp.HTTP); ok && !httpClient.IsRunning() {
+		if err := httpClient.Start(); err != nil {
+			return fmt.Errorf("failed to start CometBFT RPC client: %w", err)
+		}
+	}
+
+	var idxer types.EVMTxIndexer
+	if cfg.JSONRPC.EnableIndexer {
+		idxDB, err := OpenIndexerDB(cmtCfg.RootDir, sdkserver.GetAppDBBackend(svrCtx.Viper))
+		if err != nil {
+			svrCtx.Logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return err
+		}
+
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+
+		rpcClient, ok := clientCtx.Client.(rpcclient.Client)
+		if !ok {
+			return fmt.Errorf("invalid rpc client, expected: rpcclient.Client, got: %T", clientCtx.Client)
+		}
+		indexerService := NewEVMIndexerService(idxer, rpcClient)
+		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
+		if err := indexerService.Start(); err != nil {
+			return err
+		}
+
+		g.Go(func() error {
+			<-ctx.Done()
+
+			svrCtx.Logger.Info("stopping the EVM indexer service...")
+			if err := indexerService.Stop(); err != nil {
+				svrCtx.Logger.Error("EVM indexer service shutdown produced a warning", "error", err.Error())
+			}
+			return nil
+		})
+	}
+
+	if !cfg.JSONRPC.Enable {
+		return nil
+	}
+
+	if svrCtx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		metrics.Enabled = true
+		ethmetricsexp.Setup(cfg.JSONRPC.MetricsAddress)
+	}
+
+	httpSrv, httpSrvDone, stopWS, err := StartJSONRPC(svrCtx, clientCtx, tmRPCAddr, tmEndpoint, &cfg, idxer)
+	if err != nil {
+		return err
+	}
+
+	g.Go(func() error {
+		<-ctx.Done()
+
+		shutdownCtx, cancelFn := context.WithTimeout(context.Background(), config.ServerStartTime)
+		defer cancelFn()
+
+		svrCtx.Logger.Info("stopping the JSON-RPC server...")
+		stopWS()
+
+		if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+			svrCtx.Logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
+		} else {
+			svrCtx.Logger.Info("HTTP server shut down")
+			select {
+			case <-shutdownCtx.Done():
+			case <-httpSrvDone:
+			}
+		}
+
+		return nil
+	})
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package server
+
+import (
+	"net"
+	"path/filepath"
+	"time"
+
+	"cosmossdk.io/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	dbm "github.com/cosmos/cosmos-db"
+	"golang.org/x/net/netutil"
+
+	"github.com/green901612/cosevm/server/config"
+)
+
+// ConnectTmWS creates and starts a websocket client connected to the CometBFT
+// RPC server. Errors are logged and the (possibly unstarted) client is returned.
+func ConnectTmWS(tmRPCAddr, tmEndpoint string, logger log.Logger) *rpcclient.WSClient {
+	tmWsClient, err := rpcclient.NewWS(tmRPCAddr, tmEndpoint,
+		rpcclient.MaxReconnectAttempts(256),
+		rpcclient.ReadWait(120*time.Second),
+		rpcclient.WriteWait(120*time.Second),
+		rpcclient.PingPeriod(50*time.Second),
+		rpcclient.OnReconnect(func() {
+			logger.Debug("EVM RPC reconnects to CometBFT WS", "address", tmRPCAddr+tmEndpoint)
+		}),
+	)
+
+	if err != nil {
+		logger.Error(
+			"CometBFT WS client could not be created",
+			"address", tmRPCAddr+tmEndpoint,
+			"error", err,
+		)
+	} else if err := tmWsClient.Start(); err != nil {
+		logger.Error(
+			"CometBFT WS client could not start",
+			"address", tmRPCAddr+tmEndpoint,
+			"error", err,
+		)
+	}
+
+	return tmWsClient
+}
+
+// Listen starts a net.Listener on the tcp network on the given address.
+// If there is a specified MaxOpenConnections in the config, it will also set the limitListener.
+func Listen(addr string, cfg *config.Config) (net.Listener, error) {
+	if addr == "" {
+		addr = ":http"
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.JSONRPC.MaxOpenConnections > 0 {
+		ln = netutil.LimitListener(ln, cfg.JSONRPC.MaxOpenConnections)
+	}
+
+	return ln, nil
+}
+
+// OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
+func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmindexer", backendType, dataDir)
+}
@@ -0,0 +1,225 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+// Package testutil provides an in-memory application with a bonded validator
+// and funded accounts to test the modules and precompiles against the real
+// keepers.
+package testutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	"github.com/green901612/cosevm/crypto/ethsecp256k1"
+	"github.com/green901612/cosevm/utils"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// ChainID is the chain ID of the test application.
+const ChainID = utils.DevnetChainID + "-1"
+
+// Denom is the EVM coin denomination of the test application, which is also
+// its bond denomination.
+var Denom = evmtypes.ChainsCoinInfo[utils.DevnetChainID].Denom
+
+// AppOptions is a map based implementation of the server application options.
+type AppOptions map[string]interface{}
+
+// Get returns the option with the given key.
+func (o AppOptions) Get(key string) interface{} {
+	return o[key]
+}
+
+// Account is a test account controlled by an Ethereum key.
+type Account struct {
+	Key     *ethsecp256k1.PrivKey
+	Address common.Address
+	AccAddr sdk.AccAddress
+}
+
+// NewAccount returns a new test account with a random key.
+func NewAccount(t testing.TB) Account {
+	t.Helper()
+
+	key, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+
+	addr := common.BytesToAddress(key.PubKey().Address())
+	return Account{
+		Key:     key,
+		Address: addr,
+		AccAddr: addr.Bytes(),
+	}
+}
+
+// NewBalance returns the genesis balance of the given amount of EVM coins,
+// in the EVM coin denomination, of the account.
+func NewBalance(acc Account, amount sdkmath.Int) banktypes.Balance {
+	return banktypes.Balance{
+		Address: acc.AccAddr.String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(Denom, amount)),
+	}
+}
+
+// FundAccount mints the given amount of EVM coins to the account.
+func FundAccount(ctx sdk.Context, miniApp *app.MiniApp, acc Account, amount sdkmath.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(Denom, amount))
+	if err := miniApp.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins); err != nil {
+		return err
+	}
+	return miniApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, acc.AccAddr, coins)
+}
+
+// Setup returns an application with a single bonded validator and the given
+// genesis balances, after the execution of the genesis block, and the
+// context of the following block. The bond denomination of the staking
+// module is the EVM coin.
+func Setup(t testing.TB, balances ...banktypes.Balance) (*app.MiniApp, sdk.Context) {
+	t.Helper()
+
+	miniApp, err := app.NewMiniApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		AppOptions{},
+		baseapp.SetChainID(ChainID),
+	)
+	require.NoError(t, err)
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	genesisState := genesisStateWithValSet(t, miniApp, valSet, balances)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	_, err = miniApp.InitChain(&abci.RequestInitChain{
+		ChainId:         ChainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+		InitialHeight:   1,
+	})
+	require.NoError(t, err)
+
+	header := cmtproto.Header{
+		ChainID:            ChainID,
+		Height:             1,
+		Time:               time.Now().UTC(),
+		ProposerAddress:    valSet.Proposer.Address,
+		NextValidatorsHash: valSet.Hash(),
+	}
+	_, err = miniApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             header.Height,
+		Time:               header.Time,
+		ProposerAddress:    header.ProposerAddress,
+		NextValidatorsHash: header.NextValidatorsHash,
+		Hash:               []byte("genesis block hash"),
+	})
+	require.NoError(t, err)
+	_, err = miniApp.Commit()
+	require.NoError(t, err)
+
+	header.Height++
+	header.Time = header.Time.Add(time.Second)
+	ctx := miniApp.NewUncachedContext(false, header).
+		WithHeaderHash(common.BytesToHash([]byte("block hash")).Bytes()).
+		WithConsensusParams(*simtestutil.DefaultConsensusParams)
+	return miniApp, ctx
+}
+
+// genesisStateWithValSet returns the default genesis state of the application
+// with the given bonded validators, self-delegated by their operators, and
+// genesis balances.
+func genesisStateWithValSet(
+	t testing.TB,
+	miniApp *app.MiniApp,
+	valSet *cmttypes.ValidatorSet,
+	balances []banktypes.Balance,
+) map[string]json.RawMessage {
+	t.Helper()
+
+	cdc := miniApp.AppCodec()
+	genesisState := miniApp.DefaultGenesis()
+	bondAmt := sdk.DefaultPowerReduction
+
+	genAccs := make([]authtypes.GenesisAccount, 0, len(balances)+len(valSet.Validators))
+	for _, b := range balances {
+		genAccs = append(genAccs, authtypes.NewBaseAccount(sdk.MustAccAddressFromBech32(b.Address), nil, 0, 0))
+	}
+
+	validators := make([]stakingtypes.Validator, 0, len(valSet.Validators))
+	delegations := make([]stakingtypes.Delegation, 0, len(valSet.Validators))
+	for _, val := range valSet.Validators {
+		pk, err := cryptocodec.FromCmtPubKeyInterface(val.PubKey)
+		require.NoError(t, err)
+		pkAny, err := codectypes.NewAnyWithValue(pk)
+		require.NoError(t, err)
+
+		operator := sdk.AccAddress(val.Address)
+		genAccs = append(genAccs, authtypes.NewBaseAccount(operator, nil, 0, 0))
+		validators = append(validators, stakingtypes.Validator{
+			OperatorAddress:   sdk.ValAddress(val.Address).String(),
+			ConsensusPubkey:   pkAny,
+			Status:            stakingtypes.Bonded,
+			Tokens:            bondAmt,
+			DelegatorShares:   sdkmath.LegacyNewDecFromInt(bondAmt),
+			UnbondingTime:     time.Unix(0, 0).UTC(),
+			Commission:        stakingtypes.NewCommission(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+			MinSelfDelegation: sdkmath.ZeroInt(),
+		})
+		delegations = append(delegations, stakingtypes.NewDelegation(
+			operator.String(), sdk.ValAddress(val.Address).String(), sdkmath.LegacyNewDecFromInt(bondAmt),
+		))
+	}
+
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs)
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = Denom
+	stakingGenesis := stakingtypes.NewGenesisState(stakingParams, validators, delegations)
+	genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenesis)
+
+	// the bonded tokens are held by the bonded pool
+	bonded := sdk.NewCoins(sdk.NewCoin(Denom, bondAmt.MulRaw(int64(len(delegations)))))
+	balances = append(balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+		Coins:   bonded,
+	})
+
+	totalSupply := sdk.NewCoins()
+	for _, b := range balances {
+		totalSupply = totalSupply.Add(b.Coins...)
+	}
+
+	bankGenesis := banktypes.NewGenesisState(
+		banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{}, []banktypes.SendEnabled{},
+	)
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
+
+	return genesisState
+}
@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package testutil
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/app"
+	evmante "github.com/green901612/cosevm/x/evm/ante"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// CallContract calls the given method of the contract, or precompile, with
+// the given ABI from the given account and commits the state changes of the
+// call. The call runs in the execution context of an Ethereum transaction,
+// with a gas meter of its own. It returns the response of the call along
+// with its unpacked outputs. The call fails if the EVM execution reverts.
+func CallContract(
+	ctx sdk.Context,
+	miniApp *app.MiniApp,
+	from, contract common.Address,
+	contractABI abi.ABI,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, []interface{}, error) {
+	input, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx = evmante.BuildEvmExecutionCtx(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	res, err := miniApp.EvmKeeper.CallEVMWithData(ctx, from, &contract, input, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outputs, err := contractABI.Unpack(method, res.Ret)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, outputs, nil
+}
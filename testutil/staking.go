@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package testutil
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+)
+
+// GenesisValidator returns the address of the validator bonded at genesis by
+// Setup.
+func GenesisValidator(t testing.TB, miniApp *app.MiniApp, ctx sdk.Context) sdk.ValAddress {
+	t.Helper()
+
+	validators, err := miniApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	require.NoError(t, err)
+	return valAddr
+}
+
+// CreateValidator creates a validator operated by the given account, with a
+// self-delegation of the given amount of the bond denomination. The validator
+// is unbonded until the end of the block.
+func CreateValidator(t testing.TB, miniApp *app.MiniApp, ctx sdk.Context, operator Account, amount sdkmath.Int) sdk.ValAddress {
+	t.Helper()
+
+	valAddr := sdk.ValAddress(operator.AccAddr)
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr.String(),
+		ed25519.GenPrivKey().PubKey(),
+		sdk.NewCoin(Denom, amount),
+		stakingtypes.NewDescription("validator", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+		sdkmath.OneInt(),
+	)
+	require.NoError(t, err)
+
+	_, err = stakingkeeper.NewMsgServerImpl(miniApp.StakingKeeper).CreateValidator(ctx, msg)
+	require.NoError(t, err)
+	return valAddr
+}
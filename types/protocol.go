@@ -0,0 +1,11 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+// Constants to match up protocol versions and messages
+const (
+	eth65 = 65
+
+	// ProtocolVersion is the latest supported version of the eth protocol.
+	ProtocolVersion = eth65
+)
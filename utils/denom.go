@@ -0,0 +1,25 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package utils
+
+import (
+	"crypto/sha256"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GetDenomAddress returns the deterministic token address of the given bank
+// denomination. IBC vouchers use the hash of their denomination trace, as in
+// GetIBCDenomAddress, while the other denominations use the last 20 bytes of
+// the SHA-256 hash of the denomination.
+func GetDenomAddress(denom string) common.Address {
+	if strings.HasPrefix(denom, "ibc/") {
+		if addr, err := GetIBCDenomAddress(denom); err == nil {
+			return addr
+		}
+	}
+
+	hash := sha256.Sum256([]byte(denom))
+	return common.BytesToAddress(hash[:])
+}
@@ -0,0 +1,55 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package erc20
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/erc20/keeper"
+	"github.com/green901612/cosevm/x/erc20/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
+	}
+
+	// ensure erc20 module account is set on genesis
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the erc20 module account has not been set")
+	}
+
+	for _, pair := range data.TokenPairs {
+		k.RegisterTokenPair(ctx, pair)
+	}
+
+	for _, allowance := range data.Allowances {
+		k.SetAllowance(
+			ctx,
+			common.HexToAddress(allowance.Erc20Address),
+			common.HexToAddress(allowance.Owner),
+			common.HexToAddress(allowance.Spender),
+			allowance.Value.BigInt(),
+		)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the erc20 module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		k.GetTokenPairs(ctx),
+		k.GetAllowances(ctx),
+	)
+}
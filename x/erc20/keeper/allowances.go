@@ -0,0 +1,62 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/erc20/types"
+)
+
+// GetAllowance returns the allowance of the spender over the tokens of the
+// owner of the given dynamic ERC-20 precompile. It returns zero if no
+// allowance is set.
+func (k Keeper) GetAllowance(ctx sdk.Context, erc20, owner, spender common.Address) *big.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	bz := store.Get(types.AllowanceKey(erc20, owner, spender))
+	if len(bz) == 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).SetBytes(bz)
+}
+
+// SetAllowance sets the allowance of the spender over the tokens of the owner
+// of the given dynamic ERC-20 precompile. A zero value deletes the allowance.
+func (k Keeper) SetAllowance(ctx sdk.Context, erc20, owner, spender common.Address, value *big.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	key := types.AllowanceKey(erc20, owner, spender)
+
+	if value.Sign() == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, value.Bytes())
+}
+
+// GetAllowances returns all the allowances of the dynamic ERC-20 precompiles.
+func (k Keeper) GetAllowances(ctx sdk.Context) []types.Allowance {
+	allowances := []types.Allowance{}
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.KeyPrefixAllowance):]
+
+		allowances = append(allowances, types.Allowance{
+			Erc20Address: common.BytesToAddress(key[:common.AddressLength]).String(),
+			Owner:        common.BytesToAddress(key[common.AddressLength : 2*common.AddressLength]).String(),
+			Spender:      common.BytesToAddress(key[2*common.AddressLength:]).String(),
+			Value:        math.NewIntFromBigInt(new(big.Int).SetBytes(iterator.Value())),
+		})
+	}
+
+	return allowances
+}
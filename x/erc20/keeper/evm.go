@@ -0,0 +1,134 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/green901612/cosevm/contracts"
+	"github.com/green901612/cosevm/x/erc20/types"
+)
+
+// DeployERC20Contract deploys the ERC20MinterBurnerDecimals contract of the
+// native Cosmos coin with the given metadata from the erc20 module account,
+// which is granted the minter and burner roles of the token.
+func (k Keeper) DeployERC20Contract(ctx sdk.Context, metadata banktypes.Metadata) (common.Address, error) {
+	decimals, err := displayDecimals(metadata)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(
+		"",
+		metadata.Name,
+		metadata.Symbol,
+		decimals,
+	)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(types.ErrABIPack, "coin metadata is invalid %s: %s", metadata.Name, err)
+	}
+
+	data := make([]byte, 0, len(contracts.ERC20MinterBurnerDecimalsContract.Bin)+len(ctorArgs))
+	data = append(data, contracts.ERC20MinterBurnerDecimalsContract.Bin...)
+	data = append(data, ctorArgs...)
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	contractAddr := crypto.CreateAddress(types.ModuleAddress, nonce)
+	if _, err := k.evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, nil, data, true); err != nil {
+		return common.Address{}, errorsmod.Wrapf(err, "failed to deploy contract for %s", metadata.Name)
+	}
+
+	return contractAddr, nil
+}
+
+// QueryERC20 returns the name, symbol and decimals of the given ERC-20
+// contract.
+func (k Keeper) QueryERC20(ctx sdk.Context, contract common.Address) (types.ERC20Data, error) {
+	var (
+		nameRes    types.ERC20StringResponse
+		symbolRes  types.ERC20StringResponse
+		decimalRes types.ERC20Uint8Response
+	)
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	if err := k.queryERC20(ctx, erc20, contract, "name", &nameRes); err != nil {
+		return types.ERC20Data{}, err
+	}
+
+	if err := k.queryERC20(ctx, erc20, contract, "symbol", &symbolRes); err != nil {
+		return types.ERC20Data{}, err
+	}
+
+	if err := k.queryERC20(ctx, erc20, contract, "decimals", &decimalRes); err != nil {
+		return types.ERC20Data{}, err
+	}
+
+	return types.NewERC20Data(nameRes.Value, symbolRes.Value, decimalRes.Value), nil
+}
+
+// BalanceOf returns the ERC-20 token balance of the given account. It returns
+// nil if the balance can't be queried.
+func (k Keeper) BalanceOf(ctx sdk.Context, abi abi.ABI, contract, account common.Address) *big.Int {
+	res, err := k.evmKeeper.CallEVM(ctx, abi, types.ModuleAddress, contract, false, "balanceOf", account)
+	if err != nil {
+		return nil
+	}
+
+	unpacked, err := abi.Unpack("balanceOf", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil
+	}
+
+	balance, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+
+	return balance
+}
+
+// queryERC20 calls the given view method of the ERC-20 contract without
+// committing the state and unpacks its result into the response.
+func (k Keeper) queryERC20(ctx sdk.Context, abi abi.ABI, contract common.Address, method string, response interface{}) error {
+	res, err := k.evmKeeper.CallEVM(ctx, abi, types.ModuleAddress, contract, false, method)
+	if err != nil {
+		return err
+	}
+
+	if err := abi.UnpackIntoInterface(response, method, res.Ret); err != nil {
+		return errorsmod.Wrapf(types.ErrABIUnpack, "failed to unpack %s: %s", method, err.Error())
+	}
+
+	return nil
+}
+
+// displayDecimals returns the exponent of the display denomination unit of
+// the given metadata, which defines the decimals of its ERC-20 token.
+func displayDecimals(metadata banktypes.Metadata) (uint8, error) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom != metadata.Display {
+			continue
+		}
+
+		if unit.Exponent > math.MaxUint8 {
+			return 0, errorsmod.Wrapf(types.ErrInvalidMetadata, "exponent of the display denomination %s overflows uint8: %d", unit.Denom, unit.Exponent)
+		}
+
+		return uint8(unit.Exponent), nil
+	}
+
+	return 0, errorsmod.Wrapf(types.ErrInvalidMetadata, "display denomination %s not found in the denom units of %s", metadata.Display, metadata.Base)
+}
@@ -0,0 +1,426 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/contracts"
+	"github.com/green901612/cosevm/x/erc20/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// ConvertCoin converts a native Cosmos coin into its ERC-20 token
+// representation. Native coins are escrowed by the erc20 module account and
+// the tokens are minted by their ERC-20 contract, while the coin
+// representations of external ERC-20 tokens are burned and the escrowed
+// tokens are transferred to the receiver.
+func (k *Keeper) ConvertCoin(goCtx context.Context, msg *types.MsgConvertCoin) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	receiver := common.HexToAddress(msg.Receiver)
+
+	tokenPair, err := k.MintingEnabled(ctx, msg.Coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case tokenPair.IsNativeCoin():
+		err = k.convertCoinNativeCoin(ctx, tokenPair, msg, receiver, sender)
+	case tokenPair.IsNativeERC20():
+		err = k.convertCoinNativeERC20(ctx, tokenPair, msg, receiver, sender)
+	default:
+		err = types.ErrInvalidOwner
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertCoin,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, msg.Coin.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, tokenPair.Erc20Address),
+		),
+	)
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// ConvertERC20 converts ERC-20 tokens into their native Cosmos coin
+// representation. The tokens of native coins are burned by their ERC-20
+// contract and the escrowed coins are released, while the tokens of external
+// ERC-20 contracts are escrowed by the erc20 module account and their coin
+// representation is minted.
+func (k *Keeper) ConvertERC20(goCtx context.Context, msg *types.MsgConvertERC20) (*types.MsgConvertERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", msg.Receiver)
+	}
+
+	tokenPair, err := k.MintingEnabled(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case tokenPair.IsNativeCoin():
+		err = k.convertERC20NativeCoin(ctx, tokenPair, msg, receiver, common.BytesToAddress(sender))
+	case tokenPair.IsNativeERC20():
+		err = k.convertERC20NativeToken(ctx, tokenPair, msg, receiver, common.BytesToAddress(sender))
+	default:
+		err = types.ErrInvalidOwner
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertERC20,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, tokenPair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, msg.ContractAddress),
+		),
+	)
+
+	return &types.MsgConvertERC20Response{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RegisterCoin implements the gRPC MsgServer interface. It registers a token
+// pair for a native Cosmos coin and deploys its ERC-20 contract. It can only
+// be performed by the module authority.
+func (k *Keeper) RegisterCoin(goCtx context.Context, req *types.MsgRegisterCoin) (*types.MsgRegisterCoinResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	tokenPair, err := k.registerCoin(ctx, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCoin,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, tokenPair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, tokenPair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyPrecompile, tokenPair.GetPrecompileAddress().String()),
+		),
+	)
+
+	return &types.MsgRegisterCoinResponse{Erc20Address: tokenPair.Erc20Address}, nil
+}
+
+// RegisterERC20 implements the gRPC MsgServer interface. It registers a token
+// pair for an external ERC-20 contract. It can only be performed by the module
+// authority.
+func (k *Keeper) RegisterERC20(goCtx context.Context, req *types.MsgRegisterERC20) (*types.MsgRegisterERC20Response, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	tokenPair, err := k.registerERC20(ctx, common.HexToAddress(req.Erc20Address))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, tokenPair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, tokenPair.Erc20Address),
+		),
+	)
+
+	return &types.MsgRegisterERC20Response{Denom: tokenPair.Denom}, nil
+}
+
+// ToggleConversion implements the gRPC MsgServer interface. It enables or
+// disables the conversions of a token pair. It can only be performed by the
+// module authority.
+func (k *Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleConversion) (*types.MsgToggleConversionResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	tokenPair, err := k.toggleConversion(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeToggleConversion,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, tokenPair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, tokenPair.Erc20Address),
+		),
+	)
+
+	return &types.MsgToggleConversionResponse{}, nil
+}
+
+// checkAuthority checks that the given address is the module authority.
+func (k Keeper) checkAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), authority)
+	}
+	return nil
+}
+
+// MintingEnabled checks that the conversions are enabled for the token pair
+// of the given token, which can be either the hex address of its ERC-20
+// contract or its denomination, and returns the token pair.
+func (k Keeper) MintingEnabled(ctx sdk.Context, token string) (types.TokenPair, error) {
+	if !k.IsERC20Enabled(ctx) {
+		return types.TokenPair{}, types.ErrERC20Disabled
+	}
+
+	tokenPair, found := k.GetTokenPairByToken(ctx, token)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", token)
+	}
+
+	if !tokenPair.Enabled {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairDisabled, "minting token '%s' is not enabled by governance", token)
+	}
+
+	return tokenPair, nil
+}
+
+// convertCoinNativeCoin escrows the native coins of the sender in the erc20
+// module account and mints the same amount of ERC-20 tokens to the receiver.
+func (k Keeper) convertCoinNativeCoin(
+	ctx sdk.Context,
+	tokenPair types.TokenPair,
+	msg *types.MsgConvertCoin,
+	receiver common.Address,
+	sender sdk.AccAddress,
+) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := tokenPair.GetERC20Contract()
+	amount := msg.Coin.Amount.BigInt()
+
+	balanceToken := k.BalanceOf(ctx, erc20, contract, receiver)
+	if balanceToken == nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	// escrow the coins in the module account
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{msg.Coin}); err != nil {
+		return err
+	}
+
+	// mint the tokens to the receiver
+	if _, err := k.evmKeeper.CallEVM(ctx, erc20, types.ModuleAddress, contract, true, "mint", receiver, amount); err != nil {
+		return err
+	}
+
+	return k.checkTokenBalance(ctx, erc20, contract, receiver, new(big.Int).Add(balanceToken, amount))
+}
+
+// convertCoinNativeERC20 burns the coin representation of an external ERC-20
+// token and transfers the same amount of escrowed tokens from the erc20
+// module account to the receiver.
+func (k Keeper) convertCoinNativeERC20(
+	ctx sdk.Context,
+	tokenPair types.TokenPair,
+	msg *types.MsgConvertCoin,
+	receiver common.Address,
+	sender sdk.AccAddress,
+) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := tokenPair.GetERC20Contract()
+	amount := msg.Coin.Amount.BigInt()
+	coins := sdk.Coins{msg.Coin}
+
+	balanceToken := k.BalanceOf(ctx, erc20, contract, receiver)
+	if balanceToken == nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	// burn the coin representation
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	// release the escrowed tokens to the receiver
+	if err := k.transferERC20(ctx, erc20, contract, types.ModuleAddress, receiver, amount); err != nil {
+		return err
+	}
+
+	return k.checkTokenBalance(ctx, erc20, contract, receiver, new(big.Int).Add(balanceToken, amount))
+}
+
+// convertERC20NativeCoin burns the ERC-20 tokens of a native coin held by the
+// sender and releases the same amount of escrowed coins from the erc20 module
+// account to the receiver.
+func (k Keeper) convertERC20NativeCoin(
+	ctx sdk.Context,
+	tokenPair types.TokenPair,
+	msg *types.MsgConvertERC20,
+	receiver sdk.AccAddress,
+	sender common.Address,
+) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := tokenPair.GetERC20Contract()
+	amount := msg.Amount.BigInt()
+	coins := sdk.Coins{sdk.NewCoin(tokenPair.Denom, msg.Amount)}
+
+	balanceToken := k.BalanceOf(ctx, erc20, contract, sender)
+	if balanceToken == nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	// burn the tokens of the sender
+	if _, err := k.evmKeeper.CallEVM(ctx, erc20, types.ModuleAddress, contract, true, "burnCoins", sender, amount); err != nil {
+		return err
+	}
+
+	if err := k.checkTokenBalance(ctx, erc20, contract, sender, new(big.Int).Sub(balanceToken, amount)); err != nil {
+		return err
+	}
+
+	// release the escrowed coins to the receiver
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins)
+}
+
+// convertERC20NativeToken escrows the external ERC-20 tokens of the sender in
+// the erc20 module account and mints the same amount of their coin
+// representation to the receiver.
+func (k Keeper) convertERC20NativeToken(
+	ctx sdk.Context,
+	tokenPair types.TokenPair,
+	msg *types.MsgConvertERC20,
+	receiver sdk.AccAddress,
+	sender common.Address,
+) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := tokenPair.GetERC20Contract()
+	amount := msg.Amount.BigInt()
+	coins := sdk.Coins{sdk.NewCoin(tokenPair.Denom, msg.Amount)}
+
+	balanceToken := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+	if balanceToken == nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	// escrow the tokens of the sender in the module account
+	if err := k.transferERC20(ctx, erc20, contract, sender, types.ModuleAddress, amount); err != nil {
+		return err
+	}
+
+	if err := k.checkTokenBalance(ctx, erc20, contract, types.ModuleAddress, new(big.Int).Add(balanceToken, amount)); err != nil {
+		return err
+	}
+
+	// mint the coin representation to the receiver
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins)
+}
+
+// transferERC20 calls the transfer method of the ERC-20 contract from the
+// given account and checks that it succeeded.
+func (k Keeper) transferERC20(
+	ctx sdk.Context,
+	erc20 abi.ABI,
+	contract, from, to common.Address,
+	amount *big.Int,
+) error {
+	res, err := k.evmKeeper.CallEVM(ctx, erc20, from, contract, true, "transfer", to, amount)
+	if err != nil {
+		return err
+	}
+
+	var unpackedRet types.ERC20BoolResponse
+	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil {
+		return errorsmod.Wrapf(types.ErrABIUnpack, "failed to unpack transfer: %s", err.Error())
+	}
+
+	if !unpackedRet.Value {
+		return errorsmod.Wrap(errortypes.ErrLogic, "failed to execute transfer")
+	}
+
+	return nil
+}
+
+// checkTokenBalance checks that the ERC-20 token balance of the account
+// matches the expected one after a conversion.
+func (k Keeper) checkTokenBalance(
+	ctx sdk.Context,
+	erc20 abi.ABI,
+	contract, account common.Address,
+	expected *big.Int,
+) error {
+	balance := k.BalanceOf(ctx, erc20, contract, account)
+	if balance == nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	if balance.Cmp(expected) != 0 {
+		return errorsmod.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance - expected: %v, actual: %v", expected, balance,
+		)
+	}
+
+	return nil
+}
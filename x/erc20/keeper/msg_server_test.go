@@ -0,0 +1,377 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/app"
+	"github.com/green901612/cosevm/contracts"
+	erc20precompile "github.com/green901612/cosevm/precompiles/erc20"
+	"github.com/green901612/cosevm/testutil"
+	"github.com/green901612/cosevm/x/erc20/types"
+	evmante "github.com/green901612/cosevm/x/evm/ante"
+)
+
+const coinDenom = "atoken"
+
+var (
+	// amount is the amount of coins and tokens held by the test account
+	amount = sdkmath.NewInt(1_000_000)
+	erc20  = contracts.ERC20MinterBurnerDecimalsContract.ABI
+)
+
+type testSuite struct {
+	miniApp *app.MiniApp
+	ctx     sdk.Context
+	account testutil.Account
+}
+
+// setupTest returns an application where the test account holds EVM coins
+// and native coins of the coin denomination.
+func setupTest(t *testing.T) *testSuite {
+	t.Helper()
+
+	account := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, banktypes.Balance{
+		Address: account.AccAddr.String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(testutil.Denom, sdk.DefaultPowerReduction), sdk.NewCoin(coinDenom, amount)),
+	})
+
+	return &testSuite{
+		miniApp: miniApp,
+		ctx:     ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()),
+		account: account,
+	}
+}
+
+func (s *testSuite) msgServer() types.MsgServer {
+	return &s.miniApp.Erc20Keeper
+}
+
+func (s *testSuite) authority() string {
+	return s.miniApp.Erc20Keeper.GetAuthority().String()
+}
+
+func coinMetadata() banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "native coin",
+		Base:        coinDenom,
+		Display:     "token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: coinDenom, Exponent: 0},
+			{Denom: "token", Exponent: 18},
+		},
+		Name:   "Token",
+		Symbol: "TOKEN",
+	}
+}
+
+// registerCoin registers the token pair of the native coin.
+func (s *testSuite) registerCoin(t *testing.T) types.TokenPair {
+	t.Helper()
+
+	res, err := s.msgServer().RegisterCoin(s.ctx, &types.MsgRegisterCoin{Authority: s.authority(), Metadata: coinMetadata()})
+	require.NoError(t, err)
+
+	tokenPair, found := s.miniApp.Erc20Keeper.GetTokenPairByToken(s.ctx, coinDenom)
+	require.True(t, found)
+	require.Equal(t, res.Erc20Address, tokenPair.Erc20Address)
+	return tokenPair
+}
+
+// deployERC20 deploys an external ERC-20 contract, owned by the test account,
+// and mints the test amount of tokens to it.
+func (s *testSuite) deployERC20(t *testing.T) common.Address {
+	t.Helper()
+
+	ctorArgs, err := erc20.Pack("", "External", "EXT", uint8(6))
+	require.NoError(t, err)
+	data := append(append([]byte{}, contracts.ERC20MinterBurnerDecimalsContract.Bin...), ctorArgs...)
+
+	nonce, err := s.miniApp.AccountKeeper.GetSequence(s.ctx, s.account.AccAddr)
+	require.NoError(t, err)
+
+	ctx := evmante.BuildEvmExecutionCtx(s.ctx)
+	_, err = s.miniApp.EvmKeeper.CallEVMWithData(ctx, s.account.Address, nil, data, true)
+	require.NoError(t, err)
+
+	contract := crypto.CreateAddress(s.account.Address, nonce)
+	_, _, err = testutil.CallContract(s.ctx, s.miniApp, s.account.Address, contract, erc20, "mint", s.account.Address, amount.BigInt())
+	require.NoError(t, err)
+	return contract
+}
+
+// registerERC20 deploys an external ERC-20 contract and registers its token
+// pair.
+func (s *testSuite) registerERC20(t *testing.T) types.TokenPair {
+	t.Helper()
+
+	contract := s.deployERC20(t)
+	res, err := s.msgServer().RegisterERC20(s.ctx, &types.MsgRegisterERC20{Authority: s.authority(), Erc20Address: contract.Hex()})
+	require.NoError(t, err)
+
+	tokenPair, found := s.miniApp.Erc20Keeper.GetTokenPairByToken(s.ctx, contract.Hex())
+	require.True(t, found)
+	require.Equal(t, res.Denom, tokenPair.Denom)
+	return tokenPair
+}
+
+func (s *testSuite) coinBalance(addr sdk.AccAddress, denom string) sdkmath.Int {
+	return s.miniApp.BankKeeper.GetBalance(s.ctx, addr, denom).Amount
+}
+
+func (s *testSuite) tokenBalance(contract, account common.Address) *big.Int {
+	return s.miniApp.Erc20Keeper.BalanceOf(s.ctx, erc20, contract, account)
+}
+
+func TestRegisterCoin(t *testing.T) {
+	s := setupTest(t)
+
+	// only the authority can register a coin
+	_, err := s.msgServer().RegisterCoin(s.ctx, &types.MsgRegisterCoin{Authority: s.account.AccAddr.String(), Metadata: coinMetadata()})
+	require.ErrorContains(t, err, "invalid authority")
+
+	// the EVM coin has no token pair
+	evmMetadata := coinMetadata()
+	evmMetadata.Base = testutil.Denom
+	evmMetadata.DenomUnits[0].Denom = testutil.Denom
+	_, err = s.msgServer().RegisterCoin(s.ctx, &types.MsgRegisterCoin{Authority: s.authority(), Metadata: evmMetadata})
+	require.ErrorIs(t, err, types.ErrEVMDenom)
+
+	// a coin without supply can't be registered
+	noSupply := coinMetadata()
+	noSupply.Base = "anosupply"
+	noSupply.DenomUnits[0].Denom = "anosupply"
+	_, err = s.msgServer().RegisterCoin(s.ctx, &types.MsgRegisterCoin{Authority: s.authority(), Metadata: noSupply})
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	tokenPair := s.registerCoin(t)
+	require.True(t, tokenPair.IsNativeCoin())
+	require.True(t, tokenPair.Enabled)
+
+	// the ERC-20 contract of the coin is deployed with the metadata of the coin
+	data, err := s.miniApp.Erc20Keeper.QueryERC20(s.ctx, tokenPair.GetERC20Contract())
+	require.NoError(t, err)
+	require.Equal(t, types.NewERC20Data("Token", "TOKEN", 18), data)
+
+	metadata, found := s.miniApp.BankKeeper.GetDenomMetaData(s.ctx, coinDenom)
+	require.True(t, found)
+	require.Equal(t, coinMetadata(), metadata)
+
+	denom, found := s.miniApp.Erc20Keeper.GetDenomPrecompile(s.ctx, tokenPair.GetPrecompileAddress())
+	require.True(t, found)
+	require.Equal(t, coinDenom, denom)
+
+	// a coin can only be registered once
+	_, err = s.msgServer().RegisterCoin(s.ctx, &types.MsgRegisterCoin{Authority: s.authority(), Metadata: coinMetadata()})
+	require.ErrorIs(t, err, types.ErrTokenPairAlreadyExists)
+}
+
+func TestRegisterERC20(t *testing.T) {
+	s := setupTest(t)
+	contract := s.deployERC20(t)
+
+	// only the authority can register a contract
+	_, err := s.msgServer().RegisterERC20(s.ctx, &types.MsgRegisterERC20{Authority: s.account.AccAddr.String(), Erc20Address: contract.Hex()})
+	require.ErrorContains(t, err, "invalid authority")
+
+	// an account without code is not an ERC-20 contract
+	_, err = s.msgServer().RegisterERC20(s.ctx, &types.MsgRegisterERC20{Authority: s.authority(), Erc20Address: s.account.Address.Hex()})
+	require.ErrorIs(t, err, types.ErrInvalidTokenPair)
+
+	res, err := s.msgServer().RegisterERC20(s.ctx, &types.MsgRegisterERC20{Authority: s.authority(), Erc20Address: contract.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, types.CreateDenom(contract), res.Denom)
+
+	tokenPair, found := s.miniApp.Erc20Keeper.GetTokenPairByToken(s.ctx, res.Denom)
+	require.True(t, found)
+	require.True(t, tokenPair.IsNativeERC20())
+	require.Equal(t, contract, tokenPair.GetERC20Contract())
+
+	// the coin representation of the tokens has the decimals of the contract
+	metadata, found := s.miniApp.BankKeeper.GetDenomMetaData(s.ctx, res.Denom)
+	require.True(t, found)
+	require.Equal(t, "ext", metadata.Display)
+	require.Equal(t, uint32(6), metadata.DenomUnits[1].Exponent)
+
+	// only the native coins have a dynamic precompile
+	_, found = s.miniApp.Erc20Keeper.GetDenomPrecompile(s.ctx, tokenPair.GetPrecompileAddress())
+	require.False(t, found)
+
+	_, err = s.msgServer().RegisterERC20(s.ctx, &types.MsgRegisterERC20{Authority: s.authority(), Erc20Address: contract.Hex()})
+	require.ErrorIs(t, err, types.ErrTokenPairAlreadyExists)
+}
+
+func TestConvertNativeCoin(t *testing.T) {
+	s := setupTest(t)
+	tokenPair := s.registerCoin(t)
+	contract := tokenPair.GetERC20Contract()
+	half := amount.QuoRaw(2)
+
+	// the coins are escrowed by the module account and the tokens are minted
+	_, err := s.msgServer().ConvertCoin(s.ctx, types.NewMsgConvertCoin(sdk.NewCoin(coinDenom, half), s.account.Address, s.account.AccAddr))
+	require.NoError(t, err)
+	require.Equal(t, half, s.coinBalance(s.account.AccAddr, coinDenom))
+	require.Equal(t, half, s.coinBalance(types.ModuleAddress.Bytes(), coinDenom))
+	require.Equal(t, half.BigInt(), s.tokenBalance(contract, s.account.Address))
+
+	// the tokens are burned and the escrowed coins are released
+	receiver := testutil.NewAccount(t)
+	_, err = s.msgServer().ConvertERC20(s.ctx, types.NewMsgConvertERC20(half, receiver.AccAddr, contract, s.account.AccAddr))
+	require.NoError(t, err)
+	require.Equal(t, half, s.coinBalance(receiver.AccAddr, coinDenom))
+	require.True(t, s.coinBalance(types.ModuleAddress.Bytes(), coinDenom).IsZero())
+	require.Zero(t, s.tokenBalance(contract, s.account.Address).Sign())
+}
+
+func TestConvertNativeERC20(t *testing.T) {
+	s := setupTest(t)
+	tokenPair := s.registerERC20(t)
+	contract := tokenPair.GetERC20Contract()
+	half := amount.QuoRaw(2)
+
+	// the tokens are escrowed by the module account and the coins are minted
+	receiver := testutil.NewAccount(t)
+	_, err := s.msgServer().ConvertERC20(s.ctx, types.NewMsgConvertERC20(half, receiver.AccAddr, contract, s.account.AccAddr))
+	require.NoError(t, err)
+	require.Equal(t, half.BigInt(), s.tokenBalance(contract, s.account.Address))
+	require.Equal(t, half.BigInt(), s.tokenBalance(contract, types.ModuleAddress))
+	require.Equal(t, half, s.coinBalance(receiver.AccAddr, tokenPair.Denom))
+	require.Equal(t, half, s.miniApp.BankKeeper.GetSupply(s.ctx, tokenPair.Denom).Amount)
+
+	// the coins are burned and the escrowed tokens are released
+	_, err = s.msgServer().ConvertCoin(s.ctx, types.NewMsgConvertCoin(sdk.NewCoin(tokenPair.Denom, half), receiver.Address, receiver.AccAddr))
+	require.NoError(t, err)
+	require.Equal(t, half.BigInt(), s.tokenBalance(contract, receiver.Address))
+	require.Zero(t, s.tokenBalance(contract, types.ModuleAddress).Sign())
+	require.True(t, s.miniApp.BankKeeper.GetSupply(s.ctx, tokenPair.Denom).Amount.IsZero())
+}
+
+func TestConvertFailures(t *testing.T) {
+	s := setupTest(t)
+	nativeCoin := s.registerCoin(t)
+	nativeERC20 := s.registerERC20(t)
+	tooMuch := amount.AddRaw(1)
+
+	testCases := []struct {
+		name        string
+		malleate    func() error
+		errContains string
+	}{
+		{
+			"unregistered coin",
+			func() error {
+				_, err := s.msgServer().ConvertCoin(s.ctx, types.NewMsgConvertCoin(sdk.NewCoin("aunknown", amount), s.account.Address, s.account.AccAddr))
+				return err
+			},
+			types.ErrTokenPairNotFound.Error(),
+		},
+		{
+			"native coin - insufficient coins",
+			func() error {
+				_, err := s.msgServer().ConvertCoin(s.ctx, types.NewMsgConvertCoin(sdk.NewCoin(coinDenom, tooMuch), s.account.Address, s.account.AccAddr))
+				return err
+			},
+			"insufficient funds",
+		},
+		{
+			"native coin - insufficient tokens",
+			func() error {
+				_, err := s.msgServer().ConvertERC20(s.ctx, types.NewMsgConvertERC20(tooMuch, s.account.AccAddr, nativeCoin.GetERC20Contract(), s.account.AccAddr))
+				return err
+			},
+			"contract call failed",
+		},
+		{
+			"native ERC-20 - insufficient tokens",
+			func() error {
+				_, err := s.msgServer().ConvertERC20(s.ctx, types.NewMsgConvertERC20(tooMuch, s.account.AccAddr, nativeERC20.GetERC20Contract(), s.account.AccAddr))
+				return err
+			},
+			"contract call failed",
+		},
+		{
+			"native ERC-20 - blocked receiver",
+			func() error {
+				_, err := s.msgServer().ConvertERC20(s.ctx, types.NewMsgConvertERC20(amount, types.ModuleAddress.Bytes(), nativeERC20.GetERC20Contract(), s.account.AccAddr))
+				return err
+			},
+			"is not allowed to receive funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			coinsBefore := s.miniApp.BankKeeper.GetAllBalances(s.ctx, s.account.AccAddr)
+			tokensBefore := s.tokenBalance(nativeERC20.GetERC20Contract(), s.account.Address)
+
+			require.ErrorContains(t, tc.malleate(), tc.errContains)
+
+			// the failed conversion doesn't change the balances
+			require.Equal(t, coinsBefore, s.miniApp.BankKeeper.GetAllBalances(s.ctx, s.account.AccAddr))
+			require.Equal(t, tokensBefore, s.tokenBalance(nativeERC20.GetERC20Contract(), s.account.Address))
+			require.Zero(t, s.tokenBalance(nativeCoin.GetERC20Contract(), s.account.Address).Sign())
+		})
+	}
+}
+
+func TestToggleConversion(t *testing.T) {
+	s := setupTest(t)
+	s.registerCoin(t)
+
+	_, err := s.msgServer().ToggleConversion(s.ctx, &types.MsgToggleConversion{Authority: s.account.AccAddr.String(), Token: coinDenom})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = s.msgServer().ToggleConversion(s.ctx, &types.MsgToggleConversion{Authority: s.authority(), Token: coinDenom})
+	require.NoError(t, err)
+
+	// the conversions of a disabled token pair fail
+	_, err = s.msgServer().ConvertCoin(s.ctx, types.NewMsgConvertCoin(sdk.NewCoin(coinDenom, amount), s.account.Address, s.account.AccAddr))
+	require.ErrorIs(t, err, types.ErrTokenPairDisabled)
+	require.Equal(t, amount, s.coinBalance(s.account.AccAddr, coinDenom))
+
+	_, err = s.msgServer().ToggleConversion(s.ctx, &types.MsgToggleConversion{Authority: s.authority(), Token: coinDenom})
+	require.NoError(t, err)
+
+	_, err = s.msgServer().ConvertCoin(s.ctx, types.NewMsgConvertCoin(sdk.NewCoin(coinDenom, amount), s.account.Address, s.account.AccAddr))
+	require.NoError(t, err)
+}
+
+func TestDynamicPrecompile(t *testing.T) {
+	s := setupTest(t)
+	tokenPair := s.registerCoin(t)
+	precompileAddr := tokenPair.GetPrecompileAddress()
+
+	precompile, found, err := s.miniApp.Erc20Keeper.GetERC20PrecompileInstance(s.ctx, precompileAddr)
+	require.NoError(t, err)
+	require.True(t, found)
+	p, ok := precompile.(*erc20precompile.Precompile)
+	require.True(t, ok)
+	require.Equal(t, coinDenom, p.Denom())
+
+	// no precompile is registered at the ERC-20 contract of the coin
+	_, found, err = s.miniApp.Erc20Keeper.GetERC20PrecompileInstance(s.ctx, tokenPair.GetERC20Contract())
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// the call hook of the EVM loads the precompile, which exposes the bank
+	// balances of the coin
+	_, outputs, err := testutil.CallContract(s.ctx, s.miniApp, s.account.Address, precompileAddr, p.ABI, erc20precompile.BalanceOfMethod, s.account.Address)
+	require.NoError(t, err)
+	require.Equal(t, amount.BigInt(), outputs[0])
+
+	receiver := testutil.NewAccount(t)
+	half := amount.QuoRaw(2)
+	_, _, err = testutil.CallContract(s.ctx, s.miniApp, s.account.Address, precompileAddr, p.ABI, erc20precompile.TransferMethod, receiver.Address, half.BigInt())
+	require.NoError(t, err)
+	require.Equal(t, half, s.coinBalance(receiver.AccAddr, coinDenom))
+	require.Equal(t, half, s.coinBalance(s.account.AccAddr, coinDenom))
+}
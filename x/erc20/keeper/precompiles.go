@@ -0,0 +1,31 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20precompile "github.com/green901612/cosevm/precompiles/erc20"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+// GetERC20PrecompileInstance returns the dynamic ERC-20 precompile registered
+// at the given address, if any. The precompile exposes the bank balances of
+// the native Cosmos coin of a token pair as ERC-20 tokens.
+func (k Keeper) GetERC20PrecompileInstance(
+	ctx sdk.Context,
+	address common.Address,
+) (contract vm.PrecompiledContract, found bool, err error) {
+	denom, found := k.GetDenomPrecompile(ctx, address)
+	if !found {
+		return nil, false, nil
+	}
+
+	precompile, err := erc20precompile.NewPrecompile(address, denom, k.bankKeeper, k)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return precompile, true, nil
+}
@@ -0,0 +1,164 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/erc20/types"
+	evmtypes "github.com/green901612/cosevm/x/evm/types"
+)
+
+// registerCoin registers a token pair for the native Cosmos coin with the
+// given metadata. It deploys the ERC-20 contract of the coin, owned by the
+// erc20 module account, and exposes the bank balances of the coin through
+// the dynamic ERC-20 precompile of its denomination.
+func (k Keeper) registerCoin(ctx sdk.Context, metadata banktypes.Metadata) (types.TokenPair, error) {
+	if !k.IsERC20Enabled(ctx) {
+		return types.TokenPair{}, types.ErrERC20Disabled
+	}
+
+	if metadata.Base == evmtypes.GetEVMCoinDenom() {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrEVMDenom, "denomination %s", metadata.Base)
+	}
+
+	if k.IsDenomRegistered(ctx, metadata.Base) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "coin denomination already registered: %s", metadata.Base)
+	}
+
+	if !k.bankKeeper.HasSupply(ctx, metadata.Base) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrInvalidMetadata, "base denomination %s has no supply", metadata.Base)
+	}
+
+	if err := k.verifyMetadata(ctx, metadata); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	addr, err := k.DeployERC20Contract(ctx, metadata)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	tokenPair := types.NewTokenPair(addr, metadata.Base, types.OWNER_MODULE)
+	k.RegisterTokenPair(ctx, tokenPair)
+
+	return tokenPair, nil
+}
+
+// registerERC20 registers a token pair for the given external ERC-20
+// contract, creating the bank metadata of its Cosmos coin representation.
+func (k Keeper) registerERC20(ctx sdk.Context, contract common.Address) (types.TokenPair, error) {
+	if !k.IsERC20Enabled(ctx) {
+		return types.TokenPair{}, types.ErrERC20Disabled
+	}
+
+	if k.IsERC20Registered(ctx, contract) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", contract)
+	}
+
+	if !k.evmKeeper.IsContract(ctx, contract) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrInvalidTokenPair, "%s is not a contract", contract)
+	}
+
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	metadata, err := k.CreateCoinMetadata(ctx, contract, erc20Data)
+	if err != nil {
+		return types.TokenPair{}, errorsmod.Wrap(err, "failed to create wrapped coin denom metadata for ERC20")
+	}
+
+	tokenPair := types.NewTokenPair(contract, metadata.Base, types.OWNER_EXTERNAL)
+	k.RegisterTokenPair(ctx, tokenPair)
+
+	return tokenPair, nil
+}
+
+// CreateCoinMetadata generates and stores the bank metadata of the Cosmos
+// coin representing the tokens of the given external ERC-20 contract.
+func (k Keeper) CreateCoinMetadata(ctx sdk.Context, contract common.Address, erc20Data types.ERC20Data) (banktypes.Metadata, error) {
+	denom := types.CreateDenom(contract)
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return banktypes.Metadata{}, errorsmod.Wrapf(types.ErrInvalidMetadata, "coin denomination already registered: %s", erc20Data.Name)
+	}
+
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("Cosmos coin token representation of %s", contract),
+		Base:        denom,
+		Display:     denom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: 0,
+			},
+		},
+		Name:   denom,
+		Symbol: erc20Data.Symbol,
+	}
+
+	if erc20Data.Decimals > 0 {
+		display := strings.ToLower(erc20Data.Symbol)
+		if err := sdk.ValidateDenom(display); err != nil {
+			return banktypes.Metadata{}, errorsmod.Wrapf(types.ErrInvalidMetadata, "invalid display denomination %s: %s", display, err)
+		}
+
+		metadata.Display = display
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: uint32(erc20Data.Decimals),
+		})
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, errorsmod.Wrapf(types.ErrInvalidMetadata, "ERC20 token data is invalid for contract %s: %s", contract, err)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return metadata, nil
+}
+
+// toggleConversion enables or disables the conversions of the token pair of
+// the given token, which can be either the hex address of its ERC-20 contract
+// or its denomination.
+func (k Keeper) toggleConversion(ctx sdk.Context, token string) (types.TokenPair, error) {
+	tokenPair, found := k.GetTokenPairByToken(ctx, token)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", token)
+	}
+
+	tokenPair.Enabled = !tokenPair.Enabled
+	k.SetTokenPair(ctx, tokenPair)
+
+	return tokenPair, nil
+}
+
+// verifyMetadata stores the given metadata of a native Cosmos coin if the
+// coin has no metadata yet, or checks that it matches the stored one.
+func (k Keeper) verifyMetadata(ctx sdk.Context, metadata banktypes.Metadata) error {
+	if _, err := displayDecimals(metadata); err != nil {
+		return err
+	}
+
+	existing, found := k.bankKeeper.GetDenomMetaData(ctx, metadata.Base)
+	if !found {
+		k.bankKeeper.SetDenomMetaData(ctx, metadata)
+		return nil
+	}
+
+	if !bytes.Equal(k.cdc.MustMarshal(&existing), k.cdc.MustMarshal(&metadata)) {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "metadata of %s does not match the registered one", metadata.Base)
+	}
+
+	return nil
+}
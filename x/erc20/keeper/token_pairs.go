@@ -0,0 +1,137 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/erc20/types"
+)
+
+// GetTokenPairs returns all the registered token pairs.
+func (k Keeper) GetTokenPairs(ctx sdk.Context) []types.TokenPair {
+	tokenPairs := []types.TokenPair{}
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenPair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &tokenPair)
+		tokenPairs = append(tokenPairs, tokenPair)
+	}
+
+	return tokenPairs
+}
+
+// GetTokenPairID returns the ID of the token pair of the given token, which
+// can be either the hex address of its ERC-20 contract or its denomination.
+func (k Keeper) GetTokenPairID(ctx sdk.Context, token string) []byte {
+	if common.IsHexAddress(token) {
+		return k.GetERC20Map(ctx, common.HexToAddress(token))
+	}
+	return k.GetDenomMap(ctx, token)
+}
+
+// GetTokenPair returns the token pair of the given ID.
+func (k Keeper) GetTokenPair(ctx sdk.Context, id []byte) (types.TokenPair, bool) {
+	if id == nil {
+		return types.TokenPair{}, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	var tokenPair types.TokenPair
+	k.cdc.MustUnmarshal(bz, &tokenPair)
+	return tokenPair, true
+}
+
+// GetTokenPairByToken returns the token pair of the given token, which can be
+// either the hex address of its ERC-20 contract or its denomination.
+func (k Keeper) GetTokenPairByToken(ctx sdk.Context, token string) (types.TokenPair, bool) {
+	return k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token))
+}
+
+// SetTokenPair stores the given token pair.
+func (k Keeper) SetTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	store.Set(tokenPair.GetID(), k.cdc.MustMarshal(&tokenPair))
+}
+
+// RegisterTokenPair stores the given token pair along with the indexes of its
+// ERC-20 contract and denomination. The token pairs of the native Cosmos coins
+// also register the dynamic ERC-20 precompile of their denomination.
+func (k Keeper) RegisterTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+	id := tokenPair.GetID()
+
+	k.SetTokenPair(ctx, tokenPair)
+	k.SetERC20Map(ctx, tokenPair.GetERC20Contract(), id)
+	k.SetDenomMap(ctx, tokenPair.Denom, id)
+
+	if tokenPair.IsNativeCoin() {
+		k.SetDenomPrecompile(ctx, tokenPair.GetPrecompileAddress(), tokenPair.Denom)
+	}
+}
+
+// GetERC20Map returns the ID of the token pair of the given ERC-20 contract.
+func (k Keeper) GetERC20Map(ctx sdk.Context, erc20 common.Address) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20)
+	return store.Get(erc20.Bytes())
+}
+
+// SetERC20Map sets the ID of the token pair of the given ERC-20 contract.
+func (k Keeper) SetERC20Map(ctx sdk.Context, erc20 common.Address, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20)
+	store.Set(erc20.Bytes(), id)
+}
+
+// GetDenomMap returns the ID of the token pair of the given denomination.
+func (k Keeper) GetDenomMap(ctx sdk.Context, denom string) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	return store.Get([]byte(denom))
+}
+
+// SetDenomMap sets the ID of the token pair of the given denomination.
+func (k Keeper) SetDenomMap(ctx sdk.Context, denom string, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	store.Set([]byte(denom), id)
+}
+
+// IsERC20Registered returns true if a token pair is registered for the given
+// ERC-20 contract.
+func (k Keeper) IsERC20Registered(ctx sdk.Context, erc20 common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20)
+	return store.Has(erc20.Bytes())
+}
+
+// IsDenomRegistered returns true if a token pair is registered for the given
+// denomination.
+func (k Keeper) IsDenomRegistered(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	return store.Has([]byte(denom))
+}
+
+// GetDenomPrecompile returns the denomination exposed by the dynamic ERC-20
+// precompile at the given address.
+func (k Keeper) GetDenomPrecompile(ctx sdk.Context, address common.Address) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomPrecompile)
+	bz := store.Get(address.Bytes())
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetDenomPrecompile sets the denomination exposed by the dynamic ERC-20
+// precompile at the given address.
+func (k Keeper) SetDenomPrecompile(ctx sdk.Context, address common.Address, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomPrecompile)
+	store.Set(address.Bytes(), []byte(denom))
+}
@@ -0,0 +1,60 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+	// ModuleCdc references the global erc20 module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino) //nolint:staticcheck
+)
+
+const (
+	// Amino names
+	convertERC20Name     = "ethermint/erc20/MsgConvertERC20"
+	convertCoinName      = "ethermint/erc20/MsgConvertCoin"
+	updateParamsName     = "ethermint/erc20/MsgUpdateParams"
+	registerCoinName     = "ethermint/erc20/MsgRegisterCoin"
+	registerERC20Name    = "ethermint/erc20/MsgRegisterERC20"
+	toggleConversionName = "ethermint/erc20/MsgToggleConversion"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces registers the client interfaces to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgConvertERC20{},
+		&MsgConvertCoin{},
+		&MsgUpdateParams{},
+		&MsgRegisterCoin{},
+		&MsgRegisterERC20{},
+		&MsgToggleConversion{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgConvertERC20{}, convertERC20Name, nil)
+	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRegisterCoin{}, registerCoinName, nil)
+	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20Name, nil)
+	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversionName, nil)
+}
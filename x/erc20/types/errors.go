@@ -0,0 +1,70 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrERC20Disabled = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrInvalidTokenPair
+	codeErrTokenPairNotFound
+	codeErrTokenPairAlreadyExists
+	codeErrTokenPairDisabled
+	codeErrInvalidMetadata
+	codeErrEVMDenom
+	codeErrInvalidOwner
+	codeErrABIPack
+	codeErrABIUnpack
+	codeErrBalanceInvariance
+	codeErrEVMCall
+	codeErrInvalidConversion
+)
+
+var (
+	// ErrERC20Disabled returns an error if the EnableErc20 parameter is false.
+	ErrERC20Disabled = errorsmod.Register(ModuleName, codeErrERC20Disabled, "erc20 module is disabled")
+
+	// ErrInvalidTokenPair returns an error if a token pair is invalid.
+	ErrInvalidTokenPair = errorsmod.Register(ModuleName, codeErrInvalidTokenPair, "invalid token pair")
+
+	// ErrTokenPairNotFound returns an error if no token pair is registered for
+	// a token.
+	ErrTokenPairNotFound = errorsmod.Register(ModuleName, codeErrTokenPairNotFound, "token pair not found")
+
+	// ErrTokenPairAlreadyExists returns an error if a token pair is already
+	// registered for a token.
+	ErrTokenPairAlreadyExists = errorsmod.Register(ModuleName, codeErrTokenPairAlreadyExists, "token pair already exists")
+
+	// ErrTokenPairDisabled returns an error if the conversions of a token pair
+	// are disabled.
+	ErrTokenPairDisabled = errorsmod.Register(ModuleName, codeErrTokenPairDisabled, "token pair conversions are disabled")
+
+	// ErrInvalidMetadata returns an error if the bank metadata of a coin is
+	// invalid or does not match the registered one.
+	ErrInvalidMetadata = errorsmod.Register(ModuleName, codeErrInvalidMetadata, "invalid coin metadata")
+
+	// ErrEVMDenom returns an error if a token pair is registered for the EVM
+	// coin.
+	ErrEVMDenom = errorsmod.Register(ModuleName, codeErrEVMDenom, "cannot register a token pair for the EVM coin")
+
+	// ErrInvalidOwner returns an error if the owner of a token pair is invalid.
+	ErrInvalidOwner = errorsmod.Register(ModuleName, codeErrInvalidOwner, "invalid token pair owner")
+
+	// ErrABIPack returns an error if the contract ABI packing fails.
+	ErrABIPack = errorsmod.Register(ModuleName, codeErrABIPack, "contract ABI pack failed")
+
+	// ErrABIUnpack returns an error if the contract ABI unpacking fails.
+	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrBalanceInvariance returns an error if the ERC-20 balances do not
+	// change by the converted amount.
+	ErrBalanceInvariance = errorsmod.Register(ModuleName, codeErrBalanceInvariance, "post transfer balance invariant failed")
+
+	// ErrEVMCall returns an error if a call to an ERC-20 contract fails.
+	ErrEVMCall = errorsmod.Register(ModuleName, codeErrEVMCall, "EVM call unexpected error")
+
+	// ErrInvalidConversion returns an error if a conversion is invalid.
+	ErrInvalidConversion = errorsmod.Register(ModuleName, codeErrInvalidConversion, "invalid conversion")
+)
@@ -0,0 +1,17 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+// erc20 events
+const (
+	EventTypeConvertCoin      = "convert_coin"
+	EventTypeConvertERC20     = "convert_erc20"
+	EventTypeRegisterCoin     = "register_coin"
+	EventTypeRegisterERC20    = "register_erc20"
+	EventTypeToggleConversion = "toggle_token_conversion"
+
+	AttributeKeyCosmosCoin = "cosmos_coin"
+	AttributeKeyERC20Token = "erc20_token"
+	AttributeKeyReceiver   = "receiver"
+	AttributeKeyPrecompile = "precompile"
+)
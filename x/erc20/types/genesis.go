@@ -0,0 +1,97 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultGenesisState sets default erc20 genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:     DefaultParams(),
+		TokenPairs: []TokenPair{},
+		Allowances: []Allowance{},
+	}
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, tokenPairs []TokenPair, allowances []Allowance) *GenesisState {
+	return &GenesisState{
+		Params:     params,
+		TokenPairs: tokenPairs,
+		Allowances: allowances,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenERC20 := make(map[string]bool)
+	seenDenom := make(map[string]bool)
+
+	for _, tp := range gs.TokenPairs {
+		if err := tp.Validate(); err != nil {
+			return err
+		}
+
+		erc20 := tp.GetERC20Contract().String()
+		if seenERC20[erc20] {
+			return fmt.Errorf("duplicated token pair for ERC-20 contract %s", erc20)
+		}
+
+		if seenDenom[tp.Denom] {
+			return fmt.Errorf("duplicated token pair for denomination %s", tp.Denom)
+		}
+
+		seenERC20[erc20] = true
+		seenDenom[tp.Denom] = true
+	}
+
+	// NOTE: the allowances are not checked against the token pairs, as they
+	// can also belong to the WERC20 precompile of the EVM coin.
+	seenAllowances := make(map[string]bool)
+	for _, allowance := range gs.Allowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+
+		key := string(AllowanceKey(
+			common.HexToAddress(allowance.Erc20Address),
+			common.HexToAddress(allowance.Owner),
+			common.HexToAddress(allowance.Spender),
+		))
+		if seenAllowances[key] {
+			return fmt.Errorf(
+				"duplicated allowance of %s for %s on %s",
+				allowance.Owner, allowance.Spender, allowance.Erc20Address,
+			)
+		}
+		seenAllowances[key] = true
+	}
+
+	return gs.Params.Validate()
+}
+
+// Validate performs a stateless validation of the allowance.
+func (a Allowance) Validate() error {
+	if !common.IsHexAddress(a.Erc20Address) {
+		return fmt.Errorf("invalid ERC-20 precompile address: %s", a.Erc20Address)
+	}
+
+	if !common.IsHexAddress(a.Owner) {
+		return fmt.Errorf("invalid owner address: %s", a.Owner)
+	}
+
+	if !common.IsHexAddress(a.Spender) {
+		return fmt.Errorf("invalid spender address: %s", a.Spender)
+	}
+
+	if a.Value.IsNil() || !a.Value.IsPositive() {
+		return fmt.Errorf("invalid allowance value: %s", a.Value)
+	}
+
+	return nil
+}
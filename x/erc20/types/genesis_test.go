@@ -0,0 +1,112 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+	otherContract := common.HexToAddress("0x89c2682315d956869397738679b56305F2095038")
+
+	nativePair := NewTokenPair(contract, "ufoo", OWNER_MODULE)
+	allowance := Allowance{
+		Erc20Address: nativePair.GetPrecompileAddress().String(),
+		Owner:        common.Address{0x1}.String(),
+		Spender:      common.Address{0x2}.String(),
+		Value:        math.NewInt(100),
+	}
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{
+			"pass - default",
+			DefaultGenesisState(),
+			true,
+		},
+		{
+			"pass - valid genesis",
+			NewGenesisState(
+				DefaultParams(),
+				[]TokenPair{
+					nativePair,
+					NewTokenPair(otherContract, CreateDenom(otherContract), OWNER_EXTERNAL),
+				},
+				[]Allowance{allowance},
+			),
+			true,
+		},
+		{
+			"fail - invalid token pair",
+			NewGenesisState(DefaultParams(), []TokenPair{NewTokenPair(contract, "ufoo", OWNER_UNSPECIFIED)}, nil),
+			false,
+		},
+		{
+			"fail - duplicated ERC-20 contract",
+			NewGenesisState(
+				DefaultParams(),
+				[]TokenPair{nativePair, NewTokenPair(contract, "ubar", OWNER_MODULE)},
+				nil,
+			),
+			false,
+		},
+		{
+			"fail - duplicated denom",
+			NewGenesisState(
+				DefaultParams(),
+				[]TokenPair{nativePair, NewTokenPair(otherContract, "ufoo", OWNER_MODULE)},
+				nil,
+			),
+			false,
+		},
+		{
+			"pass - allowance without token pair",
+			NewGenesisState(DefaultParams(), nil, []Allowance{allowance}),
+			true,
+		},
+		{
+			"fail - duplicated allowance",
+			NewGenesisState(DefaultParams(), []TokenPair{nativePair}, []Allowance{allowance, allowance}),
+			false,
+		},
+		{
+			"fail - negative allowance",
+			NewGenesisState(
+				DefaultParams(),
+				[]TokenPair{nativePair},
+				[]Allowance{{
+					Erc20Address: allowance.Erc20Address,
+					Owner:        allowance.Owner,
+					Spender:      allowance.Spender,
+					Value:        math.NewInt(-1),
+				}},
+			),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.genState.Validate()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
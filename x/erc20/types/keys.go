@@ -0,0 +1,53 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "erc20"
+
+	// StoreKey key for the token pairs and the allowances of the dynamic
+	// ERC-20 precompiles.
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the Ethereum address of the erc20 module account, which
+// deploys and owns the ERC-20 contracts of the native Cosmos coins.
+var ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName))
+
+// prefix bytes for the erc20 persistent store
+const (
+	prefixTokenPair = iota + 1
+	prefixTokenPairByERC20
+	prefixTokenPairByDenom
+	prefixDenomPrecompile
+	prefixAllowance
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixTokenPair        = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixDenomPrecompile  = []byte{prefixDenomPrecompile}
+	KeyPrefixAllowance        = []byte{prefixAllowance}
+	KeyPrefixParams           = []byte{prefixParams}
+)
+
+// AllowanceKey defines the full key under which the allowance of a spender
+// over the tokens of an owner of a dynamic ERC-20 precompile is stored.
+func AllowanceKey(erc20, owner, spender common.Address) []byte {
+	key := make([]byte, 0, 3*common.AddressLength)
+	key = append(key, erc20.Bytes()...)
+	key = append(key, owner.Bytes()...)
+	return append(key, spender.Bytes()...)
+}
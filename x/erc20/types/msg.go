@@ -0,0 +1,179 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ sdk.Msg = &MsgConvertERC20{}
+	_ sdk.Msg = &MsgConvertCoin{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRegisterCoin{}
+	_ sdk.Msg = &MsgRegisterERC20{}
+	_ sdk.Msg = &MsgToggleConversion{}
+)
+
+// NewMsgConvertERC20 creates a new instance of MsgConvertERC20.
+func NewMsgConvertERC20(amount math.Int, receiver sdk.AccAddress, contract common.Address, sender sdk.AccAddress) *MsgConvertERC20 {
+	return &MsgConvertERC20{
+		ContractAddress: contract.String(),
+		Amount:          amount,
+		Receiver:        receiver.String(),
+		Sender:          sender.String(),
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgConvertERC20) ValidateBasic() error {
+	if !common.IsHexAddress(m.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract address %s", m.ContractAddress)
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount %s", m.Amount)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgConvertERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgConvertCoin creates a new instance of MsgConvertCoin.
+func NewMsgConvertCoin(coin sdk.Coin, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoin {
+	return &MsgConvertCoin{
+		Coin:     coin,
+		Receiver: receiver.String(),
+		Sender:   sender.String(),
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgConvertCoin) ValidateBasic() error {
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid coin %s", m.Coin)
+	}
+
+	if !common.IsHexAddress(m.Receiver) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid receiver address %s", m.Receiver)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgConvertCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := m.Metadata.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidMetadata, err.Error())
+	}
+
+	if err := ValidateNativeDenom(m.Metadata.Base); err != nil {
+		return errorsmod.Wrap(ErrInvalidMetadata, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterERC20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !common.IsHexAddress(m.Erc20Address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid ERC-20 contract address %s", m.Erc20Address)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgToggleConversion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !common.IsHexAddress(m.Token) {
+		if err := sdk.ValidateDenom(m.Token); err != nil {
+			return errorsmod.Wrapf(ErrInvalidTokenPair, "invalid token %s: %s", m.Token, err)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgToggleConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateNativeDenom checks that the given denomination can be registered as
+// a native Cosmos coin, i.e. that it is not the denomination of an external
+// ERC-20 token representation.
+func ValidateNativeDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(denom, DenomPrefix) {
+		return fmt.Errorf("native coin denomination %s cannot have the %s prefix", denom, DenomPrefix)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20ValidateBasic() {
+	contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+	addr := sdk.AccAddress(common.Address{0x1}.Bytes())
+
+	testCases := []struct {
+		name    string
+		msg     *MsgConvertERC20
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgConvertERC20(math.NewInt(100), addr, contract, addr),
+			true,
+		},
+		{
+			"fail - zero amount",
+			NewMsgConvertERC20(math.ZeroInt(), addr, contract, addr),
+			false,
+		},
+		{
+			"fail - invalid contract address",
+			&MsgConvertERC20{ContractAddress: "0xinvalid", Amount: math.NewInt(100), Receiver: addr.String(), Sender: addr.String()},
+			false,
+		},
+		{
+			"fail - invalid receiver",
+			&MsgConvertERC20{ContractAddress: contract.String(), Amount: math.NewInt(100), Receiver: "invalid", Sender: addr.String()},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoinValidateBasic() {
+	receiver := common.Address{0x2}
+	addr := sdk.AccAddress(common.Address{0x1}.Bytes())
+
+	testCases := []struct {
+		name    string
+		msg     *MsgConvertCoin
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgConvertCoin(sdk.NewInt64Coin("ufoo", 100), receiver, addr),
+			true,
+		},
+		{
+			"fail - zero coin",
+			NewMsgConvertCoin(sdk.NewInt64Coin("ufoo", 0), receiver, addr),
+			false,
+		},
+		{
+			"fail - invalid receiver",
+			&MsgConvertCoin{Coin: sdk.NewInt64Coin("ufoo", 100), Receiver: "invalid", Sender: addr.String()},
+			false,
+		},
+		{
+			"fail - invalid sender",
+			&MsgConvertCoin{Coin: sdk.NewInt64Coin("ufoo", 100), Receiver: receiver.String(), Sender: "invalid"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterCoinValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+
+	metadata := func(base string) banktypes.Metadata {
+		return banktypes.Metadata{
+			Base:    base,
+			Display: base,
+			Name:    "Foo",
+			Symbol:  "FOO",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: base, Exponent: 0},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *MsgRegisterCoin
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			&MsgRegisterCoin{Authority: authority, Metadata: metadata("ufoo")},
+			true,
+		},
+		{
+			"fail - invalid authority",
+			&MsgRegisterCoin{Authority: "invalid", Metadata: metadata("ufoo")},
+			false,
+		},
+		{
+			"fail - invalid metadata",
+			&MsgRegisterCoin{Authority: authority, Metadata: banktypes.Metadata{Base: "ufoo"}},
+			false,
+		},
+		{
+			"fail - erc20 denom",
+			&MsgRegisterCoin{Authority: authority, Metadata: metadata(CreateDenom(contract))},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20AndToggleConversionValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd").String()
+
+	suite.NoError((&MsgRegisterERC20{Authority: authority, Erc20Address: contract}).ValidateBasic())
+	suite.Error((&MsgRegisterERC20{Authority: authority, Erc20Address: "0xinvalid"}).ValidateBasic())
+	suite.Error((&MsgRegisterERC20{Authority: "invalid", Erc20Address: contract}).ValidateBasic())
+
+	suite.NoError((&MsgToggleConversion{Authority: authority, Token: contract}).ValidateBasic())
+	suite.NoError((&MsgToggleConversion{Authority: authority, Token: "ufoo"}).ValidateBasic())
+	suite.Error((&MsgToggleConversion{Authority: authority, Token: "1"}).ValidateBasic())
+	suite.Error((&MsgToggleConversion{Authority: "invalid", Token: "ufoo"}).ValidateBasic())
+}
@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+// DefaultEnableErc20 enables the conversions between Cosmos coins and ERC-20
+// tokens by default.
+const DefaultEnableErc20 = true
+
+// NewParams creates a new Params instance.
+func NewParams(enableErc20 bool) Params {
+	return Params{
+		EnableErc20: enableErc20,
+	}
+}
+
+// DefaultParams returns the default erc20 module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultEnableErc20)
+}
+
+// Validate performs a basic validation of the erc20 module parameters.
+func (p Params) Validate() error {
+	return nil
+}
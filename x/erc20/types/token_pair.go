@@ -0,0 +1,91 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/utils"
+)
+
+// DenomPrefix is the prefix of the Cosmos coin denominations representing
+// external ERC-20 tokens.
+const DenomPrefix = "erc20/"
+
+// NewTokenPair returns a new enabled token pair of the given ERC-20 contract
+// and denomination.
+func NewTokenPair(contractAddress common.Address, denom string, contractOwner Owner) TokenPair {
+	return TokenPair{
+		Erc20Address:  contractAddress.String(),
+		Denom:         denom,
+		Enabled:       true,
+		ContractOwner: contractOwner,
+	}
+}
+
+// GetID returns the SHA256 hash of the ERC-20 address and denomination of the
+// token pair, which identifies it in the store.
+func (tp TokenPair) GetID() []byte {
+	id := tp.Erc20Address + "|" + tp.Denom
+	return tmhash.Sum([]byte(id))
+}
+
+// GetERC20Contract returns the ERC-20 contract address of the token pair.
+func (tp TokenPair) GetERC20Contract() common.Address {
+	return common.HexToAddress(tp.Erc20Address)
+}
+
+// GetPrecompileAddress returns the address of the dynamic ERC-20 precompile
+// exposing the bank balances of the token pair denomination. Only the native
+// Cosmos coins have a dynamic precompile.
+func (tp TokenPair) GetPrecompileAddress() common.Address {
+	return utils.GetDenomAddress(tp.Denom)
+}
+
+// IsNativeCoin returns true if the token pair belongs to a native Cosmos coin,
+// whose ERC-20 contract is owned by the erc20 module.
+func (tp TokenPair) IsNativeCoin() bool {
+	return tp.ContractOwner == OWNER_MODULE
+}
+
+// IsNativeERC20 returns true if the token pair belongs to an external ERC-20
+// contract.
+func (tp TokenPair) IsNativeERC20() bool {
+	return tp.ContractOwner == OWNER_EXTERNAL
+}
+
+// Validate performs a stateless validation of the token pair.
+func (tp TokenPair) Validate() error {
+	if err := sdk.ValidateDenom(tp.Denom); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(tp.Erc20Address) {
+		return fmt.Errorf("invalid ERC-20 contract address: %s", tp.Erc20Address)
+	}
+
+	switch tp.ContractOwner {
+	case OWNER_MODULE:
+		if err := ValidateNativeDenom(tp.Denom); err != nil {
+			return err
+		}
+	case OWNER_EXTERNAL:
+		if tp.Denom != CreateDenom(tp.GetERC20Contract()) {
+			return fmt.Errorf("invalid denomination %s for the ERC-20 contract %s", tp.Denom, tp.Erc20Address)
+		}
+	default:
+		return fmt.Errorf("invalid contract owner: %s", tp.ContractOwner)
+	}
+
+	return nil
+}
+
+// CreateDenom returns the denomination of the Cosmos coin representing the
+// tokens of the given external ERC-20 contract.
+func CreateDenom(contract common.Address) string {
+	return DenomPrefix + contract.String()
+}
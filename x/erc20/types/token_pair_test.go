@@ -0,0 +1,92 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/green901612/cosevm/utils"
+)
+
+type TokenPairTestSuite struct {
+	suite.Suite
+}
+
+func TestTokenPairTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenPairTestSuite))
+}
+
+func (suite *TokenPairTestSuite) TestTokenPairValidate() {
+	contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+
+	testCases := []struct {
+		name    string
+		pair    TokenPair
+		expPass bool
+	}{
+		{
+			"pass - native coin",
+			NewTokenPair(contract, "ufoo", OWNER_MODULE),
+			true,
+		},
+		{
+			"pass - native ERC-20",
+			NewTokenPair(contract, CreateDenom(contract), OWNER_EXTERNAL),
+			true,
+		},
+		{
+			"fail - invalid denom",
+			NewTokenPair(contract, "1", OWNER_MODULE),
+			false,
+		},
+		{
+			"fail - invalid contract address",
+			TokenPair{Erc20Address: "0xinvalid", Denom: "ufoo", Enabled: true, ContractOwner: OWNER_MODULE},
+			false,
+		},
+		{
+			"fail - native coin with erc20 denom",
+			NewTokenPair(contract, CreateDenom(contract), OWNER_MODULE),
+			false,
+		},
+		{
+			"fail - native ERC-20 with other denom",
+			NewTokenPair(contract, "ufoo", OWNER_EXTERNAL),
+			false,
+		},
+		{
+			"fail - unspecified owner",
+			NewTokenPair(contract, "ufoo", OWNER_UNSPECIFIED),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.pair.Validate()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TokenPairTestSuite) TestTokenPairGetters() {
+	contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+
+	native := NewTokenPair(contract, "ufoo", OWNER_MODULE)
+	suite.True(native.IsNativeCoin())
+	suite.False(native.IsNativeERC20())
+	suite.Equal(contract, native.GetERC20Contract())
+	suite.Equal(utils.GetDenomAddress("ufoo"), native.GetPrecompileAddress())
+
+	external := NewTokenPair(contract, CreateDenom(contract), OWNER_EXTERNAL)
+	suite.False(external.IsNativeCoin())
+	suite.True(external.IsNativeERC20())
+	suite.Equal("erc20/"+contract.String(), external.Denom)
+
+	suite.NotEqual(native.GetID(), external.GetID())
+}
@@ -28,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountCmd(),
 		GetParamsCmd(),
 		GetConfigCmd(),
+		GetAccessControlCmd(),
+		GetOpcodeGasScheduleCmd(),
 	)
 	return cmd
 }
@@ -201,3 +203,69 @@ func GetConfigCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetAccessControlCmd queries if an address is allowed to create and call
+// contracts by the access control policy
+func GetAccessControlCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access-control ADDRESS",
+		Short: "Gets the contract creation and call permissions of an address",
+		Long:  "Gets whether an address is allowed to create and call contracts by the access control policy of the evm params. The calls to precompiled contracts are always allowed.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccessControlRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.AccessControl(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetOpcodeGasScheduleCmd queries the effective gas schedule of the opcodes
+func GetOpcodeGasScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opcode-gas-schedule",
+		Short: "Get the gas schedule of the evm opcodes",
+		Long:  "Get the effective gas schedule of the opcodes of the evm instruction set, with the opcode gas overrides of the evm params applied. The overrides only replace the constant gas: the dynamic gas of opcodes such as SLOAD, SSTORE and LOG0-LOG4 is still charged on top of it.", //nolint:lll
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OpcodeGasSchedule(rpctypes.ContextWithHeight(clientCtx.Height), &types.QueryOpcodeGasScheduleRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -51,8 +51,9 @@ type Contract struct {
 	caller        ContractRef
 	self          ContractRef
 
-	jumpdests map[common.Hash]bitvec // Aggregated result of JUMPDEST analysis.
-	analysis  bitvec                 // Locally cached result of JUMPDEST analysis
+	jumpdests     map[common.Hash]bitvec // Aggregated result of JUMPDEST analysis.
+	analysis      bitvec                 // Locally cached result of JUMPDEST analysis
+	jumpDestCache *JumpDestCache         // JUMPDEST analysis shared across EVM instances
 
 	Code     []byte
 	CodeHash common.Hash
@@ -143,9 +144,13 @@ func (c *Contract) isCode(udest uint64) bool {
 		// Does parent context have the analysis?
 		analysis, exist := c.jumpdests[c.CodeHash]
 		if !exist {
-			// Do the analysis and save in parent context
+			// Does the shared cache have the analysis? Otherwise do the
+			// analysis and save in the shared cache and in parent context
 			// We do not need to store it in c.analysis
-			analysis = codeBitmap(c.Code)
+			if analysis, exist = c.jumpDestCache.get(c.CodeHash); !exist {
+				analysis = codeBitmap(c.Code)
+				c.jumpDestCache.add(c.CodeHash, analysis)
+			}
 			c.jumpdests[c.CodeHash] = analysis
 		}
 		// Also stash it in current contract for faster access
@@ -102,6 +102,17 @@ func (o *operation) GetConstantGas() uint64 {
 	return o.constantGas
 }
 
+// HasDynamicGas returns true if the operation charges a dynamic gas in addition
+// to its constant gas.
+func (o *operation) HasDynamicGas() bool {
+	return o.dynamicGas != nil
+}
+
+// IsUndefined returns true if the operation is not defined in the jump table.
+func (o *operation) IsUndefined() bool {
+	return o.undefined
+}
+
 // SetExecute sets the execution function of the operation.
 func (o *operation) SetExecute(ef executionFunc) {
 	o.execute = ef
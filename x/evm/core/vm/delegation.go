@@ -0,0 +1,29 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vm
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DelegationPrefix is the prefix of the EIP-7702 delegation designators, i.e.
+// the code set to the accounts delegating their code to another address.
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// ParseDelegation returns the address the code is delegated to if the given
+// code is an EIP-7702 delegation designator.
+func ParseDelegation(code []byte) (common.Address, bool) {
+	if len(code) != len(DelegationPrefix)+common.AddressLength || !bytes.HasPrefix(code, DelegationPrefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(DelegationPrefix):]), true
+}
+
+// AddressToDelegation returns the EIP-7702 delegation designator of the given
+// address.
+func AddressToDelegation(addr common.Address) []byte {
+	return append(common.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
@@ -0,0 +1,99 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDelegation(t *testing.T) {
+	target := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name   string
+		code   []byte
+		expOk  bool
+		expDst common.Address
+	}{
+		{"delegation", AddressToDelegation(target), true, target},
+		{"empty code", nil, false, common.Address{}},
+		{"contract code", hexutil.MustDecode("0x6001600055"), false, common.Address{}},
+		{"prefix only", DelegationPrefix, false, common.Address{}},
+		{"too long", append(AddressToDelegation(target), 0x00), false, common.Address{}},
+		{"invalid prefix", append([]byte{0xef, 0x01, 0x01}, target.Bytes()...), false, common.Address{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dst, ok := ParseDelegation(tc.code)
+			require.Equal(t, tc.expOk, ok)
+			require.Equal(t, tc.expDst, dst)
+		})
+	}
+}
+
+func TestDelegatedCall(t *testing.T) {
+	var (
+		caller    = common.BytesToAddress([]byte("caller"))
+		proxy     = common.BytesToAddress([]byte("proxy"))
+		authority = common.BytesToAddress([]byte("authority"))
+		target    = common.BytesToAddress([]byte("target"))
+		// sstore(0, 1)
+		targetCode = hexutil.MustDecode("0x6001600055")
+	)
+
+	// proxyCode returns the code calling the given address without value
+	proxyCode := func(addr common.Address) []byte {
+		code := hexutil.MustDecode("0x60006000600060006000")
+		code = append(code, byte(PUSH20))
+		code = append(code, addr.Bytes()...)
+		return append(code, byte(GAS), byte(CALL), byte(STOP))
+	}
+
+	// call runs the proxy calling the given address and returns the gas used
+	call := func(t *testing.T, statedb *testStateDB, addr common.Address) uint64 {
+		t.Helper()
+
+		statedb.SetCode(proxy, proxyCode(addr))
+		vmctx := BlockContext{
+			CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+			Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+			BlockNumber: big.NewInt(1),
+		}
+		evm := NewEVM(vmctx, TxContext{}, statedb, params.AllEthashProtocolChanges, Config{})
+
+		gas := uint64(100_000)
+		_, leftOverGas, err := evm.Call(AccountRef(caller), proxy, nil, gas, new(big.Int))
+		require.NoError(t, err)
+		return gas - leftOverGas
+	}
+
+	newStateDB := func() *testStateDB {
+		statedb := newTestStateDB()
+		statedb.SetCode(target, targetCode)
+		statedb.SetCode(authority, AddressToDelegation(target))
+		return statedb
+	}
+
+	t.Run("delegated code runs in the authority context", func(t *testing.T) {
+		statedb := newStateDB()
+		call(t, statedb, authority)
+
+		require.Equal(t, common.BigToHash(big.NewInt(1)), statedb.GetState(authority, common.Hash{}))
+		require.Equal(t, common.Hash{}, statedb.GetState(target, common.Hash{}))
+		// the code of the authority remains the delegation designator
+		require.Equal(t, AddressToDelegation(target), statedb.GetCode(authority))
+		require.Equal(t, targetCode, statedb.ResolveCode(authority))
+	})
+
+	t.Run("delegation target access is charged", func(t *testing.T) {
+		directGas := call(t, newStateDB(), target)
+		delegatedGas := call(t, newStateDB(), authority)
+
+		require.Equal(t, params.ColdAccountAccessCostEIP2929, delegatedGas-directGas)
+	})
+}
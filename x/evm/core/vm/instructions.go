@@ -487,6 +487,11 @@ func opDifficulty(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext)
 }
 
 func opRandom(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	// fall back to the DIFFICULTY semantics if the block context doesn't
+	// provide any randomness
+	if interpreter.evm.Context.Random == nil {
+		return opDifficulty(pc, interpreter, scope)
+	}
 	v := new(uint256.Int).SetBytes(interpreter.evm.Context.Random.Bytes())
 	scope.Stack.Push(v)
 	return nil, nil
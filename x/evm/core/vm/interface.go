@@ -39,6 +39,13 @@ type StateDB interface {
 	SetCode(common.Address, []byte)
 	GetCodeSize(common.Address) int
 
+	// ResolveCode returns the code executed when calling the given account,
+	// i.e. the code of the delegation target of the EIP-7702 delegated
+	// accounts.
+	ResolveCode(common.Address) []byte
+	// ResolveCodeHash returns the hash of the code returned by ResolveCode.
+	ResolveCodeHash(common.Address) common.Hash
+
 	AddRefund(uint64)
 	SubRefund(uint64)
 	GetRefund() uint64
@@ -50,6 +57,13 @@ type StateDB interface {
 	Suicide(common.Address) bool
 	HasSuicided(common.Address) bool
 
+	// Selfdestruct6780 marks the given account as suicided only if it was
+	// created in the current transaction (EIP-6780).
+	Selfdestruct6780(common.Address)
+
+	GetTransientState(addr common.Address, key common.Hash) common.Hash
+	SetTransientState(addr common.Address, key, value common.Hash)
+
 	// Exist reports whether the given account exists in state.
 	// Notably this should also return true for suicided accounts.
 	Exist(common.Address) bool
@@ -34,6 +34,10 @@ type Config struct {
 	JumpTable *JumpTable // EVM instruction table, automatically populated if unset
 
 	ExtraEips []string // Additional EIPS that are to be enabled
+
+	OpcodeGasOverrides map[OpCode]uint64 // Constant gas of the opcodes overriding the jump table one
+
+	JumpDestCache *JumpDestCache // JUMPDEST analysis cache shared across EVM instances, disabled if nil
 }
 
 // ScopeContext contains the things that are per-call, such as stack and memory,
@@ -70,7 +74,12 @@ type EVMInterpreter struct {
 func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 	// If jump table was not initialised we set the default one.
 	if cfg.JumpTable == nil {
-		cfg.JumpTable = DefaultJumpTable(evm.chainRules)
+		// the Cancun rules are not exported by the params package
+		if evm.chainConfig.IsCancun(evm.Context.BlockNumber) {
+			cfg.JumpTable = &CancunInstructionSet
+		} else {
+			cfg.JumpTable = DefaultJumpTable(evm.chainRules)
+		}
 		for i, eip := range cfg.ExtraEips {
 			if len(cfg.ExtraEips) == 1 && eip == "\x8f\x1e" {
 				// The protobuf params changed so need to update the EIP for archive calls
@@ -86,6 +95,17 @@ func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 			}
 			cfg.JumpTable = copy
 		}
+
+		if len(cfg.OpcodeGasOverrides) > 0 {
+			// Deep-copy jumptable to prevent modification of opcodes in other tables
+			copy := CopyJumpTable(cfg.JumpTable)
+			for op, gas := range cfg.OpcodeGasOverrides {
+				if !copy[op].IsUndefined() {
+					copy[op].SetConstantGas(gas)
+				}
+			}
+			cfg.JumpTable = copy
+		}
 	}
 
 	return &EVMInterpreter{
@@ -146,6 +166,8 @@ func (in *EVMInterpreter) Run(contract *Contract, input []byte, readOnly bool) (
 		return nil, nil
 	}
 
+	contract.jumpDestCache = in.cfg.JumpDestCache
+
 	mem := NewMemory()       // bound memory
 	stack, err := NewStack() // local stack
 	if err != nil {
@@ -26,8 +26,48 @@ import (
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
 )
 
+// testStateDB extends the go-ethereum state with the methods required by the
+// Shanghai and Cancun instruction sets and the EIP-7702 delegations.
+type testStateDB struct {
+	*state.StateDB
+	transient map[common.Address]map[common.Hash]common.Hash
+}
+
+func newTestStateDB() *testStateDB {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	return &testStateDB{StateDB: statedb, transient: make(map[common.Address]map[common.Hash]common.Hash)}
+}
+
+func (s *testStateDB) Selfdestruct6780(addr common.Address) {}
+
+func (s *testStateDB) ResolveCode(addr common.Address) []byte {
+	if target, ok := ParseDelegation(s.GetCode(addr)); ok {
+		return s.GetCode(target)
+	}
+	return s.GetCode(addr)
+}
+
+func (s *testStateDB) ResolveCodeHash(addr common.Address) common.Hash {
+	if target, ok := ParseDelegation(s.GetCode(addr)); ok {
+		return s.GetCodeHash(target)
+	}
+	return s.GetCodeHash(addr)
+}
+
+func (s *testStateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transient[addr][key]
+}
+
+func (s *testStateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	if s.transient[addr] == nil {
+		s.transient[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.transient[addr][key] = value
+}
+
 var loopInterruptTests = []string{
 	// infinite loop using JUMP: push(2) jumpdest dup1 jump
 	"60025b8056",
@@ -42,7 +82,7 @@ func TestLoopInterrupt(t *testing.T) {
 	}
 
 	for i, tt := range loopInterruptTests {
-		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		statedb := newTestStateDB()
 		statedb.CreateAccount(address)
 		statedb.SetCode(address, common.Hex2Bytes(tt))
 		statedb.Finalise(true)
@@ -74,3 +114,40 @@ func TestLoopInterrupt(t *testing.T) {
 		}
 	}
 }
+
+func TestOpcodeGasOverrides(t *testing.T) {
+	address := common.BytesToAddress([]byte("contract"))
+	// sload(0)
+	code := common.Hex2Bytes("60005400")
+
+	run := func(t *testing.T, overrides map[OpCode]uint64) (uint64, *JumpTable) {
+		t.Helper()
+
+		statedb := newTestStateDB()
+		statedb.SetCode(address, code)
+		vmctx := BlockContext{
+			CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+			Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+			BlockNumber: big.NewInt(1),
+		}
+		evm := NewEVM(vmctx, TxContext{}, statedb, params.AllEthashProtocolChanges, Config{OpcodeGasOverrides: overrides})
+
+		gas := uint64(100_000)
+		_, leftOverGas, err := evm.Call(AccountRef(common.Address{}), address, nil, gas, new(big.Int))
+		require.NoError(t, err)
+		return gas - leftOverGas, evm.Interpreter().Config().JumpTable
+	}
+
+	defaultGas, defaultTable := run(t, nil)
+	overriddenGas, overriddenTable := run(t, map[OpCode]uint64{SLOAD: 1000, PUSH0: 5})
+
+	// the constant gas is charged in addition to the dynamic gas
+	require.Equal(t, defaultGas+1000, overriddenGas)
+	require.Equal(t, uint64(1000), overriddenTable[SLOAD].GetConstantGas())
+	require.True(t, overriddenTable[SLOAD].HasDynamicGas())
+	// the overrides of the opcodes undefined in the instruction set are ignored
+	require.True(t, overriddenTable[PUSH0].IsUndefined())
+	require.Equal(t, uint64(0), overriddenTable[PUSH0].GetConstantGas())
+	// the default instruction set is not modified
+	require.Equal(t, uint64(0), defaultTable[SLOAD].GetConstantGas())
+}
@@ -42,6 +42,9 @@ type operation struct {
 
 	// memorySize returns the memory size required for the operation
 	memorySize memorySizeFunc
+
+	// undefined denotes if the instruction is not officially defined in the jump table
+	undefined bool
 }
 
 var (
@@ -55,6 +58,8 @@ var (
 	BerlinInstructionSet           = newBerlinInstructionSet()
 	LondonInstructionSet           = newLondonInstructionSet()
 	MergeInstructionSet            = newMergeInstructionSet()
+	ShanghaiInstructionSet         = newShanghaiInstructionSet()
+	CancunInstructionSet           = newCancunInstructionSet()
 )
 
 // JumpTable contains the EVM opcodes supported at a given fork.
@@ -63,6 +68,8 @@ type JumpTable [256]*operation
 // DefaultJumpTable defines the default jump table used by the EVM interpreter.
 func DefaultJumpTable(rules params.Rules) (jumpTable *JumpTable) {
 	switch {
+	case rules.IsShanghai:
+		jumpTable = &ShanghaiInstructionSet
 	case rules.IsMerge:
 		jumpTable = &MergeInstructionSet
 	case rules.IsLondon:
@@ -117,6 +124,32 @@ func (jt JumpTable) MustValidate() {
 	}
 }
 
+// newCancunInstructionSet returns the frontier, homestead, byzantium,
+// contantinople, istanbul, petersburg, berlin, london, merge, shanghai and
+// cancun instructions. Blob transactions are not supported, so BLOBHASH and
+// BLOBBASEFEE always return zero values.
+func newCancunInstructionSet() JumpTable {
+	instructionSet := newShanghaiInstructionSet()
+	enable4844(&instructionSet) // EIP-4844 (BLOBHASH opcode)
+	enable7516(&instructionSet) // EIP-7516 (BLOBBASEFEE opcode)
+	enable1153(&instructionSet) // EIP-1153 "Transient Storage"
+	enable5656(&instructionSet) // EIP-5656 (MCOPY opcode)
+	enable6780(&instructionSet) // EIP-6780 SELFDESTRUCT only in same transaction
+	instructionSet.MustValidate()
+	return instructionSet
+}
+
+// newShanghaiInstructionSet returns the frontier, homestead, byzantium,
+// contantinople, istanbul, petersburg, berlin, london, merge and shanghai
+// instructions.
+func newShanghaiInstructionSet() JumpTable {
+	instructionSet := newMergeInstructionSet()
+	enable3855(&instructionSet) // PUSH0 instruction
+	enable3860(&instructionSet) // Limit and meter initcode
+	instructionSet.MustValidate()
+	return instructionSet
+}
+
 func newMergeInstructionSet() JumpTable {
 	instructionSet := newLondonInstructionSet()
 	instructionSet[RANDOM] = &operation{
@@ -1085,7 +1118,7 @@ func newFrontierInstructionSet() JumpTable {
 	// Fill all unassigned slots with opUndefined.
 	for i, entry := range tbl {
 		if entry == nil {
-			tbl[i] = &operation{execute: opUndefined, maxStack: maxStack(0, 0)}
+			tbl[i] = &operation{execute: opUndefined, maxStack: maxStack(0, 0), undefined: true}
 		}
 	}
 
@@ -17,8 +17,11 @@
 package vm
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,3 +36,50 @@ func TestJumpTableCopy(t *testing.T) {
 	require.Equal(t, uint64(100), deepCopy[SLOAD].constantGas)
 	require.Equal(t, uint64(0), tbl[SLOAD].constantGas)
 }
+
+func TestCancunInstructionSet(t *testing.T) {
+	address := common.BytesToAddress([]byte("contract"))
+	// TSTORE(1, 42), MSTORE(0, TLOAD(1)), MCOPY(32, 0, 32), RETURN(0, 64)
+	code := common.Hex2Bytes("602a60015d60015c5f5260205f60205e60405ff3")
+
+	config := *params.TestChainConfig
+	config.ShanghaiBlock = big.NewInt(0)
+	config.CancunBlock = big.NewInt(0)
+
+	vmctx := BlockContext{
+		BlockNumber: big.NewInt(1),
+		CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		cancun bool
+	}{
+		{"shanghai", false},
+		{"cancun", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			config := config
+			if !tc.cancun {
+				config.CancunBlock = nil
+			}
+
+			statedb := newTestStateDB()
+			statedb.CreateAccount(address)
+			statedb.SetCode(address, code)
+
+			evm := NewEVM(vmctx, TxContext{}, statedb, &config, Config{})
+			ret, _, err := evm.Call(AccountRef(common.Address{}), address, nil, 100000, new(big.Int))
+			if !tc.cancun {
+				require.ErrorContains(t, err, "invalid opcode: TSTORE")
+				return
+			}
+			require.NoError(t, err)
+
+			word := common.LeftPadBytes([]byte{42}, 32)
+			require.Equal(t, append(word, word...), ret)
+			require.Equal(t, common.BytesToHash(word), statedb.GetTransientState(address, common.BytesToHash([]byte{1})))
+		})
+	}
+}
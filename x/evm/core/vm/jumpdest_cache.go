@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vm
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/ethereum/go-ethereum/common"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// JumpDestCache is a bounded and concurrency-safe LRU cache of the JUMPDEST
+// analysis of contract codes, keyed by code hash. It can be shared across EVM
+// instances so that the code of hot contracts is only analysed once. The
+// analysis only depends on the code, so using the cache doesn't change the
+// execution results.
+//
+// A nil JumpDestCache is valid and disables the caching.
+type JumpDestCache struct {
+	cache *lru.Cache[common.Hash, bitvec]
+}
+
+// NewJumpDestCache returns a new JumpDestCache holding the analysis of up to
+// size codes. It returns nil, i.e. a disabled cache, if the size is not
+// positive.
+func NewJumpDestCache(size int) *JumpDestCache {
+	if size <= 0 {
+		return nil
+	}
+
+	cache, err := lru.New[common.Hash, bitvec](size)
+	if err != nil {
+		panic(err)
+	}
+	return &JumpDestCache{cache: cache}
+}
+
+// Len returns the number of code analysis held in the cache.
+func (c *JumpDestCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	return c.cache.Len()
+}
+
+// get returns the analysis of the code with the given hash if it is cached.
+func (c *JumpDestCache) get(codeHash common.Hash) (bitvec, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	analysis, ok := c.cache.Get(codeHash)
+	if ok {
+		telemetry.IncrCounter(1, "evm", "jumpdest_cache", "hit")
+	} else {
+		telemetry.IncrCounter(1, "evm", "jumpdest_cache", "miss")
+	}
+	return analysis, ok
+}
+
+// add caches the analysis of the code with the given hash. The analysis must
+// not be modified afterwards as it is shared by all the cache users.
+func (c *JumpDestCache) add(codeHash common.Hash, analysis bitvec) {
+	if c == nil {
+		return
+	}
+	c.cache.Add(codeHash, analysis)
+}
@@ -0,0 +1,72 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJumpDestCache(t *testing.T) {
+	require.Nil(t, NewJumpDestCache(0))
+	require.Nil(t, NewJumpDestCache(-1))
+
+	// a nil cache is disabled
+	var cache *JumpDestCache
+	cache.add(common.Hash{1}, bitvec{})
+	_, ok := cache.get(common.Hash{1})
+	require.False(t, ok)
+	require.Zero(t, cache.Len())
+
+	cache = NewJumpDestCache(1)
+	cache.add(common.Hash{1}, bitvec{1})
+	analysis, ok := cache.get(common.Hash{1})
+	require.True(t, ok)
+	require.Equal(t, bitvec{1}, analysis)
+
+	// the least recently used analysis is evicted
+	cache.add(common.Hash{2}, bitvec{2})
+	_, ok = cache.get(common.Hash{1})
+	require.False(t, ok)
+	require.Equal(t, 1, cache.Len())
+}
+
+func TestJumpDestCacheSharedAcrossEVMs(t *testing.T) {
+	var (
+		address = common.BytesToAddress([]byte("contract"))
+		// jump(4) invalid jumpdest stop
+		code     = hexutil.MustDecode("0x600456fe5b00")
+		codeHash = crypto.Keccak256Hash(code)
+		cache    = NewJumpDestCache(16)
+	)
+
+	call := func(t *testing.T) {
+		t.Helper()
+
+		statedb := newTestStateDB()
+		statedb.SetCode(address, code)
+		vmctx := BlockContext{
+			CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+			Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+			BlockNumber: big.NewInt(1),
+		}
+		evm := NewEVM(vmctx, TxContext{}, statedb, params.AllEthashProtocolChanges, Config{JumpDestCache: cache})
+
+		_, _, err := evm.Call(AccountRef(common.Address{}), address, nil, 100_000, new(big.Int))
+		require.NoError(t, err)
+	}
+
+	call(t)
+	require.Equal(t, 1, cache.Len())
+	analysis, ok := cache.get(codeHash)
+	require.True(t, ok)
+	require.Equal(t, codeBitmap(code), analysis)
+
+	// the cached analysis is used by the following EVM instances
+	call(t)
+	require.Equal(t, 1, cache.Len())
+}
@@ -26,6 +26,15 @@ func InitGenesis(
 		panic(fmt.Errorf("error setting params %s", err))
 	}
 
+	// store the chain configuration of the node if none is defined at genesis
+	chainConfig := data.ChainConfig
+	if chainConfig == nil {
+		chainConfig = types.GetChainConfig()
+	}
+	if err := k.SetChainConfig(ctx, *chainConfig); err != nil {
+		panic(fmt.Errorf("error setting chain config %s", err))
+	}
+
 	// ensure evm module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the EVM module account has not been set")
@@ -76,8 +85,10 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	chainConfig := k.GetChainConfig(ctx)
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:    ethGenAccounts,
+		Params:      k.GetParams(ctx),
+		ChainConfig: &chainConfig,
 	}
 }
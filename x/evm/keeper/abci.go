@@ -7,13 +7,20 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/green901612/cosevm/x/evm/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock emits a base fee event which will be adjusted to the evm decimals
+// BeginBlock records the hash of the block, sets its PREVRANDAO value and emits
+// a base fee event which will be adjusted to the evm decimals
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	logger := ctx.Logger().With("begin_block", "evm")
 
+	k.SetBlockRandom(ctx, types.BlockRandom(k.lastBlockHash(ctx)))
+	if headerHash := ctx.HeaderHash(); len(headerHash) != 0 {
+		k.SetBlockHash(ctx, uint64(ctx.BlockHeight()), common.BytesToHash(headerHash)) //nolint:gosec // G115
+	}
+
 	// Base fee is already set on FeeMarket BeginBlock
 	// that runs before this one
 	// We emit this event on the EVM and FeeMarket modules
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/testutil"
+	"github.com/green901612/cosevm/x/evm/types"
+)
+
+func TestBeginBlockPersistsBlockRandom(t *testing.T) {
+	miniApp, ctx := testutil.Setup(t)
+	k := miniApp.EvmKeeper
+
+	headerHash := common.BytesToHash(crypto.Keccak256([]byte("block")))
+	require.NoError(t, k.BeginBlock(ctx.WithHeaderHash(headerHash.Bytes())))
+	lastRandom := k.GetBlockRandom(ctx.WithHeaderHash(nil))
+	require.NotNil(t, lastRandom)
+
+	// the PREVRANDAO value of the next block is derived from the hash of this
+	// one, not from its own header hash, and the queries, which have no header
+	// hash in their context, read it from the store
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	nextHeaderHash := crypto.Keccak256([]byte("next block"))
+	require.NoError(t, k.BeginBlock(ctx.WithHeaderHash(nextHeaderHash)))
+	random := k.GetBlockRandom(ctx.WithHeaderHash(nil))
+	require.NotNil(t, random)
+	require.Equal(t, types.BlockRandom(headerHash), *random)
+	require.NotEqual(t, *lastRandom, *random)
+}
+
+func TestGetBlockRandomFallback(t *testing.T) {
+	miniApp, ctx := testutil.Setup(t)
+	k := miniApp.EvmKeeper
+
+	// before BeginBlock stores it, it is derived from the hash of the
+	// previous block
+	headerHash := common.BytesToHash(crypto.Keccak256([]byte("block")))
+	k.SetBlockHash(ctx, uint64(ctx.BlockHeight()), headerHash) //nolint:gosec // G115
+	store := ctx.KVStore(miniApp.GetKey(types.StoreKey))
+	store.Delete(types.KeyPrefixBlockRandom)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	random := k.GetBlockRandom(ctx)
+	require.NotNil(t, random)
+	require.Equal(t, types.BlockRandom(headerHash), *random)
+
+	// and it is not available without it
+	require.Nil(t, k.GetBlockRandom(ctx.WithBlockHeight(ctx.BlockHeight()+10)))
+}
@@ -0,0 +1,54 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	"github.com/green901612/cosevm/x/evm/types"
+)
+
+// isSystemAddress returns true if the given address is the one of a module
+// account. The modules executing EVM messages on their own behalf, such as the
+// erc20 module when converting coins, are not subject to the access control
+// policy of the EVM parameters.
+func (k Keeper) isSystemAddress(ctx sdk.Context, addr common.Address) bool {
+	_, isModuleAccount := k.accountKeeper.GetAccount(ctx, addr.Bytes()).(sdk.ModuleAccountI)
+	return isModuleAccount
+}
+
+// checkAccessControl checks that the sender of the message is allowed to
+// perform its top-level contract creation or call, so that the forbidden
+// transactions are rejected before being executed. The calls to precompiled
+// contracts are always allowed.
+func (k *Keeper) checkAccessControl(ctx sdk.Context, evm *vm.EVM, msg core.Message, params types.Params) error {
+	sender := msg.From()
+	if k.isSystemAddress(ctx, sender) {
+		return nil
+	}
+
+	accessControl := types.NewRestrictedPermissionPolicy(&params.AccessControl, sender)
+
+	if msg.To() == nil {
+		if !accessControl.CanCreate(sender, sender) {
+			return errorsmod.Wrapf(types.ErrCreateDisabled, "failed to create contract for sender %s", sender)
+		}
+		return nil
+	}
+
+	// load the dynamic ERC-20 precompile of the recipient, if any, so that the
+	// calls to it are allowed as the ones to the static precompiles
+	if err := k.GetPrecompilesCallHook(ctx)(evm, sender, *msg.To()); err != nil {
+		return err
+	}
+
+	if err := accessControl.GetCallHook(sender)(evm, sender, *msg.To()); err != nil {
+		return errorsmod.Wrapf(types.ErrCallDisabled, "failed to perform a call for sender %s", sender)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/types"
+)
+
+// GetBlockHash returns the hash of the block at the given height from the block
+// hash ring buffer. It returns an empty hash if the block is not one of the
+// HistoryServeWindow blocks before the current one, or if its hash was not
+// recorded.
+func (k Keeper) GetBlockHash(ctx sdk.Context, height uint64) common.Hash {
+	if !types.IsInHistoryServeWindow(height, uint64(ctx.BlockHeight())) { //nolint:gosec // G115
+		return common.Hash{}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockHashKey(height))
+	if len(bz) == 0 {
+		return common.Hash{}
+	}
+	return common.BytesToHash(bz)
+}
+
+// SetBlockHash sets the hash of the block at the given height to the block
+// hash ring buffer, overwriting the hash of the block BlockHashRingSize
+// heights before.
+func (k Keeper) SetBlockHash(ctx sdk.Context, height uint64, hash common.Hash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockHashKey(height), hash.Bytes())
+}
+
+// lastBlockHash returns the hash of the block preceding the current one, i.e.
+// the LastBlockId of the current header, or an empty hash if it isn't known.
+func (k Keeper) lastBlockHash(ctx sdk.Context) common.Hash {
+	if ctx.BlockHeight() <= 1 {
+		return common.Hash{}
+	}
+	return k.GetBlockHash(ctx, uint64(ctx.BlockHeight()-1)) //nolint:gosec // G115
+}
@@ -0,0 +1,49 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	geth "github.com/ethereum/go-ethereum/params"
+
+	"github.com/green901612/cosevm/x/evm/types"
+)
+
+// GetChainConfig returns the EVM chain configuration stored in the module. The
+// chain configuration of the node is returned if none has been stored yet,
+// i.e. on chains upgraded from a version without an on-chain configuration.
+func (k Keeper) GetChainConfig(ctx sdk.Context) (chainConfig types.ChainConfig) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixChainConfig)
+	if len(bz) == 0 {
+		return *types.GetChainConfig()
+	}
+	k.cdc.MustUnmarshal(bz, &chainConfig)
+	return
+}
+
+// GetEthChainConfig returns the EVM chain configuration stored in the module
+// as the geth type used by the EVM.
+func (k Keeper) GetEthChainConfig(ctx sdk.Context) *geth.ChainConfig {
+	return k.GetChainConfig(ctx).EthereumConfig(nil)
+}
+
+// SetChainConfig validates and sets the EVM chain configuration. The EVM coin
+// information is not part of the stored configuration.
+func (k Keeper) SetChainConfig(ctx sdk.Context, chainConfig types.ChainConfig) error {
+	if err := chainConfig.Validate(); err != nil {
+		return err
+	}
+
+	chainConfig.Denom = ""
+	chainConfig.Decimals = 0
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&chainConfig)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.KeyPrefixChainConfig, bz)
+	return nil
+}
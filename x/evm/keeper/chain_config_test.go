@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/testutil"
+	"github.com/green901612/cosevm/x/evm"
+	"github.com/green901612/cosevm/x/evm/types"
+)
+
+func TestUpdateChainConfig(t *testing.T) {
+	miniApp, ctx := testutil.Setup(t)
+	k := miniApp.EvmKeeper
+
+	// the Cancun fork is not scheduled on the stored configuration
+	chainConfig := k.GetChainConfig(ctx)
+	chainConfig.CancunBlock = nil
+	require.NoError(t, k.SetChainConfig(ctx, chainConfig))
+
+	cancunBlock := sdkmath.NewInt(ctx.BlockHeight() + 10)
+	updated := chainConfig
+	updated.CancunBlock = &cancunBlock
+
+	activeBlock := sdkmath.NewInt(ctx.BlockHeight())
+	activeUpdate := chainConfig
+	activeUpdate.CancunBlock = &activeBlock
+
+	shanghaiBlock := sdkmath.NewInt(ctx.BlockHeight() + 10)
+	shanghaiUpdate := chainConfig
+	shanghaiUpdate.ShanghaiBlock = &shanghaiBlock
+
+	testCases := []struct {
+		name        string
+		msg         *types.MsgUpdateChainConfig
+		errContains string
+	}{
+		{
+			name: "fail - invalid authority",
+			msg: &types.MsgUpdateChainConfig{
+				Authority:   testutil.NewAccount(t).AccAddr.String(),
+				ChainConfig: updated,
+			},
+			errContains: govtypes.ErrInvalidSigner.Error(),
+		},
+		{
+			name: "fail - fork activating at the current height",
+			msg: &types.MsgUpdateChainConfig{
+				Authority:   k.GetAuthority().String(),
+				ChainConfig: activeUpdate,
+			},
+			errContains: "cancunBlock must be after the current height",
+		},
+		{
+			name: "fail - fork already active",
+			msg: &types.MsgUpdateChainConfig{
+				Authority:   k.GetAuthority().String(),
+				ChainConfig: shanghaiUpdate,
+			},
+			errContains: "shanghaiBlock is already active",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := k.UpdateChainConfig(ctx, tc.msg)
+			require.ErrorContains(t, err, tc.errContains)
+			require.Equal(t, chainConfig, k.GetChainConfig(ctx))
+		})
+	}
+
+	_, err := k.UpdateChainConfig(ctx, &types.MsgUpdateChainConfig{
+		Authority:   k.GetAuthority().String(),
+		ChainConfig: updated,
+	})
+	require.NoError(t, err)
+	require.Equal(t, updated, k.GetChainConfig(ctx))
+
+	// the EVM configuration of the following blocks uses the stored one
+	cfg, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	require.NoError(t, err)
+	require.Equal(t, cancunBlock.BigInt(), cfg.ChainConfig.CancunBlock)
+	require.False(t, cfg.ChainConfig.IsCancun(big.NewInt(ctx.BlockHeight())))
+	require.True(t, cfg.ChainConfig.IsCancun(cancunBlock.BigInt()))
+
+	// the Config query returns it with the EVM coin information
+	res, err := k.Config(ctx, &types.QueryConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, cancunBlock, *res.Config.CancunBlock)
+	require.Equal(t, types.GetEVMCoinDenom(), res.Config.Denom)
+}
+
+func TestChainConfigGenesis(t *testing.T) {
+	miniApp, ctx := testutil.Setup(t)
+	k := miniApp.EvmKeeper
+
+	chainConfig := k.GetChainConfig(ctx)
+	cancunBlock := sdkmath.NewInt(100)
+	chainConfig.CancunBlock = &cancunBlock
+	require.NoError(t, k.SetChainConfig(ctx, chainConfig))
+
+	genesis := evm.ExportGenesis(ctx, k)
+	require.NotNil(t, genesis.ChainConfig)
+	require.Equal(t, chainConfig, *genesis.ChainConfig)
+
+	// the exported configuration is stored by the genesis of a new chain
+	newApp, newCtx := testutil.Setup(t)
+	require.NotEqual(t, chainConfig, newApp.EvmKeeper.GetChainConfig(newCtx))
+	evm.InitGenesis(newCtx, newApp.EvmKeeper, newApp.AccountKeeper, *genesis)
+	require.Equal(t, chainConfig, newApp.EvmKeeper.GetChainConfig(newCtx))
+}
@@ -15,7 +15,7 @@ import (
 // EVMConfig creates the EVMConfig based on current state
 func (k *Keeper) EVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress) (*statedb.EVMConfig, error) {
 	params := k.GetParams(ctx)
-	ethCfg := types.GetEthChainConfig()
+	ethCfg := k.GetEthChainConfig(ctx)
 
 	// get the coinbase address from the block proposer
 	coinbase, err := k.GetCoinbaseAddress(ctx, proposerAddress)
@@ -42,8 +42,9 @@ func (k *Keeper) TxConfig(ctx sdk.Context, txHash common.Hash) statedb.TxConfig
 	)
 }
 
-// VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled on the
-// module parameters. The config generated uses the default JumpTable from the EVM.
+// VMConfig creates an EVM configuration from the debug setting, the extra EIPs enabled and the opcode
+// gas overrides of the module parameters. The config generated uses the default JumpTable from the EVM
+// and the JUMPDEST analysis cache of the keeper.
 func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger) vm.Config {
 	noBaseFee := true
 	if types.IsLondon(cfg.ChainConfig, ctx.BlockHeight()) {
@@ -56,9 +57,11 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 	}
 
 	return vm.Config{
-		Debug:     debug,
-		Tracer:    tracer,
-		NoBaseFee: noBaseFee,
-		ExtraEips: cfg.Params.EIPs(),
+		Debug:              debug,
+		Tracer:             tracer,
+		NoBaseFee:          noBaseFee,
+		ExtraEips:          cfg.Params.EIPs(),
+		OpcodeGasOverrides: cfg.Params.GasOverrides(),
+		JumpDestCache:      k.jumpDestCache,
 	}
 }
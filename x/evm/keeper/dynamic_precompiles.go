@@ -0,0 +1,59 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	"github.com/green901612/cosevm/x/evm/types"
+)
+
+// WithErc20Keeper sets the keeper used to instantiate the dynamic ERC-20
+// precompiles. It is set after the keepers are built as the erc20 keeper
+// depends on the EVM keeper.
+func (k *Keeper) WithErc20Keeper(erc20Keeper types.Erc20Keeper) *Keeper {
+	if k.erc20Keeper != nil {
+		panic("erc20 keeper already set")
+	}
+
+	k.erc20Keeper = erc20Keeper
+	return k
+}
+
+// GetPrecompilesCallHook returns a call hook that loads the dynamic ERC-20
+// precompile registered at the called address, if any, into the precompiled
+// contracts of the EVM before the call is executed.
+func (k Keeper) GetPrecompilesCallHook(ctx sdk.Context) types.CallHook {
+	return func(evm *vm.EVM, _ common.Address, recipient common.Address) error {
+		if k.erc20Keeper == nil {
+			return nil
+		}
+
+		// skip the addresses that are already precompiles
+		if _, found := evm.Precompile(recipient); found {
+			return nil
+		}
+
+		precompile, found, err := k.erc20Keeper.GetERC20PrecompileInstance(ctx, recipient)
+		if err != nil || !found {
+			return err
+		}
+
+		activeAddrs := evm.ActivePrecompiles(evm.ChainConfig().Rules(evm.Context.BlockNumber, evm.Context.Random != nil))
+
+		addresses := make([]common.Address, len(activeAddrs), len(activeAddrs)+1)
+		copy(addresses, activeAddrs)
+		addresses = append(addresses, recipient)
+
+		precompiles := make(map[common.Address]vm.PrecompiledContract, len(addresses))
+		for _, address := range activeAddrs {
+			precompiles[address], _ = evm.Precompile(address)
+		}
+		precompiles[recipient] = precompile
+
+		evm.WithPrecompiles(precompiles, addresses)
+		return nil
+	}
+}
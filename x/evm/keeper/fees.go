@@ -6,14 +6,15 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/green901612/cosevm/x/evm/types"
 )
@@ -64,6 +65,39 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 	return nil
 }
 
+// RefundTxCostsToUserBalance refunds the fees, deducted from the user balance
+// by DeductTxCostsFromUserBalance, from the fee collector.
+func (k *Keeper) RefundTxCostsToUserBalance(
+	ctx sdk.Context,
+	fees sdk.Coins,
+	to common.Address,
+) error {
+	if err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, to.Bytes(), fees); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund gas cost %s to the user %s balance", fees, to)
+	}
+	return nil
+}
+
+// GetCheckTxFees returns the fees deducted during CheckTx for the transaction
+// of the sender with the given nonce, if any. They are kept in the transient
+// store of the check state, which is reset on every commit together with the
+// deductions.
+func (k Keeper) GetCheckTxFees(ctx sdk.Context, sender common.Address, nonce uint64) sdk.Coins {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientCheckTxFees)
+	bz := store.Get(types.CheckTxFeesKey(sender, nonce))
+	if len(bz) == 0 {
+		return nil
+	}
+	return sdk.NewCoins(sdk.NewCoin(types.GetEVMCoinDenom(), sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(bz))))
+}
+
+// SetCheckTxFees sets the fees, in the EVM denomination, deducted during
+// CheckTx for the transaction of the sender with the given nonce.
+func (k Keeper) SetCheckTxFees(ctx sdk.Context, sender common.Address, nonce uint64, fees sdk.Coins) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientCheckTxFees)
+	store.Set(types.CheckTxFeesKey(sender, nonce), fees.AmountOf(types.GetEVMCoinDenom()).BigInt().Bytes())
+}
+
 // VerifyFee is used to return the fee for the given transaction data in sdk.Coins. It checks that the
 // gas limit is not reached, the gas limit is higher than the intrinsic gas and that the
 // base fee is higher than the gas fee cap.
@@ -71,7 +105,7 @@ func VerifyFee(
 	txData types.TxData,
 	denom string,
 	baseFee *big.Int,
-	homestead, istanbul, isCheckTx bool,
+	homestead, istanbul, shanghai, isCheckTx bool,
 ) (sdk.Coins, error) {
 	isContractCreation := txData.GetTo() == nil
 
@@ -82,12 +116,21 @@ func VerifyFee(
 		accessList = txData.GetAccessList()
 	}
 
-	intrinsicGas, err := core.IntrinsicGas(txData.GetData(), accessList, isContractCreation, homestead, istanbul)
+	var authList types.AuthorizationList
+	if setCodeTx, ok := txData.(*types.SetCodeTx); ok {
+		authList = setCodeTx.GetAuthorizationList()
+	}
+
+	if err := checkInitCodeSize(txData.GetData(), isContractCreation, shanghai); err != nil {
+		return nil, err
+	}
+
+	intrinsicGas, err := intrinsicGas(txData.GetData(), accessList, authList, isContractCreation, homestead, istanbul, shanghai)
 	if err != nil {
 		return nil, errorsmod.Wrapf(
 			err,
-			"failed to retrieve intrinsic gas, contract creation = %t; homestead = %t, istanbul = %t",
-			isContractCreation, homestead, istanbul,
+			"failed to retrieve intrinsic gas, contract creation = %t; homestead = %t, istanbul = %t, shanghai = %t",
+			isContractCreation, homestead, istanbul, shanghai,
 		)
 	}
 
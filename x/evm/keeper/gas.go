@@ -3,9 +3,11 @@
 package keeper
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,6 +16,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/green901612/cosevm/x/evm/core/vm"
 	"github.com/green901612/cosevm/x/evm/types"
 )
 
@@ -22,8 +25,65 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	height := big.NewInt(ctx.BlockHeight())
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
+	shanghai := cfg.IsShanghai(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	var authList types.AuthorizationList
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		authList = setCodeMsg.AuthorizationList()
+	}
+
+	return intrinsicGas(msg.Data(), msg.AccessList(), authList, isContractCreation, homestead, istanbul, shanghai)
+}
+
+// intrinsicGas computes the intrinsic gas of a message, adding the cost of the
+// EIP-7702 authorizations and the initcode word cost of EIP-3860 to contract
+// creations once Shanghai is active.
+func intrinsicGas(
+	data []byte,
+	accessList ethtypes.AccessList,
+	authList types.AuthorizationList,
+	isContractCreation, homestead, istanbul, shanghai bool,
+) (uint64, error) {
+	gas, err := core.IntrinsicGas(data, accessList, isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	authCount := uint64(len(authList))
+	if (math.MaxUint64-gas)/types.PerEmptyAccountCost < authCount {
+		return 0, core.ErrGasUintOverflow
+	}
+	gas += authCount * types.PerEmptyAccountCost
+
+	if !isContractCreation || !shanghai {
+		return gas, nil
+	}
+
+	lenWords := toWordSize(uint64(len(data)))
+	if (math.MaxUint64-gas)/vm.InitCodeWordGas < lenWords {
+		return 0, core.ErrGasUintOverflow
+	}
+	return gas + lenWords*vm.InitCodeWordGas, nil
+}
+
+// checkInitCodeSize returns an error if the data of a contract creation
+// exceeds the initcode size limit of EIP-3860.
+func checkInitCodeSize(data []byte, isContractCreation, shanghai bool) error {
+	if isContractCreation && shanghai && len(data) > vm.MaxInitCodeSize {
+		return errorsmod.Wrapf(
+			vm.ErrMaxInitCodeSizeExceeded,
+			"code size %d limit %d", len(data), vm.MaxInitCodeSize,
+		)
+	}
+	return nil
+}
+
+// toWordSize returns the ceiled word size required for the given size.
+func toWordSize(size uint64) uint64 {
+	if size > math.MaxUint64-31 {
+		return math.MaxUint64/32 + 1
+	}
+	return (size + 31) / 32
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
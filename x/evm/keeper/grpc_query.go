@@ -488,12 +488,11 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	// and avoid stacking the gas used of every predecessor in the same gas meter
 
 	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		msg, err := tx.AsMessage(signer, cfg.BaseFee)
 		if err != nil {
 			continue
 		}
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.TxHash()
 		txConfig.TxIndex = uint(i) // #nosec G115
 		// reset gas meter for each transaction
 		ctx = evmante.BuildEvmExecutionCtx(ctx).
@@ -505,8 +504,8 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		txConfig.LogIndex += uint(len(rsp.Logs))
 	}
 
-	tx := req.Msg.AsTransaction()
-	txConfig.TxHash = tx.Hash()
+	tx := req.Msg
+	txConfig.TxHash = tx.TxHash()
 	if len(req.Predecessors) > 0 {
 		txConfig.TxIndex++
 	}
@@ -581,10 +580,9 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
-		ethTx := tx.AsTransaction()
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.TxHash()
 		txConfig.TxIndex = uint(i) // #nosec G115
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, true, nil)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -610,7 +608,7 @@ func (k *Keeper) traceTx(
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 	signer ethtypes.Signer,
-	tx *ethtypes.Transaction,
+	tx *types.MsgEthereumTx,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
@@ -717,10 +715,83 @@ func (k Keeper) GlobalMinGasPrice(c context.Context, _ *types.QueryGlobalMinGasP
 }
 
 // Config implements the Query/Config gRPC method
-func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
-	config := types.GetChainConfig()
+func (k Keeper) Config(c context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	config := k.GetChainConfig(ctx)
 	config.Denom = types.GetEVMCoinDenom()
 	config.Decimals = uint64(types.GetEVMCoinDecimals())
 
-	return &types.QueryConfigResponse{Config: config}, nil
+	return &types.QueryConfigResponse{Config: &config}, nil
+}
+
+// AccessControl implements the Query/AccessControl gRPC method. It returns if
+// the given account is allowed to create and call contracts, as the sender of
+// a transaction, by the access control policy of the EVM parameters.
+func (k Keeper) AccessControl(c context.Context, req *types.QueryAccessControlRequest) (*types.QueryAccessControlResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	address := common.HexToAddress(req.Address)
+
+	if k.isSystemAddress(ctx, address) {
+		return &types.QueryAccessControlResponse{CanCreate: true, CanCall: true}, nil
+	}
+
+	params := k.GetParams(ctx)
+	accessControl := types.NewRestrictedPermissionPolicy(&params.AccessControl, address)
+
+	return &types.QueryAccessControlResponse{
+		CanCreate: accessControl.CanCreate(address, address),
+		CanCall:   accessControl.CanCall(address, address, common.Address{}),
+	}, nil
+}
+
+// OpcodeGasSchedule implements the Query/OpcodeGasSchedule gRPC method. It
+// returns the gas of the opcodes of the EVM instruction set of the current
+// block, with the extra EIPs and the opcode gas overrides of the EVM parameters
+// applied. The overrides only replace the constant gas, the dynamic gas is not
+// part of the schedule.
+func (k Keeper) OpcodeGasSchedule(c context.Context, _ *types.QueryOpcodeGasScheduleRequest) (*types.QueryOpcodeGasScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	overrides := params.GasOverrides()
+
+	// the instruction set only depends on the chain rules of the block and on
+	// the vm configuration, so the EVM doesn't need any state
+	blockCtx := vm.BlockContext{
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
+		Random:      k.GetBlockRandom(ctx),
+	}
+	vmConfig := vm.Config{
+		ExtraEips:          params.EIPs(),
+		OpcodeGasOverrides: overrides,
+	}
+	evm := vm.NewEVM(blockCtx, vm.TxContext{}, nil, k.GetEthChainConfig(ctx), vmConfig)
+	jumpTable := evm.Interpreter().Config().JumpTable
+
+	opcodes := make([]types.OpcodeGas, 0, len(jumpTable))
+	for i, operation := range jumpTable {
+		if operation.IsUndefined() {
+			continue
+		}
+
+		op := vm.OpCode(i)
+		_, overridden := overrides[op]
+		opcodes = append(opcodes, types.OpcodeGas{
+			Opcode:      op.String(),
+			ConstantGas: operation.GetConstantGas(),
+			DynamicGas:  operation.HasDynamicGas(),
+			Overridden:  overridden,
+		})
+	}
+
+	return &types.QueryOpcodeGasScheduleResponse{Opcodes: opcodes}, nil
 }
@@ -58,6 +58,10 @@ type Keeper struct {
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
+	// jumpDestCache caches the JUMPDEST analysis of the contract codes across
+	// all the EVM instances created by the keeper
+	jumpDestCache *vm.JumpDestCache
+
 	// Legacy subspace
 	ss paramstypes.Subspace
 
@@ -76,8 +80,8 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	sk types.StakingKeeper,
 	fmk types.FeeMarketKeeper,
-	erc20Keeper types.Erc20Keeper,
 	tracer string,
+	jumpDestCacheSize int,
 	ss paramstypes.Subspace,
 ) *Keeper {
 	// ensure evm module account is set
@@ -104,7 +108,7 @@ func NewKeeper(
 		storeKey:         storeKey,
 		transientKey:     transientKey,
 		tracer:           tracer,
-		erc20Keeper:      erc20Keeper,
+		jumpDestCache:    vm.NewJumpDestCache(jumpDestCacheSize),
 		ss:               ss,
 	}
 }
@@ -154,6 +158,34 @@ func (k Keeper) SetBlockBloomTransient(ctx sdk.Context, bloom *big.Int) {
 	store.Set(heightBz, bloom.Bytes())
 }
 
+// GetBlockRandom returns the PREVRANDAO value of the latest block. It is read
+// from the store, where BeginBlock persists it, so that it is also available
+// on the queries, whose context doesn't have a header hash. It is derived
+// from the hash of the previous block when it is not stored yet, and it
+// returns nil if that hash is not available either.
+func (k Keeper) GetBlockRandom(ctx sdk.Context) *common.Hash {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.KeyPrefixBlockRandom); len(bz) != 0 {
+		random := common.BytesToHash(bz)
+		return &random
+	}
+
+	lastBlockHash := k.lastBlockHash(ctx)
+	if lastBlockHash == (common.Hash{}) {
+		return nil
+	}
+
+	random := types.BlockRandom(lastBlockHash)
+	return &random
+}
+
+// SetBlockRandom sets the PREVRANDAO value of the latest block to the store,
+// overwriting the one of the previous block.
+func (k Keeper) SetBlockRandom(ctx sdk.Context, random common.Hash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixBlockRandom, random.Bytes())
+}
+
 // ----------------------------------------------------------------------------
 // Tx
 // ----------------------------------------------------------------------------
@@ -269,7 +301,7 @@ func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 // - `0`: london hardfork enabled but feemarket is not enabled.
 // - `n`: both london hardfork and feemarket are enabled.
 func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
-	ethCfg := types.GetEthChainConfig()
+	ethCfg := k.GetEthChainConfig(ctx)
 	if !types.IsLondon(ethCfg, ctx.BlockHeight()) {
 		return nil
 	}
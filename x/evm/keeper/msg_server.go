@@ -33,10 +33,14 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 
 	sender := msg.From
 	tx := msg.AsTransaction()
+	txData, err := types.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unpack tx data")
+	}
 	txIndex := k.GetTxIndexTransient(ctx)
 
 	labels := []metrics.Label{
-		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", tx.Type())),
+		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", txData.TxType())),
 	}
 	if tx.To() == nil {
 		labels = append(labels, telemetry.NewLabel("execution", "create"))
@@ -44,7 +48,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplyTransaction(ctx, tx)
+	response, err := k.ApplyTransaction(ctx, msg)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
@@ -147,3 +151,26 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateChainConfig implements the gRPC MsgServer interface. When an
+// UpdateChainConfig proposal passes, it updates the EVM chain configuration
+// used from the next block. Only the forks that are not active yet can be
+// changed, and only to heights after the current one. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateChainConfig(goCtx context.Context, req *types.MsgUpdateChainConfig) (*types.MsgUpdateChainConfigResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.GetChainConfig(ctx).ValidateUpdate(req.ChainConfig, ctx.BlockHeight()); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetChainConfig(ctx, req.ChainConfig); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateChainConfigResponse{}, nil
+}
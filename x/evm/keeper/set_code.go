@@ -0,0 +1,70 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	"github.com/green901612/cosevm/x/evm/types"
+)
+
+// applyAuthorizations applies the EIP-7702 authorizations of a set code
+// transaction before its execution. The invalid authorizations are skipped
+// without failing the transaction.
+func (k *Keeper) applyAuthorizations(ctx sdk.Context, stateDB vm.StateDB, chainID *big.Int, authList types.AuthorizationList) {
+	for i, auth := range authList {
+		if err := applyAuthorization(stateDB, chainID, auth); err != nil {
+			k.Logger(ctx).Debug("skipped invalid authorization", "index", i, "error", err.Error())
+		}
+	}
+}
+
+// applyAuthorization verifies the given authorization and sets the delegation
+// designator of its address to the code of the authority, or clears it if the
+// address is the zero one.
+func applyAuthorization(stateDB vm.StateDB, chainID *big.Int, auth types.SetCodeAuthorization) error {
+	if authChainID := auth.GetChainID(); authChainID.Sign() != 0 && authChainID.Cmp(chainID) != 0 {
+		return fmt.Errorf("invalid chain id %s", authChainID)
+	}
+
+	if auth.Nonce == math.MaxUint64 {
+		return errors.New("nonce overflow")
+	}
+
+	authority, err := auth.Authority()
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	// the authority is accessed even if the authorization is invalid
+	stateDB.AddAddressToAccessList(authority)
+
+	code := stateDB.GetCode(authority)
+	if _, isDelegated := vm.ParseDelegation(code); len(code) != 0 && !isDelegated {
+		return fmt.Errorf("authority %s is not an EOA", authority)
+	}
+
+	if nonce := stateDB.GetNonce(authority); nonce != auth.Nonce {
+		return fmt.Errorf("invalid nonce for authority %s: have %d, want %d", authority, auth.Nonce, nonce)
+	}
+
+	if stateDB.Exist(authority) {
+		stateDB.AddRefund(types.PerEmptyAccountCost - types.PerAuthBaseCost)
+	}
+
+	if address := auth.GetAddress(); address == (common.Address{}) {
+		stateDB.SetCode(authority, nil)
+	} else {
+		stateDB.SetCode(authority, vm.AddressToDelegation(address))
+	}
+
+	stateDB.SetNonce(authority, auth.Nonce+1)
+	return nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/testutil"
+	evmante "github.com/green901612/cosevm/x/evm/ante"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	"github.com/green901612/cosevm/x/evm/types"
+)
+
+// newAuthority returns the key and address of a new authority.
+func newAuthority(t *testing.T) (*ecdsa.PrivateKey, common.Address) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return key, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+// newAuthorization returns the authorization of the delegation to the given
+// address, signed by the given key.
+func newAuthorization(t *testing.T, key *ecdsa.PrivateKey, chainID int64, address common.Address, nonce uint64) types.SetCodeAuthorization {
+	t.Helper()
+
+	authChainID := sdkmath.NewInt(chainID)
+	auth := types.SetCodeAuthorization{
+		ChainID: &authChainID,
+		Address: address.Hex(),
+		Nonce:   nonce,
+	}
+	require.NoError(t, auth.Sign(key))
+	return auth
+}
+
+func TestApplyAuthorizations(t *testing.T) {
+	sender := testutil.NewAccount(t)
+	miniApp, ctx := testutil.Setup(t, testutil.NewBalance(sender, sdk.DefaultPowerReduction))
+	k := miniApp.EvmKeeper
+
+	chainID := types.GetEthChainConfig().ChainID.Int64()
+	target := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	validKey, valid := newAuthority(t)
+	anyChainKey, anyChain := newAuthority(t)
+	wrongNonceKey, wrongNonce := newAuthority(t)
+	wrongChainKey, wrongChain := newAuthority(t)
+	invalidSigKey, invalidSig := newAuthority(t)
+
+	invalidSigAuth := newAuthorization(t, invalidSigKey, chainID, target, 0)
+	invalidSigAuth.V = []byte{5}
+
+	authList := types.AuthorizationList{
+		newAuthorization(t, validKey, chainID, target, 0),
+		// an authorization with a zero chain id is valid on any chain
+		newAuthorization(t, anyChainKey, 0, target, 0),
+		newAuthorization(t, wrongNonceKey, chainID, target, 1),
+		newAuthorization(t, wrongChainKey, chainID+1, target, 0),
+		invalidSigAuth,
+	}
+
+	msg := types.NewSetCodeMessage(ethtypes.NewMessage(
+		sender.Address, &target, 0, big.NewInt(0), 1_000_000,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false,
+	), authList)
+
+	ctx = evmante.BuildEvmExecutionCtx(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	res, err := k.ApplyMessage(ctx, msg, types.NewNoOpTracer(), true)
+	require.NoError(t, err)
+	require.False(t, res.Failed(), res.VmError)
+
+	// the valid authorizations delegate the code of their authority and
+	// increment its nonce
+	for _, authority := range []common.Address{valid, anyChain} {
+		require.Equal(t, vm.AddressToDelegation(target), k.GetCode(ctx, k.GetCodeHash(ctx, authority)), authority)
+		require.Equal(t, uint64(1), k.GetNonce(ctx, authority), authority)
+	}
+
+	// the invalid authorizations are skipped without failing the transaction
+	for _, authority := range []common.Address{wrongNonce, wrongChain, invalidSig} {
+		require.Empty(t, k.GetCode(ctx, k.GetCodeHash(ctx, authority)), authority)
+		require.Zero(t, k.GetNonce(ctx, authority), authority)
+	}
+
+	// a delegation to the zero address clears the code of the authority
+	msg = types.NewSetCodeMessage(ethtypes.NewMessage(
+		sender.Address, &target, 1, big.NewInt(0), 1_000_000,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false,
+	), types.AuthorizationList{newAuthorization(t, validKey, chainID, common.Address{}, 1)})
+
+	res, err = k.ApplyMessage(ctx, msg, types.NewNoOpTracer(), true)
+	require.NoError(t, err)
+	require.False(t, res.Failed(), res.VmError)
+	require.Empty(t, k.GetCode(ctx, k.GetCodeHash(ctx, valid)))
+	require.Equal(t, uint64(2), k.GetNonce(ctx, valid))
+}
@@ -29,16 +29,15 @@ import (
 // coinbase address to make it available for the COINBASE opcode, even though there is no
 // beneficiary of the coinbase transaction (since we're not mining).
 //
-// NOTE: the RANDOM opcode is currently not supported since it requires
-// RANDAO implementation. See https://github.com/evmos/ethermint/pull/1520#pullrequestreview-1200504697
-// for more information.
+// NOTE: the PREVRANDAO opcode returns the randomness derived from the hash of
+// the previous block, see GetBlockRandom.
 func (k *Keeper) NewEVM(
 	ctx sdk.Context,
 	msg core.Message,
 	cfg *statedb.EVMConfig,
 	tracer vm.EVMLogger,
 	stateDB vm.StateDB,
-) *vm.EVM {
+) (*vm.EVM, error) {
 	blockCtx := vm.BlockContext{
 		CanTransfer: evmutils.CanTransfer,
 		Transfer:    evmutils.Transfer,
@@ -49,7 +48,7 @@ func (k *Keeper) NewEVM(
 		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:     cfg.BaseFee,
-		Random:      nil, // not supported
+		Random:      k.GetBlockRandom(ctx),
 	}
 
 	txCtx := evmutils.NewEVMTxContext(msg)
@@ -59,18 +58,29 @@ func (k *Keeper) NewEVM(
 	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
 
 	signer := msg.From()
-	accessControl := types.NewRestrictedPermissionPolicy(&cfg.Params.AccessControl, signer)
 
 	// Set hooks for the EVM opcodes
 	evmHooks := types.NewDefaultOpCodesHooks()
-	evmHooks.AddCreateHooks(
-		accessControl.GetCreateHook(signer),
+	// load the dynamic ERC-20 precompile of the called address, if any, before
+	// checking the access control, which allows the calls to precompiles
+	evmHooks.AddCallHooks(
+		k.GetPrecompilesCallHook(ctx),
 	)
-	// evmHooks.AddCallHooks(
-	// 	accessControl.GetCallHook(signer),
-	// 	k.GetPrecompilesCallHook(ctx),
-	// )
-	return vm.NewEVMWithHooks(evmHooks, blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	if !k.isSystemAddress(ctx, signer) {
+		accessControl := types.NewRestrictedPermissionPolicy(&cfg.Params.AccessControl, signer)
+		evmHooks.AddCreateHooks(
+			accessControl.GetCreateHook(signer),
+		)
+		evmHooks.AddCallHooks(
+			accessControl.GetCallHook(signer),
+		)
+	}
+	evm := vm.NewEVMWithHooks(evmHooks, blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	rules := cfg.ChainConfig.Rules(blockCtx.BlockNumber, blockCtx.Random != nil)
+	if err := k.activateStaticPrecompiles(evm, rules, cfg.Params); err != nil {
+		return nil, err
+	}
+	return evm, nil
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
@@ -107,8 +117,14 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 			return common.BytesToHash(headerHash)
 
 		case ctx.BlockHeight() > h:
-			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
-			// current chain epoch. This only applies if the current height is greater than the requested height.
+			// Case 2: if the chain is not the current height we need to retrieve the hash from the block hash
+			// history of the store. The BLOCKHASH opcode only requests the 256 most recent blocks, which are all
+			// within the history window.
+			if hash := k.GetBlockHash(ctx, height); hash != (common.Hash{}) {
+				return hash
+			}
+
+			// fall back to the historical info of the staking module for the blocks recorded before the history
 			histInfo, err := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if err != nil {
 				k.Logger(ctx).Debug("error while getting historical info", "height", h, "error", err.Error())
@@ -146,18 +162,18 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 // returning.
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
-func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
+func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx) (*types.MsgEthereumTxResponse, error) {
 	var bloom *big.Int
 
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
-	txConfig := k.TxConfig(ctx, tx.Hash())
+	txConfig := k.TxConfig(ctx, msgEth.TxHash())
 
 	// get the signer according to the chain rules from the config and block height
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	msg, err := msgEth.AsMessage(signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
@@ -276,7 +292,10 @@ func (k *Keeper) ApplyMessageWithConfig(
 	)
 
 	stateDB := statedb.New(ctx, k, txConfig)
-	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
+	evm, err := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to create the EVM")
+	}
 
 	leftoverGas := msg.Gas()
 
@@ -293,6 +312,12 @@ func (k *Keeper) ApplyMessageWithConfig(
 	contractCreation := msg.To() == nil
 	isLondon := cfg.ChainConfig.IsLondon(evm.Context.BlockNumber)
 
+	// Check whether the init code size has been exceeded, eth_call and
+	// eth_estimateGas don't go through the Ante Handler.
+	if err := checkInitCodeSize(msg.Data(), contractCreation, cfg.ChainConfig.IsShanghai(evm.Context.BlockNumber)); err != nil {
+		return nil, err
+	}
+
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
@@ -309,10 +334,19 @@ func (k *Keeper) ApplyMessageWithConfig(
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
 	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
-		// The access list is prepared without any precompile because it is
-		// filled with only the recipient precompile address in the EVM'hook
-		// call.
-		stateDB.PrepareAccessList(msg.From(), msg.To(), []common.Address{}, msg.AccessList())
+		// The access list is prepared with the precompiles activated on the
+		// EVM instance, i.e. the default and active static ones.
+		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
+	}
+
+	// the EIP-7702 authorizations are applied after the increment of the
+	// sender nonce and before the execution, which can use the delegations
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		k.applyAuthorizations(ctx, stateDB, cfg.ChainConfig.ChainID, setCodeMsg.AuthorizationList())
+	}
+
+	if err := k.checkAccessControl(ctx, evm, msg, cfg.Params); err != nil {
+		return nil, err
 	}
 
 	if contractCreation {
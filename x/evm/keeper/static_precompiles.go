@@ -0,0 +1,79 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"maps"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	"github.com/green901612/cosevm/x/evm/types"
+)
+
+// WithStaticPrecompiles sets the available static precompiled contracts.
+func (k *Keeper) WithStaticPrecompiles(precompiles map[common.Address]vm.PrecompiledContract) *Keeper {
+	if k.precompiles != nil {
+		panic("available precompiles map already set")
+	}
+
+	if len(precompiles) == 0 {
+		panic("empty precompiled contract map")
+	}
+
+	k.precompiles = precompiles
+	return k
+}
+
+// IsAvailableStaticPrecompile returns true if the given address has a
+// registered static precompile implementation.
+func (k Keeper) IsAvailableStaticPrecompile(address common.Address) bool {
+	_, found := k.precompiles[address]
+	return found
+}
+
+// validateStaticPrecompiles checks that every active static precompile of the
+// given parameters has a registered implementation.
+func (k Keeper) validateStaticPrecompiles(evmParams types.Params) error {
+	for _, address := range evmParams.GetActiveStaticPrecompilesAddrs() {
+		if !k.IsAvailableStaticPrecompile(address) {
+			return errorsmod.Wrapf(
+				types.ErrInactivePrecompile,
+				"no static precompile registered for active address %s", address,
+			)
+		}
+	}
+	return nil
+}
+
+// activateStaticPrecompiles sets the precompiled contracts of the given EVM to
+// the default ones of the chain rules, extended with the static precompiles
+// listed in the active static precompiles of the EVM parameters. Static
+// precompiles that are not listed in the parameters can't be called. It
+// returns an error if an active address has no static precompile registered,
+// as the calls to it would otherwise succeed against an empty account.
+func (k *Keeper) activateStaticPrecompiles(evm *vm.EVM, rules params.Rules, evmParams types.Params) error {
+	activeAddrs := vm.DefaultActivePrecompiles(rules)
+	// copy the default precompiles, they are shared by all the EVM instances
+	precompiles := maps.Clone(vm.DefaultPrecompiles(rules))
+
+	addresses := make([]common.Address, len(activeAddrs), len(activeAddrs)+len(evmParams.ActiveStaticPrecompiles))
+	copy(addresses, activeAddrs)
+
+	for _, address := range evmParams.GetActiveStaticPrecompilesAddrs() {
+		precompile, found := k.precompiles[address]
+		if !found {
+			return errorsmod.Wrapf(
+				types.ErrInactivePrecompile,
+				"no static precompile registered for active address %s", address,
+			)
+		}
+		precompiles[address] = precompile
+		addresses = append(addresses, address)
+	}
+
+	evm.WithPrecompiles(precompiles, addresses)
+	return nil
+}
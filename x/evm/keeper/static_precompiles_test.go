@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/testutil"
+	"github.com/green901612/cosevm/x/evm/core/vm"
+	"github.com/green901612/cosevm/x/evm/keeper"
+	"github.com/green901612/cosevm/x/evm/statedb"
+	"github.com/green901612/cosevm/x/evm/types"
+)
+
+var (
+	bankPrecompile    = common.HexToAddress(types.BankPrecompileAddress)
+	stakingPrecompile = common.HexToAddress(types.StakingPrecompileAddress)
+)
+
+// accessListTracer records whether the addresses are in the access list of
+// the state when the top call frame starts.
+type accessListTracer struct {
+	types.NoOpTracer
+	addresses []common.Address
+	inList    map[common.Address]bool
+}
+
+func (t *accessListTracer) CaptureStart(env *vm.EVM, _ common.Address, _ common.Address, _ bool, _ []byte, _ uint64, _ *big.Int) {
+	t.inList = make(map[common.Address]bool, len(t.addresses))
+	for _, address := range t.addresses {
+		t.inList[address] = env.StateDB.AddressInAccessList(address)
+	}
+}
+
+func TestWithStaticPrecompiles(t *testing.T) {
+	miniApp, _ := testutil.Setup(t)
+
+	// the precompiles of the application keeper are already set
+	k := *miniApp.EvmKeeper
+	require.PanicsWithValue(t, "available precompiles map already set", func() {
+		k.WithStaticPrecompiles(map[common.Address]vm.PrecompiledContract{})
+	})
+
+	// a keeper needs at least one static precompile
+	var empty keeper.Keeper
+	require.PanicsWithValue(t, "empty precompiled contract map", func() {
+		empty.WithStaticPrecompiles(map[common.Address]vm.PrecompiledContract{})
+	})
+}
+
+func TestIsAvailableStaticPrecompile(t *testing.T) {
+	miniApp, _ := testutil.Setup(t)
+	k := miniApp.EvmKeeper
+
+	for _, address := range types.DefaultStaticPrecompiles {
+		require.True(t, k.IsAvailableStaticPrecompile(common.HexToAddress(address)), address)
+	}
+	require.False(t, k.IsAvailableStaticPrecompile(common.HexToAddress("0x0000000000000000000000000000000000001234")))
+}
+
+func TestSetParamsStaticPrecompiles(t *testing.T) {
+	testCases := []struct {
+		name        string
+		precompiles []string
+		expErr      string
+	}{
+		{"all the default precompiles", types.DefaultStaticPrecompiles, ""},
+		{"no precompile", []string{}, ""},
+		{"unknown precompile", []string{"0x0000000000000000000000000000000000001234"}, "no static precompile registered"},
+		{"duplicate precompile", []string{types.BankPrecompileAddress, types.BankPrecompileAddress}, "duplicate precompile"},
+		{"invalid precompile", []string{"0x1234"}, "invalid precompile"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			miniApp, ctx := testutil.Setup(t)
+			k := miniApp.EvmKeeper
+
+			params := k.GetParams(ctx)
+			params.ActiveStaticPrecompiles = slices.Clone(tc.precompiles)
+			err := k.SetParams(ctx, params)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.precompiles, k.GetParams(ctx).ActiveStaticPrecompiles)
+		})
+	}
+}
+
+func TestActivateStaticPrecompiles(t *testing.T) {
+	miniApp, ctx := testutil.Setup(t)
+	k := miniApp.EvmKeeper
+
+	// only the bank precompile is active
+	params := k.GetParams(ctx)
+	params.ActiveStaticPrecompiles = []string{types.BankPrecompileAddress}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	from := testutil.NewAccount(t).Address
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	msg := ethtypes.NewMessage(
+		from, &to, 0, big.NewInt(0), 100_000,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, ethtypes.AccessList{}, true,
+	)
+
+	cfg, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	require.NoError(t, err)
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm, err := k.NewEVM(ctx, msg, cfg, types.NewNoOpTracer(), stateDB)
+	require.NoError(t, err)
+	rules := cfg.ChainConfig.Rules(evm.Context.BlockNumber, evm.Context.Random != nil)
+
+	active := evm.ActivePrecompiles(rules)
+	require.Contains(t, active, bankPrecompile)
+	require.NotContains(t, active, stakingPrecompile)
+	// the default precompiles of the rules stay active
+	require.Contains(t, active, common.BytesToAddress([]byte{1}))
+
+	_, found := evm.Precompile(bankPrecompile)
+	require.True(t, found)
+	_, found = evm.Precompile(stakingPrecompile)
+	require.False(t, found)
+
+	// the inactive precompiles are left out of the access list of the message
+	tracer := &accessListTracer{addresses: []common.Address{bankPrecompile, stakingPrecompile}}
+	res, err := k.ApplyMessage(ctx, msg, tracer, false)
+	require.NoError(t, err)
+	require.False(t, res.Failed(), res.VmError)
+	require.True(t, tracer.inList[bankPrecompile])
+	require.False(t, tracer.inList[stakingPrecompile])
+
+	// an active address without a registered precompile fails the message
+	// instead of being called as an empty account
+	cfg.Params.ActiveStaticPrecompiles = []string{"0x0000000000000000000000000000000000001234"}
+	_, err = k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	require.ErrorIs(t, err, types.ErrInactivePrecompile)
+}
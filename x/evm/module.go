@@ -11,18 +11,30 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
+	"github.com/spf13/cast"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	storetypes "cosmossdk.io/store/types"
+	txsigning "cosmossdk.io/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	srvconfig "github.com/green901612/cosevm/server/config"
+	srvflags "github.com/green901612/cosevm/server/flags"
 	"github.com/green901612/cosevm/x/evm/client/cli"
 	"github.com/green901612/cosevm/x/evm/keeper"
+	modulev1 "github.com/green901612/cosevm/x/evm/module/v1"
 	"github.com/green901612/cosevm/x/evm/types"
 )
 
@@ -189,3 +201,89 @@ func (am AppModule) IsAppModule() {}
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() {}
+
+// ____________________________________________________________________________
+
+// App Wiring Setup
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(
+			ProvideModule,
+			ProvideCustomGetSigners,
+		),
+	)
+}
+
+// ProvideCustomGetSigners provides the custom signers getter of
+// MsgEthereumTx, which has no signer option defined in its proto definition.
+func ProvideCustomGetSigners() txsigning.CustomGetSigner {
+	return types.MsgEthereumTxCustomGetSigner
+}
+
+// ModuleInputs defines the dependencies of the evm module required by
+// depinject.
+type ModuleInputs struct {
+	depinject.In
+
+	Config       *modulev1.Module
+	Cdc          codec.Codec
+	StoreKey     *storetypes.KVStoreKey
+	TransientKey *storetypes.TransientStoreKey
+	AppOpts      servertypes.AppOptions `optional:"true"`
+
+	AccountKeeper   types.AccountKeeper
+	BankKeeper      types.BankKeeper
+	StakingKeeper   types.StakingKeeper
+	FeeMarketKeeper types.FeeMarketKeeper
+
+	// LegacySubspace is used solely for migration of x/params managed parameters
+	LegacySubspace paramstypes.Subspace `optional:"true"`
+}
+
+// ModuleOutputs defines the evm module outputs provided to depinject.
+type ModuleOutputs struct {
+	depinject.Out
+
+	EvmKeeper *keeper.Keeper
+	Module    appmodule.AppModule
+}
+
+// ProvideModule builds the evm keeper and app module from the injected
+// dependencies.
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	var (
+		tracer            string
+		jumpDestCacheSize = srvconfig.DefaultJumpDestCacheSize
+	)
+	if in.AppOpts != nil {
+		tracer = cast.ToString(in.AppOpts.Get(srvflags.EVMTracer))
+		if size := in.AppOpts.Get(srvflags.EVMJumpDestCacheSize); size != nil {
+			jumpDestCacheSize = cast.ToInt(size)
+		}
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc,
+		in.StoreKey,
+		in.TransientKey,
+		authority,
+		in.AccountKeeper,
+		in.BankKeeper,
+		in.StakingKeeper,
+		in.FeeMarketKeeper,
+		tracer,
+		jumpDestCacheSize,
+		in.LegacySubspace,
+	)
+
+	m := NewAppModule(k, in.AccountKeeper, in.LegacySubspace)
+
+	return ModuleOutputs{EvmKeeper: k, Module: m}
+}
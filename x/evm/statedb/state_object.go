@@ -65,6 +65,8 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	// created is set if the object was created in the current transaction
+	created bool
 }
 
 // newObject creates a state object.
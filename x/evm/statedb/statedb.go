@@ -61,6 +61,9 @@ type StateDB struct {
 	// Per-transaction access list
 	accessList *accessList
 
+	// Transient storage (EIP-1153)
+	transientStorage transientStorage
+
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
 }
@@ -74,6 +77,8 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		journal:      newJournal(),
 		accessList:   newAccessList(),
 
+		transientStorage: newTransientStorage(),
+
 		txConfig: txConfig,
 	}
 }
@@ -215,6 +220,26 @@ func (s *StateDB) GetCodeHash(addr common.Address) common.Hash {
 	return common.BytesToHash(stateObject.CodeHash())
 }
 
+// ResolveCode returns the code of account, or the one of its delegation target
+// if the account code is an EIP-7702 delegation designator. Note that GetCode
+// returns the designator itself, as required by the EXTCODE* opcodes.
+func (s *StateDB) ResolveCode(addr common.Address) []byte {
+	code := s.GetCode(addr)
+	if target, ok := vm.ParseDelegation(code); ok {
+		return s.GetCode(target)
+	}
+	return code
+}
+
+// ResolveCodeHash returns the code hash of account, or the one of its
+// delegation target if the account code is an EIP-7702 delegation designator.
+func (s *StateDB) ResolveCodeHash(addr common.Address) common.Hash {
+	if target, ok := vm.ParseDelegation(s.GetCode(addr)); ok {
+		return s.GetCodeHash(target)
+	}
+	return s.GetCodeHash(addr)
+}
+
 // GetState retrieves a value from the given account's storage trie.
 func (s *StateDB) GetState(addr common.Address, hash common.Hash) common.Hash {
 	stateObject := s.getStateObject(addr)
@@ -286,6 +311,7 @@ func (s *StateDB) createObject(addr common.Address) (newobj, prev *stateObject)
 	prev = s.getStateObject(addr)
 
 	newobj = newObject(s, addr, Account{})
+	newobj.created = true
 	if prev == nil {
 		s.journal.append(createObjectChange{account: &addr})
 	} else {
@@ -418,6 +444,45 @@ func (s *StateDB) Suicide(addr common.Address) bool {
 	return true
 }
 
+// Selfdestruct6780 marks the given account as suicided only if it was created
+// in the current transaction, as defined by EIP-6780.
+func (s *StateDB) Selfdestruct6780(addr common.Address) {
+	stateObject := s.getStateObject(addr)
+	if stateObject == nil {
+		return
+	}
+	if stateObject.created {
+		s.Suicide(addr)
+	}
+}
+
+// SetTransientState sets transient storage for a given account. It
+// adds the change to the journal so that it can be rolled back
+// to its previous value if there is a revert.
+func (s *StateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	prev := s.GetTransientState(addr, key)
+	if prev == value {
+		return
+	}
+	s.journal.append(transientStorageChange{
+		account:  &addr,
+		key:      key,
+		prevalue: prev,
+	})
+	s.setTransientState(addr, key, value)
+}
+
+// setTransientState is a lower level setter for transient storage. It
+// is called during a revert to prevent modifications to the journal.
+func (s *StateDB) setTransientState(addr common.Address, key, value common.Hash) {
+	s.transientStorage.Set(addr, key, value)
+}
+
+// GetTransientState gets transient storage for a given account.
+func (s *StateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transientStorage.Get(addr, key)
+}
+
 // PrepareAccessList handles the preparatory steps for executing a state transition with
 // regards to both EIP-2929 and EIP-2930:
 //
@@ -538,6 +538,55 @@ func (suite *StateDBTestSuite) TestRefund() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	value2 := common.BigToHash(big.NewInt(3))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	db.SetTransientState(address, key, value1)
+	rev := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+
+	db.RevertToSnapshot(rev)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+
+	// transient storage is never committed
+	suite.Require().NoError(db.Commit())
+	suite.Require().Empty(keeper.accounts)
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+}
+
+func (suite *StateDBTestSuite) TestSelfdestruct6780() {
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+
+	// an account created before the transaction is not destructed
+	db.CreateAccount(address)
+	db.SetCode(address, []byte("hello world"))
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.Selfdestruct6780(address)
+	suite.Require().False(db.HasSuicided(address))
+
+	// an account created in the same transaction is destructed
+	db.CreateAccount(address2)
+	db.SetCode(address2, []byte("hello world"))
+	db.Selfdestruct6780(address2)
+	suite.Require().True(db.HasSuicided(address2))
+
+	suite.Require().NoError(db.Commit())
+	suite.Require().Contains(keeper.accounts, address)
+	suite.Require().NotContains(keeper.accounts, address2)
+}
+
 func (suite *StateDBTestSuite) TestIterateStorage() {
 	key1 := common.BigToHash(big.NewInt(1))
 	value1 := common.BigToHash(big.NewInt(2))
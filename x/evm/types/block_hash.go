@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+const (
+	// HistoryServeWindow is the number of previous block hashes served by the
+	// EIP-2935 history contract.
+	HistoryServeWindow = 8191
+
+	// BlockHashRingSize is the number of entries of the block hash ring buffer.
+	// In addition to the served hashes, it holds the hash of the current block,
+	// which is written on BeginBlock.
+	BlockHashRingSize = HistoryServeWindow + 1
+)
+
+// IsInHistoryServeWindow returns true if the hash of the block at the given
+// height is served at the current height, i.e. if it is one of the
+// HistoryServeWindow blocks before the current one.
+func IsInHistoryServeWindow(height, current uint64) bool {
+	return height < current && current-height <= HistoryServeWindow
+}
@@ -58,7 +58,8 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *geth.ChainConfig {
 
 func DefaultChainConfig(chainID string) *ChainConfig {
 	if chainID == "" {
-		chainID = utils.DevnetChainID
+		// the devnet chain id constant doesn't include the epoch
+		chainID = utils.DevnetChainID + "-1"
 	}
 
 	eip155ChainID, err := utils.ParseChainID(chainID)
@@ -115,9 +116,9 @@ func setChainConfig(cc *ChainConfig) error {
 	if chainConfig != nil {
 		return errors.New("chainConfig already set. Cannot set again the chainConfig")
 	}
-	config := DefaultChainConfig("")
-	if cc != nil {
-		config = cc
+	config := cc
+	if config == nil {
+		config = DefaultChainConfig("")
 	}
 	if err := config.Validate(); err != nil {
 		return err
@@ -127,6 +128,68 @@ func setChainConfig(cc *ChainConfig) error {
 	return nil
 }
 
+// ValidateUpdate checks that the given chain configuration only changes the
+// forks that are not active at the given block height, and that it activates
+// them after that height. The chain ID cannot be changed.
+func (cc ChainConfig) ValidateUpdate(updated ChainConfig, height int64) error {
+	if updated.ChainId != cc.ChainId {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "chain id cannot be changed: have %d, got %d", cc.ChainId, updated.ChainId)
+	}
+
+	forks := []struct {
+		name             string
+		current, updated *sdkmath.Int
+		changed          bool
+	}{
+		{"homesteadBlock", cc.HomesteadBlock, updated.HomesteadBlock, false},
+		{"daoForkBlock", cc.DAOForkBlock, updated.DAOForkBlock, cc.DAOForkSupport != updated.DAOForkSupport},
+		{"eip150Block", cc.EIP150Block, updated.EIP150Block, cc.EIP150Hash != updated.EIP150Hash},
+		{"eip155Block", cc.EIP155Block, updated.EIP155Block, false},
+		{"eip158Block", cc.EIP158Block, updated.EIP158Block, false},
+		{"byzantiumBlock", cc.ByzantiumBlock, updated.ByzantiumBlock, false},
+		{"constantinopleBlock", cc.ConstantinopleBlock, updated.ConstantinopleBlock, false},
+		{"petersburgBlock", cc.PetersburgBlock, updated.PetersburgBlock, false},
+		{"istanbulBlock", cc.IstanbulBlock, updated.IstanbulBlock, false},
+		{"muirGlacierBlock", cc.MuirGlacierBlock, updated.MuirGlacierBlock, false},
+		{"berlinBlock", cc.BerlinBlock, updated.BerlinBlock, false},
+		{"londonBlock", cc.LondonBlock, updated.LondonBlock, false},
+		{"arrowGlacierBlock", cc.ArrowGlacierBlock, updated.ArrowGlacierBlock, false},
+		{"grayGlacierBlock", cc.GrayGlacierBlock, updated.GrayGlacierBlock, false},
+		{"mergeNetsplitBlock", cc.MergeNetsplitBlock, updated.MergeNetsplitBlock, false},
+		{"shanghaiBlock", cc.ShanghaiBlock, updated.ShanghaiBlock, false},
+		{"cancunBlock", cc.CancunBlock, updated.CancunBlock, false},
+	}
+
+	for _, fork := range forks {
+		if !fork.changed && equalBlocks(fork.current, fork.updated) {
+			continue
+		}
+		if isActiveBlock(fork.current, height) {
+			return errorsmod.Wrapf(ErrInvalidChainConfig, "%s is already active at height %d", fork.name, height)
+		}
+		if isActiveBlock(fork.updated, height) {
+			return errorsmod.Wrapf(ErrInvalidChainConfig, "%s must be after the current height %d", fork.name, height)
+		}
+	}
+
+	return nil
+}
+
+// equalBlocks returns true if both fork blocks are unset or have the same value.
+func equalBlocks(a, b *sdkmath.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// isActiveBlock returns true if the fork block is set and is not after the
+// given height.
+func isActiveBlock(block *sdkmath.Int, height int64) bool {
+	value := getBlockValue(block)
+	return value != nil && value.Cmp(big.NewInt(height)) <= 0
+}
+
 func getBlockValue(block *sdkmath.Int) *big.Int {
 	if block == nil || block.IsNegative() {
 		return nil
@@ -380,3 +380,89 @@ func TestChainConfigValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestChainConfigValidateUpdate(t *testing.T) {
+	const height = 100
+
+	testCases := []struct {
+		name     string
+		malleate func(current, updated *types.ChainConfig)
+		expError bool
+	}{
+		{
+			"unchanged",
+			func(_, _ *types.ChainConfig) {},
+			false,
+		},
+		{
+			"schedule a fork after the current height",
+			func(current, updated *types.ChainConfig) {
+				current.CancunBlock = nil
+				updated.CancunBlock = newIntPtr(height + 1)
+			},
+			false,
+		},
+		{
+			"reschedule a fork not active yet",
+			func(current, updated *types.ChainConfig) {
+				current.CancunBlock = newIntPtr(height + 1)
+				updated.CancunBlock = newIntPtr(height + 10)
+			},
+			false,
+		},
+		{
+			"unschedule a fork not active yet",
+			func(current, updated *types.ChainConfig) {
+				current.CancunBlock = newIntPtr(height + 1)
+				updated.CancunBlock = nil
+			},
+			false,
+		},
+		{
+			"schedule a fork at the current height",
+			func(current, updated *types.ChainConfig) {
+				current.CancunBlock = nil
+				updated.CancunBlock = newIntPtr(height)
+			},
+			true,
+		},
+		{
+			"change an active fork",
+			func(_, updated *types.ChainConfig) { updated.CancunBlock = newIntPtr(height + 1) },
+			true,
+		},
+		{
+			"unschedule an active fork",
+			func(current, updated *types.ChainConfig) {
+				current.CancunBlock = newIntPtr(height)
+				updated.CancunBlock = nil
+			},
+			true,
+		},
+		{
+			"change the DAO fork support of an active fork",
+			func(_, updated *types.ChainConfig) { updated.DAOForkSupport = !updated.DAOForkSupport },
+			true,
+		},
+		{
+			"change the chain id",
+			func(_, updated *types.ChainConfig) { updated.ChainId++ },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			current := types.DefaultChainConfig("torram_2931-1")
+			updated := types.DefaultChainConfig("torram_2931-1")
+			tc.malleate(current, updated)
+
+			err := current.ValidateUpdate(*updated, height)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -25,7 +25,8 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName      = "ethermint/MsgUpdateParams"
+	updateChainConfigName = "ethermint/MsgUpdateChainConfig"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,10 +45,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgUpdateChainConfig{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
 		(*TxData)(nil),
+		&SetCodeTx{},
 		&DynamicFeeTx{},
 		&AccessListTx{},
 		&LegacyTx{},
@@ -91,4 +94,5 @@ func UnpackTxData(anyTxData *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateChainConfig{}, updateChainConfigName, nil)
 }
@@ -32,6 +32,7 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrInvalidSetCodeTx
 )
 
 var (
@@ -88,6 +89,9 @@ var (
 
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrInvalidSetCodeTx returns an error if an EIP-7702 set code transaction is invalid
+	ErrInvalidSetCodeTx = errorsmod.Register(ModuleName, codeErrInvalidSetCodeTx, "invalid set code transaction")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -71,6 +71,12 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the precompiled
 	// contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// opcode_gas_overrides defines the constant gas of the opcodes that
+	// overrides the one of the EVM instruction set. Only the constant gas is
+	// overridden: the opcodes with a dynamic gas, such as SLOAD, SSTORE and
+	// LOG0-LOG4, still charge it on top of the override, so it can raise their
+	// cost but not lower it below their dynamic gas.
+	OpcodeGasOverrides []OpcodeGasOverride `protobuf:"bytes,11,rep,name=opcode_gas_overrides,json=opcodeGasOverrides,proto3" json:"opcode_gas_overrides"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -141,6 +147,70 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetOpcodeGasOverrides() []OpcodeGasOverride {
+	if m != nil {
+		return m.OpcodeGasOverrides
+	}
+	return nil
+}
+
+// OpcodeGasOverride defines the constant gas of an opcode that overrides the
+// one of the EVM instruction set
+type OpcodeGasOverride struct {
+	// opcode is the name of the opcode, e.g. SLOAD
+	Opcode string `protobuf:"bytes,1,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	// constant_gas is the constant gas charged by the opcode, in addition to its
+	// dynamic gas, between 1 and 100000
+	ConstantGas uint64 `protobuf:"varint,2,opt,name=constant_gas,json=constantGas,proto3" json:"constant_gas,omitempty"`
+}
+
+func (m *OpcodeGasOverride) Reset()         { *m = OpcodeGasOverride{} }
+func (m *OpcodeGasOverride) String() string { return proto.CompactTextString(m) }
+func (*OpcodeGasOverride) ProtoMessage()    {}
+func (*OpcodeGasOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *OpcodeGasOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpcodeGasOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpcodeGasOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpcodeGasOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpcodeGasOverride.Merge(m, src)
+}
+func (m *OpcodeGasOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpcodeGasOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpcodeGasOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpcodeGasOverride proto.InternalMessageInfo
+
+func (m *OpcodeGasOverride) GetOpcode() string {
+	if m != nil {
+		return m.Opcode
+	}
+	return ""
+}
+
+func (m *OpcodeGasOverride) GetConstantGas() uint64 {
+	if m != nil {
+		return m.ConstantGas
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -154,7 +224,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +282,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +379,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +455,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +512,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +588,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +703,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +744,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{8}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +804,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{9}
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -843,6 +913,7 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 func init() {
 	proto.RegisterEnum("ethermint.evm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*OpcodeGasOverride)(nil), "ethermint.evm.v1.OpcodeGasOverride")
 	proto.RegisterType((*AccessControl)(nil), "ethermint.evm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "ethermint.evm.v1.AccessControlType")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
@@ -857,128 +928,132 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1932 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcd, 0x6e, 0x23, 0xc7,
-	0x11, 0x16, 0xa5, 0x91, 0x34, 0x6c, 0x52, 0xe4, 0xa8, 0xf5, 0xb3, 0x5c, 0xae, 0xa3, 0x51, 0x26,
-	0x41, 0xa0, 0x2c, 0x1c, 0x69, 0x57, 0x6b, 0x25, 0xc2, 0x3a, 0x7f, 0xa2, 0x96, 0x4e, 0xc4, 0x68,
-	0xd7, 0x42, 0x53, 0x8e, 0xe1, 0x20, 0xc1, 0xa0, 0x39, 0xd3, 0x26, 0xc7, 0x9a, 0x99, 0x26, 0xa6,
-	0x9b, 0x5c, 0x32, 0x4f, 0x60, 0xec, 0xc9, 0x79, 0x80, 0x0d, 0x0c, 0xe4, 0x92, 0xa3, 0x1f, 0x21,
-	0x47, 0xc3, 0x27, 0x1f, 0x83, 0x00, 0x19, 0x04, 0xdc, 0x83, 0x01, 0x1d, 0xf5, 0x04, 0x41, 0xff,
-	0xf0, 0x57, 0x5a, 0x45, 0xb9, 0x50, 0x5d, 0xd5, 0x55, 0xdf, 0x57, 0x55, 0x5d, 0x3d, 0xdd, 0x2d,
-	0x50, 0x26, 0xbc, 0x45, 0x92, 0x28, 0x88, 0xf9, 0x1e, 0xe9, 0x46, 0x7b, 0xdd, 0xc7, 0xe2, 0xcf,
-	0x6e, 0x3b, 0xa1, 0x9c, 0x42, 0x6b, 0x34, 0xb7, 0x2b, 0x94, 0xdd, 0xc7, 0xe5, 0x55, 0x1c, 0x05,
-	0x31, 0xdd, 0x93, 0xbf, 0xca, 0xa8, 0xbc, 0xde, 0xa4, 0x4d, 0x2a, 0x87, 0x7b, 0x62, 0xa4, 0xb4,
-	0xce, 0x5f, 0x17, 0xc0, 0xd2, 0x19, 0x4e, 0x70, 0xc4, 0xe0, 0x11, 0x00, 0xa4, 0xc7, 0x13, 0xec,
-	0x92, 0xa0, 0xcd, 0x4a, 0xc6, 0xf6, 0xc2, 0x4e, 0xb6, 0xe2, 0x0c, 0x52, 0x3b, 0x5b, 0x15, 0xda,
-	0xea, 0xc9, 0x19, 0xbb, 0x4a, 0xed, 0xd5, 0x3e, 0x8e, 0xc2, 0xa7, 0xce, 0xd8, 0xd0, 0x41, 0x59,
-	0x29, 0x54, 0x83, 0x36, 0x83, 0xfb, 0x60, 0x03, 0x87, 0x21, 0x7d, 0xe9, 0x76, 0x62, 0x01, 0x4f,
-	0x3c, 0x4e, 0x7c, 0x97, 0xf7, 0x58, 0x69, 0x69, 0x3b, 0xb3, 0x63, 0xa2, 0x35, 0x39, 0xf9, 0xd1,
-	0x78, 0xee, 0xbc, 0x27, 0x7c, 0xf2, 0xa4, 0x1b, 0xb9, 0x5e, 0x0b, 0xc7, 0x31, 0x09, 0x59, 0xc9,
-	0x94, 0xc4, 0xc5, 0x41, 0x6a, 0xe7, 0xaa, 0xbf, 0x7f, 0x7e, 0xac, 0xd5, 0x28, 0x47, 0xba, 0xd1,
-	0x50, 0x80, 0x7f, 0x02, 0x05, 0xec, 0x79, 0x84, 0x31, 0xd7, 0xa3, 0x31, 0x4f, 0x68, 0x58, 0xca,
-	0x6e, 0x67, 0x76, 0x72, 0xfb, 0xf6, 0xee, 0x6c, 0x25, 0x76, 0x8f, 0xa4, 0xdd, 0xb1, 0x32, 0xab,
-	0x6c, 0x7c, 0x9d, 0xda, 0x73, 0x83, 0xd4, 0x5e, 0x99, 0x52, 0xa3, 0x15, 0x3c, 0x29, 0xc2, 0xa7,
-	0xe0, 0x3e, 0xf6, 0x78, 0xd0, 0x25, 0x2e, 0xe3, 0x98, 0x07, 0x9e, 0xdb, 0x4e, 0x88, 0x47, 0xa3,
-	0x76, 0x10, 0x12, 0x56, 0x02, 0x22, 0x3e, 0x74, 0x4f, 0x19, 0xd4, 0xe5, 0xfc, 0xd9, 0x78, 0xfa,
-	0xe9, 0xbd, 0x57, 0xdf, 0x7d, 0xf5, 0x10, 0x92, 0x6e, 0x44, 0xd9, 0x5e, 0x4f, 0x2e, 0x95, 0x2a,
-	0x6f, 0xcd, 0x30, 0x33, 0xd6, 0x7c, 0xcd, 0x30, 0xe7, 0xad, 0x85, 0x9a, 0x61, 0x2e, 0x58, 0x46,
-	0xcd, 0x30, 0x17, 0xad, 0xa5, 0x9a, 0x61, 0x2e, 0x5b, 0x26, 0xca, 0x8a, 0x1a, 0xf8, 0x24, 0xa6,
-	0x11, 0xca, 0x7b, 0x2d, 0x1c, 0xc4, 0x22, 0xb3, 0x4f, 0x83, 0xa6, 0xf3, 0x97, 0x0c, 0x98, 0x0e,
-	0x16, 0x1e, 0x81, 0x25, 0x2f, 0x21, 0x98, 0x93, 0x52, 0x46, 0x26, 0xfd, 0x83, 0xff, 0x91, 0xf4,
-	0x79, 0xbf, 0x4d, 0x2a, 0x86, 0x48, 0x1c, 0x69, 0x47, 0xf8, 0x0b, 0x60, 0x78, 0x38, 0x0c, 0x4b,
-	0xf3, 0xff, 0x2f, 0x80, 0x74, 0x73, 0xfe, 0x9d, 0x01, 0xab, 0xd7, 0x2c, 0xa0, 0x07, 0x72, 0x7a,
-	0x51, 0x78, 0xbf, 0xad, 0x82, 0x2b, 0xec, 0xbf, 0xf3, 0x36, 0x6c, 0x09, 0xfa, 0xc3, 0x41, 0x6a,
-	0x83, 0xb1, 0x7c, 0x95, 0xda, 0x50, 0xf5, 0xd7, 0x04, 0x90, 0x83, 0x00, 0x1e, 0x59, 0x40, 0x0f,
-	0xac, 0x4d, 0xaf, 0xbc, 0x1b, 0x06, 0x8c, 0x97, 0xe6, 0x65, 0xd3, 0x3c, 0x19, 0xa4, 0xf6, 0x74,
-	0x60, 0xa7, 0x01, 0xe3, 0x57, 0xa9, 0x5d, 0x9e, 0x42, 0x9d, 0xf4, 0x74, 0xd0, 0x2a, 0x9e, 0x75,
-	0x70, 0xbe, 0x29, 0x82, 0xdc, 0xb1, 0x58, 0x84, 0x63, 0xb9, 0x06, 0xf0, 0x8f, 0xa0, 0xd8, 0xa2,
-	0x11, 0x61, 0x9c, 0x60, 0xdf, 0x6d, 0x84, 0xd4, 0xbb, 0x90, 0xd9, 0x65, 0x2b, 0x4f, 0xfe, 0x95,
-	0xda, 0x1b, 0x1e, 0x65, 0x11, 0x65, 0xcc, 0xbf, 0xd8, 0x0d, 0xe8, 0x5e, 0x84, 0x79, 0x6b, 0xf7,
-	0x24, 0x16, 0xa4, 0x9b, 0x8a, 0x74, 0xc6, 0xd3, 0x41, 0x85, 0x91, 0xa6, 0x22, 0x14, 0xb0, 0x05,
-	0x0a, 0x3e, 0xa6, 0xee, 0xa7, 0x34, 0xb9, 0xd0, 0xe0, 0xf3, 0x12, 0xbc, 0xf2, 0x56, 0xf0, 0x41,
-	0x6a, 0xe7, 0x9f, 0x1d, 0x7d, 0xf8, 0x01, 0x4d, 0x2e, 0x24, 0xc4, 0x55, 0x6a, 0x6f, 0x28, 0xb2,
-	0x69, 0x20, 0x07, 0xe5, 0x7d, 0x4c, 0x47, 0x66, 0xf0, 0x63, 0x60, 0x8d, 0x0c, 0x58, 0xa7, 0xdd,
-	0xa6, 0x09, 0x2f, 0x2d, 0x88, 0x9d, 0x59, 0xf9, 0xc9, 0x20, 0xb5, 0x0b, 0x1a, 0xb2, 0xae, 0x66,
-	0xae, 0x52, 0xfb, 0xde, 0x0c, 0xa8, 0xf6, 0x71, 0x50, 0x41, 0xc3, 0x6a, 0x53, 0xd8, 0x00, 0x79,
-	0x12, 0xb4, 0x1f, 0x1f, 0x3c, 0xd2, 0x09, 0x18, 0x32, 0x81, 0x5f, 0xdd, 0x96, 0x40, 0xae, 0x7a,
-	0x72, 0xf6, 0xf8, 0xe0, 0xd1, 0x30, 0xfe, 0x35, 0xfd, 0x5d, 0x99, 0x40, 0x71, 0x50, 0x4e, 0x89,
-	0x2a, 0xf8, 0x13, 0xa0, 0x45, 0xb7, 0x85, 0x59, 0xab, 0xb4, 0x28, 0x29, 0x76, 0x44, 0x03, 0x29,
-	0xa4, 0xdf, 0x62, 0xd6, 0x1a, 0x57, 0xbd, 0xd1, 0xff, 0x33, 0x8e, 0x79, 0xd0, 0x89, 0x86, 0x58,
-	0x40, 0x39, 0x0b, 0xab, 0x51, 0xb8, 0x07, 0x3a, 0xdc, 0xa5, 0xbb, 0x86, 0x7b, 0x70, 0x53, 0xb8,
-	0x07, 0xd3, 0xe1, 0x2a, 0x9b, 0x11, 0xc7, 0xa1, 0xe6, 0x58, 0xbe, 0x2b, 0xc7, 0xe1, 0x4d, 0x1c,
-	0x87, 0xd3, 0x1c, 0xca, 0x46, 0xf4, 0xe5, 0x4c, 0x9e, 0x25, 0xf3, 0xce, 0x7d, 0x79, 0xad, 0x42,
-	0x85, 0x91, 0x46, 0xa1, 0x5f, 0x80, 0x75, 0x8f, 0xc6, 0x8c, 0x0b, 0x5d, 0x4c, 0xdb, 0x21, 0xd1,
-	0x14, 0x59, 0x49, 0x71, 0x78, 0x1b, 0xc5, 0x03, 0x45, 0x71, 0x93, 0xbb, 0x83, 0xd6, 0xa6, 0xd5,
-	0x8a, 0xcc, 0x05, 0x56, 0x9b, 0x70, 0x92, 0xb0, 0x46, 0x27, 0x69, 0x6a, 0x22, 0x20, 0x89, 0xde,
-	0xbb, 0x8d, 0x48, 0x77, 0xe8, 0xac, 0xab, 0x83, 0x8a, 0x63, 0x95, 0x22, 0xf8, 0x04, 0x14, 0x02,
-	0xc1, 0xda, 0xe8, 0x84, 0x1a, 0x3e, 0x27, 0xe1, 0xf7, 0x6f, 0x83, 0xd7, 0xbb, 0x6a, 0xda, 0xd1,
-	0x41, 0x2b, 0x43, 0x85, 0x82, 0xf6, 0x01, 0x8c, 0x3a, 0x41, 0xe2, 0x36, 0x43, 0xec, 0x05, 0x24,
-	0xd1, 0xf0, 0x79, 0x09, 0xff, 0xd3, 0xdb, 0xe0, 0xef, 0x2b, 0xf8, 0xeb, 0xce, 0x0e, 0xb2, 0x84,
-	0xf2, 0x37, 0x4a, 0xa7, 0x58, 0xea, 0x20, 0xdf, 0x20, 0x49, 0x18, 0xc4, 0x1a, 0x7f, 0x45, 0xe2,
-	0x3f, 0xba, 0x0d, 0x5f, 0x77, 0xd0, 0xa4, 0x9b, 0x83, 0x72, 0x4a, 0x1c, 0x81, 0x86, 0x34, 0xf6,
-	0xe9, 0x10, 0x74, 0xf5, 0xce, 0xa0, 0x93, 0x6e, 0x0e, 0xca, 0x29, 0x51, 0x81, 0x36, 0xc1, 0x1a,
-	0x4e, 0x12, 0xfa, 0x72, 0xa6, 0x20, 0x50, 0x62, 0xff, 0xec, 0x36, 0xec, 0xe1, 0x77, 0xfa, 0xba,
-	0xb7, 0xf8, 0x4e, 0x0b, 0xed, 0x54, 0x49, 0x7c, 0x00, 0x9b, 0x09, 0xee, 0xcf, 0xf0, 0xac, 0xdf,
-	0xb9, 0xf0, 0xd7, 0x9d, 0x1d, 0x64, 0x09, 0xe5, 0x14, 0xcb, 0x67, 0x60, 0x3d, 0x22, 0x49, 0x93,
-	0xb8, 0x31, 0xe1, 0xac, 0x1d, 0x06, 0x5c, 0xf3, 0x6c, 0xdc, 0x79, 0x1f, 0xdc, 0xe4, 0xee, 0x20,
-	0x28, 0xd5, 0x2f, 0xb4, 0x76, 0xd4, 0xa5, 0xac, 0x85, 0xe3, 0x66, 0x0b, 0x07, 0x9a, 0x65, 0xf3,
-	0xce, 0x5d, 0x3a, 0xed, 0xe8, 0xa0, 0x95, 0xa1, 0x62, 0xb4, 0xd4, 0x1e, 0x8e, 0xbd, 0xce, 0x70,
-	0xa9, 0xef, 0xdd, 0x79, 0xa9, 0x27, 0xdd, 0x1c, 0x94, 0x53, 0xa2, 0x02, 0xbd, 0x0f, 0x4c, 0x75,
-	0x5b, 0x09, 0xfc, 0x52, 0x69, 0x3b, 0xb3, 0x63, 0xa0, 0x65, 0x29, 0x9f, 0xf8, 0x70, 0x1d, 0x2c,
-	0xca, 0xfb, 0x4c, 0xe9, 0xbe, 0x20, 0x42, 0x4a, 0x80, 0x65, 0x60, 0xfa, 0xc4, 0x0b, 0x22, 0x1c,
-	0xb2, 0x52, 0x59, 0x3a, 0x8c, 0xe4, 0x9a, 0x61, 0x16, 0xac, 0x62, 0xcd, 0x30, 0x8b, 0x96, 0x55,
-	0x33, 0x4c, 0xcb, 0x5a, 0xad, 0x19, 0xe6, 0x9a, 0xb5, 0x8e, 0x56, 0xfa, 0x34, 0xa4, 0x6e, 0xf7,
-	0x89, 0x8a, 0x00, 0xe5, 0xc8, 0x4b, 0xcc, 0xf4, 0x57, 0x0b, 0x15, 0x3c, 0xcc, 0x71, 0xd8, 0x67,
-	0xba, 0xaa, 0xc8, 0x52, 0xb5, 0x9e, 0x38, 0x03, 0xf7, 0xc0, 0xa2, 0xb8, 0xa5, 0x11, 0x68, 0x81,
-	0x85, 0x0b, 0xd2, 0x57, 0x27, 0x37, 0x12, 0x43, 0x11, 0x62, 0x17, 0x87, 0x1d, 0xa2, 0x0e, 0x5c,
-	0xa4, 0x04, 0xe7, 0x0c, 0x14, 0xcf, 0x13, 0x1c, 0x33, 0x71, 0xc3, 0xa3, 0xf1, 0x29, 0x6d, 0x32,
-	0x08, 0x81, 0x21, 0x0f, 0x1d, 0xe5, 0x2b, 0xc7, 0xf0, 0xc7, 0xc0, 0x08, 0x69, 0x93, 0xc9, 0xab,
-	0x47, 0x6e, 0x7f, 0xe3, 0xfa, 0x3d, 0xe7, 0x94, 0x36, 0x91, 0x34, 0x71, 0xbe, 0x99, 0x07, 0x0b,
-	0xa7, 0xb4, 0x09, 0x4b, 0x60, 0x19, 0xfb, 0x7e, 0x42, 0x18, 0xd3, 0x48, 0x43, 0x11, 0x6e, 0x82,
-	0x25, 0x4e, 0xdb, 0x81, 0xa7, 0xe0, 0xb2, 0x48, 0x4b, 0x82, 0xd8, 0xc7, 0x1c, 0xcb, 0x53, 0x3a,
-	0x8f, 0xe4, 0x58, 0x5c, 0x98, 0x65, 0x66, 0x6e, 0xdc, 0x89, 0x1a, 0x24, 0x91, 0x87, 0xad, 0x51,
-	0x29, 0x5e, 0xa6, 0x76, 0x4e, 0xea, 0x5f, 0x48, 0x35, 0x9a, 0x14, 0xe0, 0xbb, 0x60, 0x99, 0xf7,
-	0x26, 0x0f, 0xce, 0xb5, 0xcb, 0xd4, 0x2e, 0xf2, 0x71, 0x9a, 0xe2, 0x5c, 0x44, 0x4b, 0xbc, 0x27,
-	0xcf, 0xc7, 0x3d, 0x60, 0xf2, 0x9e, 0x1b, 0xc4, 0x3e, 0xe9, 0xc9, 0xb3, 0xd1, 0xa8, 0xac, 0x5f,
-	0xa6, 0xb6, 0x35, 0x61, 0x7e, 0x22, 0xe6, 0xd0, 0x32, 0xef, 0xc9, 0x01, 0x7c, 0x17, 0x00, 0x15,
-	0x92, 0x64, 0x50, 0x47, 0xdd, 0xca, 0x65, 0x6a, 0x67, 0xa5, 0x56, 0x62, 0x8f, 0x87, 0xd0, 0x01,
-	0x8b, 0x0a, 0xdb, 0x94, 0xd8, 0xf9, 0xcb, 0xd4, 0x36, 0x43, 0xda, 0x54, 0x98, 0x6a, 0x4a, 0x94,
-	0x2a, 0x21, 0x11, 0xed, 0x12, 0x5f, 0x9e, 0x37, 0x26, 0x1a, 0x8a, 0xce, 0x17, 0xf3, 0xc0, 0x3c,
-	0xef, 0x21, 0xc2, 0x3a, 0x21, 0x87, 0x1f, 0x00, 0x4b, 0xde, 0xe6, 0xb0, 0xc7, 0xdd, 0xa9, 0xd2,
-	0x56, 0x1e, 0x8c, 0x4f, 0x87, 0x59, 0x0b, 0x07, 0x15, 0x87, 0xaa, 0x23, 0x5d, 0xff, 0x75, 0xb0,
-	0xd8, 0x08, 0x29, 0x8d, 0x64, 0x27, 0xe4, 0x91, 0x12, 0xe0, 0xc7, 0xb2, 0x6a, 0x72, 0x95, 0x17,
-	0xe4, 0x4d, 0xf9, 0xfb, 0xd7, 0x57, 0x79, 0xa6, 0x55, 0x2a, 0x0f, 0xc4, 0x3d, 0xf9, 0x2a, 0xb5,
-	0x0b, 0x8a, 0x5b, 0xfb, 0x3b, 0x7f, 0xff, 0xee, 0xab, 0x87, 0x19, 0x51, 0x60, 0xd9, 0x4f, 0x16,
-	0x58, 0x48, 0x08, 0x97, 0x2b, 0x97, 0x47, 0x62, 0x28, 0xf6, 0x45, 0x42, 0xba, 0x24, 0xe1, 0xc4,
-	0x97, 0x2b, 0x64, 0xa2, 0x91, 0x2c, 0x36, 0x59, 0x13, 0x33, 0xb7, 0xc3, 0x88, 0xaf, 0x96, 0x03,
-	0x2d, 0x37, 0x31, 0xfb, 0x88, 0x11, 0xff, 0xa9, 0xf1, 0xf9, 0x97, 0xf6, 0x9c, 0x83, 0x41, 0x4e,
-	0x5f, 0xa2, 0x3b, 0xed, 0x90, 0xdc, 0xd2, 0x66, 0xfb, 0x20, 0xcf, 0x38, 0x4d, 0x70, 0x93, 0xb8,
-	0x17, 0xa4, 0xaf, 0x9b, 0x4d, 0xb5, 0x8e, 0xd6, 0xff, 0x8e, 0xf4, 0x19, 0x9a, 0x14, 0x34, 0xc5,
-	0x97, 0x06, 0xc8, 0x9d, 0x27, 0xd8, 0x23, 0xfa, 0x4a, 0x2c, 0x1a, 0x56, 0x88, 0x89, 0xa6, 0xd0,
-	0x92, 0xe0, 0xe6, 0x41, 0x44, 0x68, 0x87, 0xeb, 0x4d, 0x35, 0x14, 0x85, 0x47, 0x42, 0x48, 0x8f,
-	0x78, 0xb2, 0x96, 0x06, 0xd2, 0x12, 0x3c, 0x00, 0x2b, 0x7e, 0xc0, 0x70, 0x23, 0x94, 0xaf, 0x2d,
-	0xef, 0x42, 0xa5, 0x5f, 0xb1, 0x2e, 0x53, 0x3b, 0xaf, 0x27, 0xea, 0x42, 0x8f, 0xa6, 0x24, 0xf8,
-	0x3e, 0x28, 0x8e, 0xdd, 0x64, 0xb4, 0xea, 0x91, 0x59, 0x81, 0x97, 0xa9, 0x5d, 0x18, 0x99, 0xca,
-	0x19, 0x34, 0x23, 0xab, 0x6f, 0x53, 0xa3, 0xd3, 0x94, 0x1d, 0x68, 0x22, 0x25, 0x08, 0x6d, 0x18,
-	0x44, 0x01, 0x97, 0x1d, 0xb7, 0x88, 0x94, 0x00, 0xdf, 0x07, 0x59, 0xda, 0x25, 0x49, 0x12, 0xf8,
-	0xf2, 0xf1, 0x27, 0xda, 0xe0, 0x7b, 0xd7, 0xdb, 0x60, 0xe2, 0xb9, 0x80, 0xc6, 0xf6, 0x22, 0x39,
-	0x12, 0xcb, 0x20, 0x23, 0x12, 0xd1, 0xa4, 0x2f, 0x2f, 0x1d, 0x3a, 0x39, 0x35, 0xf1, 0x5c, 0xea,
-	0xd1, 0x94, 0x04, 0x2b, 0x00, 0x6a, 0xb7, 0x84, 0xf0, 0x4e, 0x12, 0xbb, 0xf2, 0x23, 0x90, 0x97,
-	0xbe, 0x72, 0x2b, 0xaa, 0x59, 0x24, 0x27, 0x9f, 0x61, 0x8e, 0xd1, 0x35, 0x0d, 0xfc, 0x25, 0x80,
-	0x6a, 0x4d, 0xdc, 0xcf, 0x18, 0x1d, 0x3e, 0x27, 0xf5, 0xad, 0x41, 0xf2, 0xab, 0x59, 0x1d, 0xb3,
-	0xa5, 0xa4, 0x1a, 0xa3, 0x3a, 0x8b, 0x9a, 0x61, 0x1a, 0xd6, 0xa2, 0x7e, 0x9d, 0x0e, 0xeb, 0xa7,
-	0xb3, 0x40, 0x6b, 0x43, 0x79, 0x22, 0xbc, 0x87, 0xff, 0xc8, 0x80, 0x89, 0xb7, 0x1c, 0xfc, 0x39,
-	0x28, 0x1f, 0x1d, 0x1f, 0x57, 0xeb, 0x75, 0xf7, 0xfc, 0x93, 0xb3, 0xaa, 0x7b, 0x56, 0x45, 0xcf,
-	0x4f, 0xea, 0xf5, 0x93, 0x0f, 0x5f, 0x9c, 0x56, 0xeb, 0x75, 0x6b, 0xae, 0xfc, 0xce, 0xab, 0xd7,
-	0xdb, 0xa5, 0xb1, 0xfd, 0x99, 0xa8, 0x27, 0x63, 0x01, 0x8d, 0x43, 0xd1, 0xa9, 0xef, 0x81, 0xcd,
-	0x49, 0x6f, 0x54, 0xad, 0x9f, 0xa3, 0x93, 0xe3, 0xf3, 0xea, 0x33, 0x2b, 0x53, 0x2e, 0xbd, 0x7a,
-	0xbd, 0xbd, 0x3e, 0xf6, 0x44, 0x84, 0xf1, 0x24, 0xf0, 0xc4, 0x4e, 0x39, 0x04, 0xa5, 0x9b, 0x39,
-	0xab, 0xcf, 0xac, 0xf9, 0x72, 0xf9, 0xd5, 0xeb, 0xed, 0xcd, 0x9b, 0x18, 0x89, 0x5f, 0x36, 0x3e,
-	0xff, 0xdb, 0xd6, 0x5c, 0xe5, 0xd7, 0x5f, 0x0f, 0xb6, 0x32, 0xdf, 0x0e, 0xb6, 0x32, 0xff, 0x19,
-	0x6c, 0x65, 0xbe, 0x78, 0xb3, 0x35, 0xf7, 0xed, 0x9b, 0xad, 0xb9, 0x7f, 0xbe, 0xd9, 0x9a, 0xfb,
-	0xc3, 0x8f, 0x9a, 0x01, 0x6f, 0x75, 0x1a, 0xbb, 0x1e, 0x8d, 0xf6, 0xd4, 0xe3, 0x5e, 0xfd, 0x76,
-	0xf7, 0x1f, 0xe9, 0x67, 0xbe, 0x78, 0xab, 0xb2, 0xc6, 0x92, 0xfc, 0xb7, 0xca, 0x93, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x1d, 0xfd, 0x0a, 0x4c, 0xaf, 0x11, 0x00, 0x00,
+	// 2000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcd, 0x6f, 0xe3, 0xc6,
+	0x15, 0xb7, 0x6c, 0xda, 0xa6, 0x47, 0xb2, 0x44, 0x8f, 0xed, 0x5d, 0xae, 0x36, 0x35, 0x1d, 0xb6,
+	0x28, 0xdc, 0x45, 0x6a, 0xef, 0x7a, 0xe3, 0x76, 0xb1, 0xe9, 0x97, 0xe5, 0x55, 0xb6, 0x56, 0xf7,
+	0xc3, 0x18, 0x39, 0x0d, 0xd2, 0x0f, 0x10, 0x23, 0x72, 0x22, 0x31, 0x26, 0x39, 0x02, 0x67, 0xa4,
+	0x95, 0xfa, 0x17, 0x04, 0x7b, 0x4a, 0xff, 0x80, 0x05, 0x02, 0xf4, 0xd2, 0x63, 0xfe, 0x84, 0x1e,
+	0x83, 0x9c, 0x72, 0x2c, 0x0a, 0x94, 0x28, 0xbc, 0x87, 0x00, 0x3e, 0xfa, 0xda, 0x4b, 0x31, 0x1f,
+	0xfa, 0x76, 0x0c, 0xf7, 0x22, 0xcd, 0x7b, 0xf3, 0xde, 0xef, 0xf7, 0xe6, 0xcd, 0x23, 0xe7, 0x0d,
+	0x41, 0x99, 0xf0, 0x16, 0x49, 0xe3, 0x30, 0xe1, 0x7b, 0xa4, 0x1b, 0xef, 0x75, 0x1f, 0x88, 0xbf,
+	0xdd, 0x76, 0x4a, 0x39, 0x85, 0xd6, 0x70, 0x6e, 0x57, 0x28, 0xbb, 0x0f, 0xca, 0x6b, 0x38, 0x0e,
+	0x13, 0xba, 0x27, 0x7f, 0x95, 0x51, 0x79, 0xa3, 0x49, 0x9b, 0x54, 0x0e, 0xf7, 0xc4, 0x48, 0x69,
+	0xdd, 0xff, 0x2e, 0x80, 0xa5, 0x13, 0x9c, 0xe2, 0x98, 0xc1, 0x43, 0x00, 0x48, 0x8f, 0xa7, 0xd8,
+	0x23, 0x61, 0x9b, 0xd9, 0xc6, 0xf6, 0xc2, 0xce, 0x4a, 0xc5, 0x3d, 0xcf, 0x9c, 0x95, 0xaa, 0xd0,
+	0x56, 0x8f, 0x4f, 0xd8, 0x65, 0xe6, 0xac, 0xf5, 0x71, 0x1c, 0x3d, 0x76, 0x47, 0x86, 0x2e, 0x5a,
+	0x91, 0x42, 0x35, 0x6c, 0x33, 0xb8, 0x0f, 0x36, 0x71, 0x14, 0xd1, 0x57, 0x5e, 0x27, 0x11, 0xf0,
+	0xc4, 0xe7, 0x24, 0xf0, 0x78, 0x8f, 0xd9, 0x4b, 0xdb, 0xb9, 0x1d, 0x13, 0xad, 0xcb, 0xc9, 0x8f,
+	0x46, 0x73, 0xa7, 0x3d, 0xe1, 0x53, 0x20, 0xdd, 0xd8, 0xf3, 0x5b, 0x38, 0x49, 0x48, 0xc4, 0x6c,
+	0x53, 0x12, 0x97, 0xce, 0x33, 0x27, 0x5f, 0xfd, 0xfd, 0xf3, 0x23, 0xad, 0x46, 0x79, 0xd2, 0x8d,
+	0x07, 0x02, 0xfc, 0x33, 0x28, 0x62, 0xdf, 0x27, 0x8c, 0x79, 0x3e, 0x4d, 0x78, 0x4a, 0x23, 0x7b,
+	0x65, 0x3b, 0xb7, 0x93, 0xdf, 0x77, 0x76, 0xa7, 0x33, 0xb1, 0x7b, 0x28, 0xed, 0x8e, 0x94, 0x59,
+	0x65, 0xf3, 0xeb, 0xcc, 0x99, 0x3b, 0xcf, 0x9c, 0xd5, 0x09, 0x35, 0x5a, 0xc5, 0xe3, 0x22, 0x7c,
+	0x0c, 0xee, 0x60, 0x9f, 0x87, 0x5d, 0xe2, 0x31, 0x8e, 0x79, 0xe8, 0x7b, 0xed, 0x94, 0xf8, 0x34,
+	0x6e, 0x87, 0x11, 0x61, 0x36, 0x10, 0xf1, 0xa1, 0xdb, 0xca, 0xa0, 0x2e, 0xe7, 0x4f, 0x46, 0xd3,
+	0xf0, 0x8f, 0x60, 0x83, 0xb6, 0x7d, 0x1a, 0x10, 0xaf, 0x89, 0x99, 0x47, 0xbb, 0x24, 0x4d, 0xc3,
+	0x80, 0x30, 0x3b, 0xbf, 0xbd, 0xb0, 0x93, 0xdf, 0xff, 0xe1, 0x6c, 0x80, 0x2f, 0xa5, 0xf5, 0x53,
+	0xcc, 0x5e, 0x6a, 0xdb, 0x8a, 0x21, 0x82, 0x44, 0x90, 0x4e, 0x4f, 0xb0, 0xc7, 0xb7, 0x5f, 0x7f,
+	0xf7, 0xd5, 0x3d, 0x48, 0xba, 0x31, 0x65, 0x7b, 0x3d, 0x59, 0x07, 0x6a, 0xef, 0x6a, 0x86, 0x99,
+	0xb3, 0xe6, 0x6b, 0x86, 0x39, 0x6f, 0x2d, 0xd4, 0x0c, 0x73, 0xc1, 0x32, 0x6a, 0x86, 0xb9, 0x68,
+	0x2d, 0xd5, 0x0c, 0x73, 0xd9, 0x32, 0xd1, 0x8a, 0x48, 0x70, 0x40, 0x12, 0x1a, 0xa3, 0x82, 0xdf,
+	0xc2, 0x61, 0x22, 0xd2, 0xf6, 0x69, 0xd8, 0x74, 0x5f, 0x80, 0xb5, 0x19, 0x7a, 0x78, 0x0b, 0x2c,
+	0x29, 0x6a, 0x3b, 0xb7, 0x9d, 0xdb, 0x59, 0x41, 0x5a, 0x82, 0xef, 0x82, 0x82, 0x4f, 0x13, 0xc6,
+	0x71, 0xc2, 0xc5, 0xda, 0xec, 0xf9, 0xed, 0xdc, 0x8e, 0x81, 0xf2, 0x03, 0xdd, 0x53, 0xcc, 0xdc,
+	0xbf, 0xe6, 0xc0, 0x64, 0x66, 0xe1, 0x21, 0x58, 0xf2, 0x53, 0x82, 0xb9, 0x02, 0xbb, 0x32, 0x01,
+	0x13, 0x0e, 0xa7, 0xfd, 0xf6, 0x20, 0x01, 0xda, 0x11, 0xfe, 0x12, 0x18, 0x3e, 0x8e, 0x22, 0x7b,
+	0xfe, 0xff, 0x05, 0x90, 0x6e, 0xee, 0xbf, 0x73, 0x60, 0x6d, 0xc6, 0x02, 0xfa, 0x20, 0xaf, 0x2b,
+	0x88, 0xf7, 0xdb, 0x2a, 0xb8, 0xe2, 0xfe, 0x3b, 0xdf, 0x87, 0x2d, 0x41, 0x7f, 0x74, 0x9e, 0x39,
+	0x60, 0x24, 0x5f, 0x66, 0x0e, 0x54, 0x0f, 0xc3, 0x18, 0x90, 0x8b, 0x00, 0x1e, 0x5a, 0x40, 0x1f,
+	0xac, 0x4f, 0x96, 0xa9, 0x17, 0x85, 0x8c, 0xdb, 0xf3, 0xb2, 0xc2, 0x1f, 0x9e, 0x67, 0xce, 0x64,
+	0x60, 0xcf, 0x42, 0xc6, 0x2f, 0x33, 0xa7, 0x3c, 0x81, 0x3a, 0xee, 0xe9, 0xa2, 0x35, 0x3c, 0xed,
+	0xe0, 0x7e, 0x53, 0x02, 0xf9, 0x23, 0xb1, 0xa9, 0x47, 0x72, 0x4f, 0xe1, 0x9f, 0x40, 0xa9, 0x45,
+	0x63, 0xc2, 0x38, 0xc1, 0x81, 0xd7, 0x88, 0xa8, 0x7f, 0xa6, 0xf6, 0xb1, 0xf2, 0xf0, 0x5f, 0x99,
+	0xb3, 0xe9, 0x53, 0x16, 0x53, 0xc6, 0x82, 0xb3, 0xdd, 0x90, 0xee, 0xc5, 0x98, 0xb7, 0x76, 0x8f,
+	0x13, 0x41, 0x7a, 0x4b, 0x91, 0x4e, 0x79, 0xba, 0xa8, 0x38, 0xd4, 0x54, 0x84, 0x02, 0xb6, 0x40,
+	0x31, 0xc0, 0xd4, 0xfb, 0x94, 0xa6, 0x67, 0x1a, 0x7c, 0x5e, 0x82, 0x57, 0xbe, 0x17, 0xfc, 0x3c,
+	0x73, 0x0a, 0x4f, 0x0e, 0x5f, 0x7e, 0x48, 0xd3, 0x33, 0x09, 0x71, 0x99, 0x39, 0x9b, 0x8a, 0x6c,
+	0x12, 0xc8, 0x45, 0x85, 0x00, 0xd3, 0xa1, 0x19, 0xfc, 0x18, 0x58, 0x43, 0x03, 0xd6, 0x69, 0xb7,
+	0x69, 0xca, 0xed, 0x05, 0xf1, 0x1a, 0xa9, 0xfc, 0xf4, 0x3c, 0x73, 0x8a, 0x1a, 0xb2, 0xae, 0x66,
+	0x2e, 0x33, 0xe7, 0xf6, 0x14, 0xa8, 0xf6, 0x71, 0x51, 0x51, 0xc3, 0x6a, 0x53, 0xd8, 0x00, 0x05,
+	0x12, 0xb6, 0x1f, 0x1c, 0xdc, 0xd7, 0x0b, 0x30, 0xe4, 0x02, 0x7e, 0x7d, 0xdd, 0x02, 0xf2, 0xd5,
+	0xe3, 0x93, 0x07, 0x07, 0xf7, 0x07, 0xf1, 0xaf, 0x2b, 0xaa, 0x71, 0x14, 0x17, 0xe5, 0x95, 0xa8,
+	0x82, 0x3f, 0x06, 0x5a, 0xf4, 0x5a, 0x98, 0xb5, 0xec, 0x45, 0x49, 0xb1, 0x23, 0x0a, 0x48, 0x21,
+	0xfd, 0x16, 0xb3, 0xd6, 0x28, 0xeb, 0x8d, 0xfe, 0x5f, 0x70, 0xc2, 0xc3, 0x4e, 0x3c, 0xc0, 0x02,
+	0xca, 0x59, 0x58, 0x0d, 0xc3, 0x3d, 0xd0, 0xe1, 0x2e, 0xdd, 0x34, 0xdc, 0x83, 0xab, 0xc2, 0x3d,
+	0x98, 0x0c, 0x57, 0xd9, 0x0c, 0x39, 0x1e, 0x69, 0x8e, 0xe5, 0x9b, 0x72, 0x3c, 0xba, 0x8a, 0xe3,
+	0xd1, 0x24, 0x87, 0xb2, 0x11, 0x75, 0x39, 0xb5, 0x4e, 0xdb, 0xbc, 0x71, 0x5d, 0xce, 0x64, 0xa8,
+	0x38, 0xd4, 0x28, 0xf4, 0x33, 0xb0, 0x31, 0x78, 0x11, 0x85, 0x09, 0x6d, 0x47, 0x44, 0x53, 0xac,
+	0x48, 0x8a, 0x47, 0xd7, 0x51, 0xdc, 0x55, 0x14, 0x57, 0xb9, 0xbb, 0x68, 0x7d, 0x52, 0xad, 0xc8,
+	0x3c, 0x60, 0xb5, 0x09, 0x27, 0x29, 0x6b, 0x74, 0xd2, 0xa6, 0x26, 0x02, 0x92, 0xe8, 0xfd, 0xeb,
+	0x88, 0x74, 0x85, 0x4e, 0xbb, 0xba, 0xa8, 0x34, 0x52, 0x29, 0x82, 0x4f, 0x40, 0x31, 0x14, 0xac,
+	0x8d, 0x4e, 0xa4, 0xe1, 0xf3, 0x12, 0x7e, 0xff, 0x3a, 0x78, 0xfd, 0x54, 0x4d, 0x3a, 0xba, 0x68,
+	0x75, 0xa0, 0x50, 0xd0, 0x01, 0x80, 0x71, 0x27, 0x4c, 0xbd, 0x66, 0x84, 0xfd, 0x90, 0xa4, 0x1a,
+	0xbe, 0x20, 0xe1, 0x7f, 0x76, 0x1d, 0xfc, 0x1d, 0x05, 0x3f, 0xeb, 0xec, 0x22, 0x4b, 0x28, 0x9f,
+	0x2a, 0x9d, 0x62, 0xa9, 0x83, 0x42, 0x83, 0xa4, 0x51, 0x98, 0x68, 0xfc, 0x55, 0x89, 0x7f, 0xff,
+	0x3a, 0x7c, 0x5d, 0x41, 0xe3, 0x6e, 0x2e, 0xca, 0x2b, 0x71, 0x08, 0x1a, 0xd1, 0x24, 0xa0, 0x03,
+	0xd0, 0xb5, 0x1b, 0x83, 0x8e, 0xbb, 0xb9, 0x28, 0xaf, 0x44, 0x05, 0xda, 0x04, 0xeb, 0x38, 0x4d,
+	0xe9, 0xab, 0xa9, 0x84, 0x40, 0x89, 0xfd, 0xf3, 0xeb, 0xb0, 0x07, 0xef, 0xe9, 0x59, 0x6f, 0xf1,
+	0x9e, 0x16, 0xda, 0x89, 0x94, 0x04, 0x00, 0x36, 0x53, 0xdc, 0x9f, 0xe2, 0xd9, 0xb8, 0x71, 0xe2,
+	0x67, 0x9d, 0x5d, 0x64, 0x09, 0xe5, 0x04, 0xcb, 0x67, 0x60, 0x23, 0x26, 0x69, 0x93, 0x78, 0x09,
+	0xe1, 0xac, 0x1d, 0x85, 0x5c, 0xf3, 0x6c, 0xde, 0xf8, 0x39, 0xb8, 0xca, 0xdd, 0x45, 0x50, 0xaa,
+	0x5f, 0x68, 0xed, 0xb0, 0x4a, 0x59, 0x0b, 0x27, 0xcd, 0x16, 0x0e, 0x35, 0xcb, 0xad, 0x1b, 0x57,
+	0xe9, 0xa4, 0xa3, 0x8b, 0x56, 0x07, 0x8a, 0xe1, 0x56, 0xfb, 0x38, 0xf1, 0x3b, 0x83, 0xad, 0xbe,
+	0x7d, 0xe3, 0xad, 0x1e, 0x77, 0x73, 0x51, 0x5e, 0x89, 0x0a, 0xf4, 0x0e, 0x30, 0x55, 0xf7, 0x13,
+	0x06, 0xb6, 0x2d, 0x9b, 0x97, 0x65, 0x29, 0x1f, 0x07, 0x70, 0x03, 0x2c, 0xca, 0xfe, 0xc8, 0xbe,
+	0x23, 0x5b, 0x1e, 0x25, 0xc0, 0x32, 0x30, 0x03, 0xe2, 0x87, 0x31, 0x8e, 0x98, 0x5d, 0x96, 0x0e,
+	0x43, 0xb9, 0x66, 0x98, 0x45, 0xab, 0x54, 0x33, 0xcc, 0x92, 0x65, 0xd5, 0x0c, 0xd3, 0xb2, 0xd6,
+	0x6a, 0x86, 0xb9, 0x6e, 0x6d, 0xa0, 0xd5, 0x3e, 0x8d, 0xa8, 0xd7, 0x7d, 0xa8, 0x22, 0x40, 0x79,
+	0xf2, 0x0a, 0x33, 0xfd, 0xd6, 0x42, 0x45, 0x1f, 0x73, 0x1c, 0xf5, 0x99, 0xce, 0x2a, 0xb2, 0x54,
+	0xae, 0xc7, 0xce, 0xc0, 0x3d, 0xb0, 0x28, 0x5a, 0x4a, 0x02, 0x2d, 0xb0, 0x70, 0x46, 0xfa, 0xba,
+	0x03, 0x13, 0x43, 0x11, 0x62, 0x17, 0x47, 0x1d, 0xa2, 0x0e, 0x5c, 0xa4, 0x04, 0xf7, 0x04, 0x94,
+	0x4e, 0x53, 0x9c, 0x30, 0xd1, 0x8e, 0xd2, 0xe4, 0x19, 0x6d, 0x32, 0x08, 0x81, 0x21, 0x0f, 0x1d,
+	0xe5, 0x2b, 0xc7, 0xf0, 0x27, 0xc0, 0x88, 0x68, 0x93, 0xc9, 0xd6, 0x23, 0xbf, 0xbf, 0x39, 0xdb,
+	0xe7, 0x3c, 0xa3, 0x4d, 0x24, 0x4d, 0xdc, 0x6f, 0xe6, 0xc1, 0xc2, 0x33, 0xda, 0x84, 0x36, 0x58,
+	0xc6, 0x41, 0x90, 0x12, 0xc6, 0x34, 0xd2, 0x40, 0x14, 0x0d, 0x22, 0xa7, 0xed, 0xd0, 0x57, 0x70,
+	0x2b, 0x48, 0x4b, 0x82, 0x38, 0xc0, 0x1c, 0xcb, 0x53, 0xba, 0x80, 0xe4, 0x58, 0x74, 0xf7, 0x72,
+	0x65, 0x5e, 0xd2, 0x89, 0x1b, 0x24, 0x95, 0x87, 0xad, 0x51, 0x29, 0x5d, 0x64, 0x4e, 0x5e, 0xea,
+	0x5f, 0x48, 0x35, 0x1a, 0x17, 0xe0, 0x7b, 0x60, 0x99, 0xf7, 0xc6, 0x0f, 0xce, 0xf5, 0x8b, 0xcc,
+	0x29, 0xf1, 0xd1, 0x32, 0xc5, 0xb9, 0x88, 0x96, 0x78, 0x4f, 0xfc, 0xc3, 0x3d, 0x60, 0xf2, 0x9e,
+	0x17, 0x26, 0x01, 0xe9, 0xc9, 0xb3, 0xd1, 0xa8, 0x6c, 0x5c, 0x64, 0x8e, 0x35, 0x66, 0x7e, 0x2c,
+	0xe6, 0xd0, 0x32, 0xef, 0xc9, 0x01, 0x7c, 0x0f, 0x00, 0x15, 0x92, 0x64, 0x50, 0x47, 0xdd, 0xea,
+	0x45, 0xe6, 0xac, 0x48, 0xad, 0xc4, 0x1e, 0x0d, 0xa1, 0x0b, 0x16, 0x15, 0xb6, 0x29, 0xb1, 0x0b,
+	0x17, 0x99, 0x63, 0x46, 0xb4, 0xa9, 0x30, 0xd5, 0x94, 0x48, 0x55, 0x4a, 0x62, 0xda, 0x25, 0x81,
+	0x3c, 0x6f, 0x4c, 0x34, 0x10, 0xdd, 0x2f, 0xe6, 0x81, 0x79, 0xda, 0x43, 0x84, 0x75, 0x22, 0x0e,
+	0x3f, 0x04, 0x96, 0xec, 0xe6, 0xb0, 0xcf, 0xbd, 0x89, 0xd4, 0x56, 0xee, 0x8e, 0x4e, 0x87, 0x69,
+	0x0b, 0x17, 0x95, 0x06, 0xaa, 0x43, 0x9d, 0xff, 0x0d, 0xb0, 0xd8, 0x88, 0x28, 0x8d, 0x65, 0x25,
+	0x14, 0x90, 0x12, 0xe0, 0xc7, 0x32, 0x6b, 0x72, 0x97, 0x17, 0x64, 0xa7, 0xfc, 0xee, 0xec, 0x2e,
+	0x4f, 0x95, 0x4a, 0xe5, 0xae, 0xe8, 0x93, 0x2f, 0x33, 0xa7, 0xa8, 0xb8, 0xb5, 0xbf, 0xfb, 0xf7,
+	0xef, 0xbe, 0xba, 0x97, 0x13, 0x09, 0x96, 0xf5, 0x64, 0x81, 0x85, 0x94, 0x70, 0xb9, 0x73, 0x05,
+	0x24, 0x86, 0xe2, 0xb9, 0x48, 0x49, 0x97, 0xa4, 0x9c, 0x04, 0x72, 0x87, 0x4c, 0x34, 0x94, 0xc5,
+	0x43, 0x26, 0x2e, 0x3e, 0x1d, 0x46, 0x02, 0xb5, 0x1d, 0x68, 0xb9, 0x89, 0xd9, 0x47, 0x8c, 0x04,
+	0x8f, 0x8d, 0xcf, 0xbf, 0x74, 0xe6, 0x5c, 0x0c, 0xf2, 0xba, 0x89, 0xee, 0xb4, 0x23, 0x72, 0x4d,
+	0x99, 0xed, 0x83, 0x02, 0xe3, 0x34, 0xc5, 0x4d, 0xe2, 0x9d, 0x91, 0xbe, 0x2e, 0x36, 0x55, 0x3a,
+	0x5a, 0xff, 0x3b, 0xd2, 0x67, 0x68, 0x5c, 0xd0, 0x14, 0x5f, 0x1a, 0x20, 0x7f, 0x9a, 0x62, 0x9f,
+	0xe8, 0x96, 0x58, 0x14, 0xac, 0x10, 0xd3, 0xc1, 0x8d, 0x46, 0x49, 0x82, 0x9b, 0x87, 0x31, 0xa1,
+	0x1d, 0xae, 0x1f, 0xaa, 0x81, 0x28, 0x3c, 0x52, 0x42, 0x7a, 0xc4, 0x97, 0xb9, 0x34, 0x90, 0x96,
+	0xe0, 0x01, 0x58, 0x0d, 0x42, 0x86, 0x1b, 0x91, 0xbc, 0x1a, 0xfa, 0x67, 0x6a, 0xf9, 0x15, 0xeb,
+	0x22, 0x73, 0x0a, 0x7a, 0xa2, 0x2e, 0xf4, 0x68, 0x42, 0x82, 0x1f, 0x80, 0xd2, 0xc8, 0x4d, 0x46,
+	0xab, 0x6e, 0xc4, 0x15, 0x78, 0x91, 0x39, 0xc5, 0xa1, 0xa9, 0x9c, 0x41, 0x53, 0xb2, 0x7a, 0x37,
+	0x35, 0x3a, 0x4d, 0x59, 0x81, 0x26, 0x52, 0x82, 0xd0, 0x46, 0x61, 0x1c, 0x72, 0x59, 0x71, 0x8b,
+	0x48, 0x09, 0xf0, 0x03, 0xb0, 0x32, 0xba, 0x72, 0x02, 0x59, 0x06, 0x3f, 0x98, 0x2d, 0x83, 0xb1,
+	0xeb, 0x02, 0x1a, 0xd9, 0x8b, 0xc5, 0x91, 0x44, 0x06, 0x19, 0x93, 0x98, 0xa6, 0x7d, 0x3b, 0x3f,
+	0x5a, 0x9c, 0x9a, 0x78, 0x2e, 0xf5, 0x68, 0x42, 0x82, 0x15, 0x00, 0xb5, 0x5b, 0x4a, 0x78, 0x27,
+	0x4d, 0x3c, 0xf9, 0x12, 0x28, 0x48, 0x5f, 0xf9, 0x28, 0xaa, 0x59, 0x24, 0x27, 0x9f, 0x60, 0x8e,
+	0xd1, 0x8c, 0x06, 0xfe, 0x0a, 0x40, 0xb5, 0x27, 0xde, 0x67, 0x8c, 0x0e, 0xae, 0xa7, 0xba, 0x6b,
+	0x90, 0xfc, 0x6a, 0x56, 0xc7, 0x6c, 0x29, 0xa9, 0xc6, 0xa8, 0x5e, 0x45, 0xcd, 0x30, 0x0d, 0x6b,
+	0x51, 0xdf, 0x76, 0x07, 0xf9, 0xd3, 0xab, 0x40, 0xeb, 0x03, 0x79, 0x2c, 0xbc, 0x7b, 0xff, 0xc8,
+	0x81, 0xb1, 0xbb, 0x1c, 0xfc, 0x05, 0x28, 0x1f, 0x1e, 0x1d, 0x55, 0xeb, 0x75, 0xef, 0xf4, 0x93,
+	0x93, 0xaa, 0x77, 0x52, 0x45, 0xcf, 0x8f, 0xeb, 0xf5, 0xe3, 0x97, 0x2f, 0x9e, 0x55, 0xeb, 0x75,
+	0x6b, 0xae, 0xfc, 0xce, 0xeb, 0x37, 0xdb, 0xf6, 0xc8, 0xfe, 0x44, 0xe4, 0x93, 0xb1, 0x90, 0x26,
+	0x91, 0xa8, 0xd4, 0xf7, 0xc1, 0xad, 0x71, 0x6f, 0x54, 0xad, 0x9f, 0xa2, 0xe3, 0xa3, 0xd3, 0xea,
+	0x13, 0x2b, 0x57, 0xb6, 0x5f, 0xbf, 0xd9, 0xde, 0x18, 0x79, 0x22, 0xc2, 0x78, 0x1a, 0x8a, 0x6f,
+	0x1f, 0xf0, 0x11, 0xb0, 0xaf, 0xe6, 0xac, 0x3e, 0xb1, 0xe6, 0xcb, 0xe5, 0xd7, 0x6f, 0xb6, 0x6f,
+	0x5d, 0xc5, 0x48, 0x82, 0xb2, 0xf1, 0xf9, 0xdf, 0xb6, 0xe6, 0x2a, 0xbf, 0xf9, 0xfa, 0x7c, 0x2b,
+	0xf7, 0xed, 0xf9, 0x56, 0xee, 0x3f, 0xe7, 0x5b, 0xb9, 0x2f, 0xde, 0x6e, 0xcd, 0x7d, 0xfb, 0x76,
+	0x6b, 0xee, 0x9f, 0x6f, 0xb7, 0xe6, 0xfe, 0xf0, 0xe3, 0x66, 0xc8, 0x5b, 0x9d, 0xc6, 0xae, 0x4f,
+	0xe3, 0x3d, 0xf5, 0xb1, 0x40, 0xfd, 0x76, 0xf7, 0xef, 0xeb, 0xcf, 0x06, 0xe2, 0xae, 0xca, 0x1a,
+	0x4b, 0xf2, 0x1b, 0xd0, 0xc3, 0xff, 0x0d, 0x00, 0xcf, 0xf5, 0x27, 0xf2, 0x5c, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1001,6 +1076,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OpcodeGasOverrides) > 0 {
+		for iNdEx := len(m.OpcodeGasOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OpcodeGasOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ActiveStaticPrecompiles) > 0 {
 		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActiveStaticPrecompiles[iNdEx])
@@ -1051,6 +1140,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OpcodeGasOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpcodeGasOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpcodeGasOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConstantGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ConstantGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opcode) > 0 {
+		i -= len(m.Opcode)
+		copy(dAtA[i:], m.Opcode)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Opcode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1840,6 +1964,28 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if len(m.OpcodeGasOverrides) > 0 {
+		for _, e := range m.OpcodeGasOverrides {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OpcodeGasOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opcode)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ConstantGas != 0 {
+		n += 1 + sovEvm(uint64(m.ConstantGas))
+	}
 	return n
 }
 
@@ -2325,6 +2471,141 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpcodeGasOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpcodeGasOverrides = append(m.OpcodeGasOverrides, OpcodeGasOverride{})
+			if err := m.OpcodeGasOverrides[len(m.OpcodeGasOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpcodeGasOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpcodeGasOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpcodeGasOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantGas", wireType)
+			}
+			m.ConstantGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConstantGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
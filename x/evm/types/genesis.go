@@ -19,10 +19,15 @@ func (ga GenesisAccount) Validate() error {
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{
+	genesis := &GenesisState{
 		Accounts: []GenesisAccount{},
 		Params:   DefaultParams(),
 	}
+	if cc := GetChainConfig(); cc != nil {
+		chainConfig := *cc
+		genesis.ChainConfig = &chainConfig
+	}
+	return genesis
 }
 
 // NewGenesisState creates a new genesis state.
@@ -47,5 +52,11 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	if gs.ChainConfig != nil {
+		if err := gs.ChainConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid chain config: %w", err)
+		}
+	}
+
 	return gs.Params.Validate()
 }
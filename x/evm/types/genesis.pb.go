@@ -30,6 +30,9 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// chain_config defines the EVM chain configuration. If it is not set, the
+	// chain configuration of the node is stored at genesis.
+	ChainConfig *ChainConfig `protobuf:"bytes,3,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +82,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetChainConfig() *ChainConfig {
+	if m != nil {
+		return m.ChainConfig
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -153,28 +163,29 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xcb, 0xeb, 0xa5,
-	0x96, 0xe5, 0xea, 0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88,
-	0x22, 0x29, 0x29, 0x0c, 0x43, 0x40, 0x6a, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6,
-	0x3e, 0x88, 0x05, 0x11, 0x55, 0x9a, 0xc2, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51,
-	0x81, 0x59, 0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27,
-	0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd,
-	0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81,
-	0x69, 0x4c, 0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x25,
-	0x42, 0x12, 0x5c, 0xec, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0x20, 0x77, 0x31, 0x6a, 0x70, 0x06,
-	0xc1, 0xb8, 0x42, 0x42, 0x5c, 0x2c, 0xc9, 0xf9, 0x29, 0xa9, 0x60, 0x7b, 0x38, 0x83, 0xc0, 0x6c,
-	0x21, 0x77, 0x2e, 0xf6, 0xe2, 0x92, 0xfc, 0xa2, 0xc4, 0xf4, 0x54, 0x09, 0x66, 0xb0, 0x2f, 0xc4,
-	0x31, 0xad, 0x07, 0x7b, 0xd8, 0x49, 0x04, 0x64, 0xfb, 0xaa, 0xfb, 0xf2, 0xec, 0xc1, 0x10, 0xf5,
-	0x10, 0x87, 0xc0, 0x74, 0x3b, 0x39, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
-	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43,
-	0x94, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x28, 0x98, 0xf3, 0x8b,
-	0xa1, 0x64, 0x99, 0x91, 0x81, 0x7e, 0x05, 0x38, 0xfc, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x21, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xca, 0x72, 0xe8, 0xe2, 0x01, 0x00,
-	0x00,
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0x3d, 0x4f, 0x02, 0x31,
+	0x18, 0xbe, 0x8a, 0x01, 0x29, 0xc4, 0x68, 0x43, 0xe2, 0x85, 0xc4, 0x42, 0x18, 0x0c, 0x71, 0xb8,
+	0x0a, 0x8e, 0x2e, 0x08, 0x03, 0xab, 0x39, 0x36, 0x17, 0x53, 0x4a, 0x3d, 0x6e, 0xb8, 0x2b, 0xb9,
+	0x96, 0x8b, 0xfe, 0x02, 0x57, 0x7f, 0x86, 0x71, 0xf2, 0x67, 0x30, 0x32, 0x19, 0x27, 0x35, 0x30,
+	0xf8, 0x37, 0x4c, 0xdb, 0x03, 0x3f, 0x6e, 0x69, 0x9e, 0xb7, 0xef, 0xf3, 0x3c, 0xef, 0x17, 0xc4,
+	0x5c, 0x4d, 0x79, 0x12, 0x85, 0xb1, 0x22, 0x3c, 0x8d, 0x48, 0xda, 0x21, 0x01, 0x8f, 0xb9, 0x0c,
+	0xa5, 0x37, 0x4b, 0x84, 0x12, 0xe8, 0x60, 0x9b, 0xf7, 0x78, 0x1a, 0x79, 0x69, 0xa7, 0x7e, 0x48,
+	0xa3, 0x30, 0x16, 0xc4, 0xbc, 0x96, 0x54, 0xaf, 0xe7, 0x4c, 0x34, 0xd7, 0xe6, 0x6a, 0x81, 0x08,
+	0x84, 0x81, 0x44, 0x23, 0xfb, 0xdb, 0x7a, 0x05, 0xb0, 0x3a, 0xb4, 0x85, 0x46, 0x8a, 0x2a, 0x8e,
+	0x86, 0x70, 0x8f, 0x32, 0x26, 0xe6, 0xb1, 0x92, 0x2e, 0x68, 0x16, 0xda, 0x95, 0x6e, 0xd3, 0xfb,
+	0x5f, 0xda, 0xcb, 0x14, 0x97, 0x96, 0xd8, 0x2f, 0x2f, 0xde, 0x1b, 0xce, 0xd3, 0xd7, 0xcb, 0x29,
+	0xf0, 0xb7, 0x62, 0x74, 0x01, 0x8b, 0x33, 0x9a, 0xd0, 0x48, 0xba, 0x3b, 0x4d, 0xd0, 0xae, 0x74,
+	0xdd, 0xbc, 0xcd, 0x95, 0xc9, 0xff, 0x96, 0x67, 0x12, 0xd4, 0x83, 0x55, 0x36, 0xa5, 0x61, 0x7c,
+	0xc3, 0x44, 0x7c, 0x1b, 0x06, 0x6e, 0xc1, 0x58, 0x1c, 0xe7, 0x2d, 0x06, 0x9a, 0x35, 0x30, 0x24,
+	0xbf, 0xc2, 0x7e, 0x82, 0xd6, 0x03, 0x80, 0xfb, 0x7f, 0xdb, 0x44, 0x2e, 0x2c, 0xd1, 0xc9, 0x24,
+	0xe1, 0x52, 0x4f, 0x06, 0xda, 0x65, 0x7f, 0x13, 0x22, 0x04, 0x77, 0x99, 0x98, 0x70, 0xd3, 0x69,
+	0xd9, 0x37, 0x18, 0x0d, 0x61, 0x49, 0x2a, 0x91, 0xd0, 0x80, 0xbb, 0x05, 0xb3, 0x87, 0xa3, 0x7c,
+	0x75, 0xb3, 0xb2, 0x7e, 0x4d, 0xf7, 0xff, 0xfc, 0xd1, 0x28, 0x8d, 0x2c, 0xdf, 0x8e, 0xb2, 0x51,
+	0xf7, 0x7b, 0x8b, 0x15, 0x06, 0xcb, 0x15, 0x06, 0x9f, 0x2b, 0x0c, 0x1e, 0xd7, 0xd8, 0x59, 0xae,
+	0xb1, 0xf3, 0xb6, 0xc6, 0xce, 0xf5, 0x49, 0x10, 0xaa, 0xe9, 0x7c, 0xec, 0x31, 0x11, 0xe9, 0x43,
+	0x09, 0x99, 0xbd, 0x69, 0xf7, 0x8c, 0xdc, 0x69, 0x4c, 0xd4, 0xfd, 0x8c, 0xcb, 0x71, 0xd1, 0xdc,
+	0xea, 0xfc, 0x7b, 0x00, 0x96, 0xd5, 0x82, 0x5b, 0x24, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -197,6 +208,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChainConfig != nil {
+		{
+			size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -300,6 +323,10 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.ChainConfig != nil {
+		l = m.ChainConfig.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -428,6 +455,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainConfig == nil {
+				m.ChainConfig = &ChainConfig{}
+			}
+			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
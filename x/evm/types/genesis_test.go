@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
@@ -82,6 +83,8 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	invalidBlock := sdkmath.NewInt(-1)
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -114,6 +117,24 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			genState: NewGenesisState(DefaultGenesisState().Params, DefaultGenesisState().Accounts),
 			expPass:  true,
 		},
+		{
+			name: "valid chain config",
+			genState: &GenesisState{
+				Params:      DefaultParams(),
+				ChainConfig: DefaultChainConfig("torram_2931-1"),
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid chain config",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ChainConfig: &ChainConfig{
+					HomesteadBlock: &invalidBlock,
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid genesis account",
 			genState: &GenesisState{
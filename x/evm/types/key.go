@@ -3,6 +3,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -29,6 +30,9 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixBlockHash
+	prefixChainConfig
+	prefixBlockRandom
 )
 
 // prefix bytes for the EVM transient store
@@ -37,22 +41,27 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientCheckTxFees
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode     = []byte{prefixCode}
-	KeyPrefixStorage  = []byte{prefixStorage}
-	KeyPrefixParams   = []byte{prefixParams}
-	KeyPrefixCodeHash = []byte{prefixCodeHash}
+	KeyPrefixCode        = []byte{prefixCode}
+	KeyPrefixStorage     = []byte{prefixStorage}
+	KeyPrefixParams      = []byte{prefixParams}
+	KeyPrefixCodeHash    = []byte{prefixCodeHash}
+	KeyPrefixBlockHash   = []byte{prefixBlockHash}
+	KeyPrefixChainConfig = []byte{prefixChainConfig}
+	KeyPrefixBlockRandom = []byte{prefixBlockRandom}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom       = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex     = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize     = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed     = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientCheckTxFees = []byte{prefixTransientCheckTxFees}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -64,3 +73,15 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// CheckTxFeesKey defines the transient key of the fees deducted during
+// CheckTx for the transaction of the sender with the given nonce.
+func CheckTxFeesKey(sender common.Address, nonce uint64) []byte {
+	return append(sender.Bytes(), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// BlockHashKey defines the key of the block hash ring buffer entry that holds
+// the hash of the block at the given height.
+func BlockHashKey(height uint64) []byte {
+	return append(KeyPrefixBlockHash, sdk.Uint64ToBigEndian(height%BlockHashRingSize)...)
+}
@@ -6,11 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 
 	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	txsigning "cosmossdk.io/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -33,10 +36,19 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgUpdateChainConfig{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
 
+// MsgEthereumTxCustomGetSigner defines the custom signers getter for
+// MsgEthereumTx, which does not define the cosmos.msg.v1.signer option since
+// the signer is recovered from the Ethereum signature.
+var MsgEthereumTxCustomGetSigner = txsigning.CustomGetSigner{
+	MsgType: protoreflect.FullName("ethermint.evm.v1.MsgEthereumTx"),
+	Fn:      GetSignersFromMsgEthereumTxV2,
+}
+
 // message type and route constants
 const (
 	// TypeMsgEthereumTx defines the type string of an Ethereum transaction
@@ -79,6 +91,22 @@ func newMsgEthereumTx(
 	}
 
 	switch {
+	case tx.AuthorizationList != nil:
+		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
+		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
+
+		txData = &SetCodeTx{
+			ChainID:        cid,
+			Amount:         amt,
+			To:             toAddr,
+			GasTipCap:      &gtc,
+			GasFeeCap:      &gfc,
+			Nonce:          tx.Nonce,
+			GasLimit:       tx.GasLimit,
+			Data:           tx.Input,
+			Accesses:       NewAccessList(tx.Accesses),
+			Authorizations: tx.AuthorizationList,
+		}
 	case tx.GasFeeCap != nil:
 		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
 		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
@@ -122,7 +150,7 @@ func newMsgEthereumTx(
 	}
 
 	msg := MsgEthereumTx{Data: dataAny}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.TxHash().Hex()
 	return &msg
 }
 
@@ -185,7 +213,7 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 	}
 
 	// Validate Hash field after validated txData to avoid panic
-	txHash := msg.AsTransaction().Hash().Hex()
+	txHash := msg.TxHash().Hex()
 	if msg.Hash != txHash {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tx hash %s, expected: %s", msg.Hash, txHash)
 	}
@@ -224,6 +252,10 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 		return fmt.Errorf("sender address not defined for message")
 	}
 
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		return msg.signSetCodeTx(setCodeTx, ethSigner, keyringSigner)
+	}
+
 	tx := msg.AsTransaction()
 	txHash := ethSigner.Hash(tx)
 
@@ -240,6 +272,31 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 	return msg.FromEthereumTx(tx)
 }
 
+// signSetCodeTx signs the given EIP-7702 transaction data of the message,
+// which cannot be signed with the go-ethereum signers.
+func (msg *MsgEthereumTx) signSetCodeTx(txData *SetCodeTx, ethSigner ethtypes.Signer, keyringSigner keyring.Signer) error {
+	txData = txData.Copy().(*SetCodeTx)
+	txData.SetSignatureValues(ethSigner.ChainID(), nil, nil, nil)
+
+	sig, _, err := keyringSigner.SignByAddress(msg.GetFrom(), txData.SigHash().Bytes(), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	if err != nil {
+		return err
+	}
+
+	if err := txData.WithSignature(sig); err != nil {
+		return err
+	}
+
+	anyTxData, err := PackTxData(txData)
+	if err != nil {
+		return err
+	}
+
+	msg.Data = anyTxData
+	msg.Hash = txData.Hash().Hex()
+	return nil
+}
+
 // GetGas implements the GasTx interface. It returns the GasLimit of the transaction.
 func (msg MsgEthereumTx) GetGas() uint64 {
 	txData, err := UnpackTxData(msg.Data)
@@ -287,15 +344,56 @@ func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	return ethtypes.NewTx(txData.AsEthereumData())
 }
 
-// AsMessage creates an Ethereum core.Message from the msg fields
+// TxHash returns the Ethereum transaction hash of the msg. It must be used
+// instead of the hash of AsTransaction, which does not support the EIP-7702
+// transactions.
+func (msg MsgEthereumTx) TxHash() common.Hash {
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		return setCodeTx.Hash()
+	}
+
+	return msg.AsTransaction().Hash()
+}
+
+// AsMessage creates an Ethereum core.Message from the msg fields. The messages
+// of the EIP-7702 transactions are SetCodeMessages.
 func (msg MsgEthereumTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
-	return msg.AsTransaction().AsMessage(signer, baseFee)
+	setCodeTx, ok := msg.setCodeTx()
+	if !ok {
+		return msg.AsTransaction().AsMessage(signer, baseFee)
+	}
+
+	from, err := setCodeTx.Sender(signer.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := setCodeTx.GetGasFeeCap()
+	if baseFee != nil {
+		gasPrice = setCodeTx.EffectiveGasPrice(baseFee)
+	}
+
+	return SetCodeMessage{
+		Message: ethtypes.NewMessage(
+			from,
+			setCodeTx.GetTo(),
+			setCodeTx.GetNonce(),
+			setCodeTx.GetValue(),
+			setCodeTx.GetGas(),
+			gasPrice,
+			setCodeTx.GetGasFeeCap(),
+			setCodeTx.GetGasTipCap(),
+			setCodeTx.GetData(),
+			setCodeTx.GetAccessList(),
+			false,
+		),
+		authorizations: setCodeTx.GetAuthorizationList(),
+	}, nil
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (msg *MsgEthereumTx) GetSender(chainID *big.Int) (common.Address, error) {
-	signer := ethtypes.LatestSignerForChainID(chainID)
-	from, err := signer.Sender(msg.AsTransaction())
+	from, err := msg.RecoverSender(chainID)
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -304,13 +402,112 @@ func (msg *MsgEthereumTx) GetSender(chainID *big.Int) (common.Address, error) {
 	return from, nil
 }
 
+// RecoverSender extracts the sender address from the signature values like
+// GetSender, without setting it to the msg From field.
+func (msg MsgEthereumTx) RecoverSender(chainID *big.Int) (common.Address, error) {
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		return setCodeTx.Sender(chainID)
+	}
+
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	return signer.Sender(msg.AsTransaction())
+}
+
+// GetSignersFromMsgEthereumTxV2 returns the signers of the given
+// MsgEthereumTx, i.e. the sender recovered from the signature of the Ethereum
+// transaction, since the `from` field is not part of the signed data. The
+// message is received as a protov2 message as required by the x/tx signing
+// context.
+func GetSignersFromMsgEthereumTxV2(msg protov2.Message) ([][]byte, error) {
+	bz, err := protov2.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgEthTx MsgEthereumTx
+	if err := msgEthTx.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	if err := msgEthTx.UnpackInterfaces(signersInterfaceRegistry()); err != nil {
+		return nil, err
+	}
+
+	if setCodeTx, ok := msgEthTx.setCodeTx(); ok {
+		sender, err := setCodeTx.Sender(setCodeTx.GetChainID())
+		if err != nil {
+			return nil, err
+		}
+
+		return [][]byte{sender.Bytes()}, nil
+	}
+
+	tx := msgEthTx.AsTransaction()
+	if tx == nil {
+		return nil, errors.New("invalid ethereum transaction data")
+	}
+
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return [][]byte{sender.Bytes()}, nil
+}
+
+// signersInterfaceRegistry resolves the TxData of the messages decoded by
+// GetSignersFromMsgEthereumTxV2. It is built lazily, once the protobuf types
+// of the package are registered.
+var signersInterfaceRegistry = sync.OnceValue(func() codectypes.InterfaceRegistry {
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	return registry
+})
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(msg.Data, new(TxData))
 }
 
+// setCodeTx returns the tx data of the msg if it is an EIP-7702 transaction.
+func (msg MsgEthereumTx) setCodeTx() (*SetCodeTx, bool) {
+	if msg.Data == nil {
+		return nil, false
+	}
+
+	setCodeTx, ok := msg.Data.GetCachedValue().(*SetCodeTx)
+	return setCodeTx, ok
+}
+
+// MarshalBinary returns the canonical encoding of the transaction. It must be
+// used instead of the encoding of AsTransaction, which does not support the
+// EIP-7702 transactions.
+func (msg MsgEthereumTx) MarshalBinary() ([]byte, error) {
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		return setCodeTx.MarshalBinary()
+	}
+
+	return msg.AsTransaction().MarshalBinary()
+}
+
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] == SetCodeTxType {
+		txData, err := UnmarshalSetCodeTx(b)
+		if err != nil {
+			return err
+		}
+
+		anyTxData, err := PackTxData(txData)
+		if err != nil {
+			return err
+		}
+
+		msg.Data = anyTxData
+		msg.Hash = txData.Hash().Hex()
+		return nil
+	}
+
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(b); err != nil {
 		return err
@@ -356,6 +553,23 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 	return tx, nil
 }
 
+// SetCodeMessage is the core.Message of an EIP-7702 transaction, which carries
+// the authorization list that is not supported by the go-ethereum messages.
+type SetCodeMessage struct {
+	ethtypes.Message
+	authorizations AuthorizationList
+}
+
+// NewSetCodeMessage returns a new message with the given authorization list.
+func NewSetCodeMessage(msg ethtypes.Message, authorizations AuthorizationList) SetCodeMessage {
+	return SetCodeMessage{Message: msg, authorizations: authorizations}
+}
+
+// AuthorizationList returns the authorization list of the message.
+func (m SetCodeMessage) AuthorizationList() AuthorizationList {
+	return m.authorizations
+}
+
 // ValidateBasic does a sanity check of the provided data
 func (m *MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
@@ -369,3 +583,17 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateChainConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.ChainConfig.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateChainConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
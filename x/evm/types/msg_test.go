@@ -0,0 +1,117 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// toProtoV2 returns the message as the dynamic protov2 message received by the
+// x/tx signing context.
+func toProtoV2(t *testing.T, msg *MsgEthereumTx) protov2.Message {
+	t.Helper()
+
+	desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(proto.MessageName(msg)))
+	require.NoError(t, err)
+
+	bz, err := msg.Marshal()
+	require.NoError(t, err)
+
+	msgV2 := dynamicpb.NewMessage(desc.(protoreflect.MessageDescriptor))
+	require.NoError(t, protov2.Unmarshal(bz, msgV2))
+	return msgV2
+}
+
+func TestGetSignersFromMsgEthereumTxV2(t *testing.T) {
+	chainID := big.NewInt(9000)
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	// newSignedMsg returns a message of a signed dynamic fee transaction,
+	// modified by the given function after the signature
+	newSignedMsg := func(t *testing.T, malleate func(txData *DynamicFeeTx)) *MsgEthereumTx {
+		t.Helper()
+
+		ethTx := NewTx(&EvmTxArgs{
+			ChainID:   chainID,
+			Nonce:     1,
+			To:        &to,
+			Amount:    big.NewInt(10),
+			GasLimit:  21000,
+			GasFeeCap: big.NewInt(2_000_000_000),
+			GasTipCap: big.NewInt(1_000_000_000),
+		}).AsTransaction()
+
+		signedTx, err := ethtypes.SignTx(ethTx, ethtypes.LatestSignerForChainID(chainID), key)
+		require.NoError(t, err)
+
+		txData, err := NewTxDataFromTx(signedTx)
+		require.NoError(t, err)
+		dynamicFeeTx, ok := txData.(*DynamicFeeTx)
+		require.True(t, ok)
+		malleate(dynamicFeeTx)
+
+		anyTxData, err := PackTxData(dynamicFeeTx)
+		require.NoError(t, err)
+		return &MsgEthereumTx{Data: anyTxData}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(txData *DynamicFeeTx)
+		expFrom  bool
+		expError bool
+	}{
+		{
+			"valid signature",
+			func(*DynamicFeeTx) {},
+			true,
+			false,
+		},
+		{
+			"tampered transaction",
+			// the signature of a tampered transaction recovers another address
+			func(txData *DynamicFeeTx) { txData.Nonce++ },
+			false,
+			false,
+		},
+		{
+			"tampered signature",
+			func(txData *DynamicFeeTx) { txData.S = crypto.S256().Params().N.Bytes() },
+			false,
+			true,
+		},
+		{
+			"missing signature",
+			func(txData *DynamicFeeTx) { txData.V, txData.R, txData.S = nil, nil, nil },
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := newSignedMsg(t, tc.malleate)
+
+			signers, err := GetSignersFromMsgEthereumTxV2(toProtoV2(t, msg))
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.expFrom, common.BytesToAddress(signers[0]) == from)
+		})
+	}
+}
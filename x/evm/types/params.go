@@ -17,19 +17,27 @@ import (
 	"github.com/green901612/cosevm/x/evm/core/vm"
 )
 
+// MaxOpcodeConstantGas defines the maximum constant gas of an opcode gas
+// override, above the most expensive constant gas of the instruction set
+const MaxOpcodeConstantGas uint64 = 100_000
+
 var (
 	// DefaultAllowUnprotectedTxs rejects all unprotected txs (i.e false)
 	DefaultAllowUnprotectedTxs = false
 	// DefaultStaticPrecompiles defines the default active precompiles
+	// NOTE: only the precompiles with an implementation registered in the
+	// keeper can be active.
 	DefaultStaticPrecompiles = []string{
-		P256PrecompileAddress,         // P256 precompile
-		Bech32PrecompileAddress,       // Bech32 precompile
-		StakingPrecompileAddress,      // Staking precompile
-		DistributionPrecompileAddress, // Distribution precompile
-		ICS20PrecompileAddress,        // ICS20 transfer precompile
-		VestingPrecompileAddress,      // Vesting precompile
-		BankPrecompileAddress,         // Bank precompile
-		GovPrecompileAddress,          // Gov precompile
+		P256PrecompileAddress,           // P256 precompile
+		Bech32PrecompileAddress,         // Bech32 precompile
+		StakingPrecompileAddress,        // Staking precompile
+		DistributionPrecompileAddress,   // Distribution precompile
+		BankPrecompileAddress,           // Bank precompile
+		GovPrecompileAddress,            // Gov precompile
+		SlashingPrecompileAddress,       // Slashing precompile
+		EvidencePrecompileAddress,       // Evidence precompile
+		WERC20PrecompileAddress,         // WERC20 precompile
+		HistoryStoragePrecompileAddress, // EIP-2935 history contract
 	}
 	// DefaultExtraEIPs defines the default extra EIPs to be included
 	// On v15, EIP 3855 was enabled
@@ -39,6 +47,9 @@ var (
 		"channel-31", // Cronos
 		"channel-83", // Kava
 	}
+	// DefaultOpcodeGasOverrides defines the default opcode gas overrides, the
+	// gas of the EVM instruction set is not overridden by default
+	DefaultOpcodeGasOverrides       []OpcodeGasOverride
 	DefaultCreateAllowlistAddresses []string
 	DefaultCallAllowlistAddresses   []string
 	DefaultAccessControl            = AccessControl{
@@ -60,6 +71,7 @@ func NewParams(
 	activeStaticPrecompiles,
 	evmChannels []string,
 	accessControl AccessControl,
+	opcodeGasOverrides []OpcodeGasOverride,
 ) Params {
 	return Params{
 		AllowUnprotectedTxs:     allowUnprotectedTxs,
@@ -67,6 +79,7 @@ func NewParams(
 		ActiveStaticPrecompiles: activeStaticPrecompiles,
 		EVMChannels:             evmChannels,
 		AccessControl:           accessControl,
+		OpcodeGasOverrides:      opcodeGasOverrides,
 	}
 }
 
@@ -78,6 +91,7 @@ func DefaultParams() Params {
 		ActiveStaticPrecompiles: DefaultStaticPrecompiles,
 		EVMChannels:             DefaultEVMChannels,
 		AccessControl:           DefaultAccessControl,
+		OpcodeGasOverrides:      DefaultOpcodeGasOverrides,
 	}
 }
 
@@ -117,6 +131,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateOpcodeGasOverrides(p.OpcodeGasOverrides); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -127,6 +145,16 @@ func (p Params) EIPs() []string {
 	return eips
 }
 
+// GasOverrides returns the opcode gas overrides as a map of the constant gas
+// indexed by opcode.
+func (p Params) GasOverrides() map[vm.OpCode]uint64 {
+	overrides := make(map[vm.OpCode]uint64, len(p.OpcodeGasOverrides))
+	for _, override := range p.OpcodeGasOverrides {
+		overrides[vm.StringToOp(override.Opcode)] = override.ConstantGas
+	}
+	return overrides
+}
+
 // GetActiveStaticPrecompilesAddrs is a util function that the Active Precompiles
 // as a slice of addresses.
 func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
@@ -262,3 +290,34 @@ func ValidatePrecompiles(i interface{}) error {
 func IsLondon(ethConfig *params.ChainConfig, height int64) bool {
 	return ethConfig.IsLondon(big.NewInt(height))
 }
+
+func validateOpcodeGasOverrides(i interface{}) error {
+	overrides, ok := i.([]OpcodeGasOverride)
+	if !ok {
+		return fmt.Errorf("invalid opcode gas overrides type: %T", i)
+	}
+
+	uniqueOpcodes := make(map[string]struct{})
+
+	for _, override := range overrides {
+		// StringToOp returns the STOP opcode for the unknown names
+		if vm.StringToOp(override.Opcode) == vm.STOP && override.Opcode != vm.STOP.String() {
+			return fmt.Errorf("invalid opcode %s", override.Opcode)
+		}
+
+		// a free opcode would allow unmetered loops
+		if override.ConstantGas == 0 || override.ConstantGas > MaxOpcodeConstantGas {
+			return fmt.Errorf(
+				"constant gas of opcode %s must be between 1 and %d: %d",
+				override.Opcode, MaxOpcodeConstantGas, override.ConstantGas,
+			)
+		}
+
+		if _, ok := uniqueOpcodes[override.Opcode]; ok {
+			return fmt.Errorf("found duplicate opcode gas override: %s", override.Opcode)
+		}
+		uniqueOpcodes[override.Opcode] = struct{}{}
+	}
+
+	return nil
+}
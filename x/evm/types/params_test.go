@@ -6,6 +6,8 @@ import (
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
 )
 
 func TestParamsValidate(t *testing.T) {
@@ -25,7 +27,7 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			name:    "valid",
-			params:  NewParams(false, extraEips, nil, nil, DefaultAccessControl),
+			params:  NewParams(false, extraEips, nil, nil, DefaultAccessControl, nil),
 			expPass: true,
 		},
 		{
@@ -45,6 +47,41 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid opcode gas overrides",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{{"SLOAD", 1000}, {"STOP", 1}},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid opcode gas override",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{{"sload", 1000}},
+			},
+			errContains: "invalid opcode sload",
+		},
+		{
+			name: "duplicate opcode gas override",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{{"SSTORE", 1000}, {"SSTORE", 2000}},
+			},
+			errContains: "found duplicate opcode gas override: SSTORE",
+		},
+		{
+			name: "zero opcode constant gas",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{{"JUMP", 0}},
+			},
+			errContains: "constant gas of opcode JUMP must be between 1 and 100000: 0",
+		},
+		{
+			name: "opcode constant gas above the maximum",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{{"SSTORE", MaxOpcodeConstantGas + 1}},
+			},
+			errContains: "constant gas of opcode SSTORE must be between 1 and 100000: 100001",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -73,12 +110,20 @@ func TestParamsValidate(t *testing.T) {
 
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []string{"ethereum_2929", "ethereum_1884", "ethereum_1344"}
-	params := NewParams(false, extraEips, nil, nil, DefaultAccessControl)
+	params := NewParams(false, extraEips, nil, nil, DefaultAccessControl, nil)
 	actual := params.EIPs()
 
 	require.Equal(t, []string{"ethereum_2929", "ethereum_1884", "ethereum_1344"}, actual)
 }
 
+func TestParamsGasOverrides(t *testing.T) {
+	params := DefaultParams()
+	require.Empty(t, params.GasOverrides())
+
+	params.OpcodeGasOverrides = []OpcodeGasOverride{{"SLOAD", 1000}, {"LOG1", 500}}
+	require.Equal(t, map[vm.OpCode]uint64{vm.SLOAD: 1000, vm.LOG1: 500}, params.GasOverrides())
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateBool(""))
 	require.NoError(t, validateBool(true))
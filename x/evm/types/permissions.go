@@ -54,8 +54,12 @@ func NewRestrictedPermissionPolicy(accessControl *AccessControl, signer common.A
 var _ PermissionPolicy = RestrictedPermissionPolicy{}
 
 // GetCallHook returns a CallHook that checks if the caller is allowed to perform a call.
+// The calls to the precompiled contracts of the EVM are always allowed.
 func (p RestrictedPermissionPolicy) GetCallHook(signer common.Address) CallHook {
-	return func(_ *vm.EVM, caller, recipient common.Address) error {
+	return func(evm *vm.EVM, caller, recipient common.Address) error {
+		if _, isPrecompile := evm.Precompile(recipient); isPrecompile {
+			return nil
+		}
 		if p.CanCall(signer, caller, recipient) {
 			return nil
 		}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/green901612/cosevm/x/evm/core/vm"
+)
+
+func TestRestrictedPermissionPolicy(t *testing.T) {
+	t.Parallel()
+
+	listed := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	contract := common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	testCases := []struct {
+		name       string
+		accessType AccessType
+		signer     common.Address
+		caller     common.Address
+		expAllowed bool
+	}{
+		{"permissionless - allowed", AccessTypePermissionless, other, other, true},
+		{"permissionless - blocked signer", AccessTypePermissionless, listed, contract, false},
+		{"permissionless - blocked caller", AccessTypePermissionless, other, listed, false},
+		{"restricted - not allowed", AccessTypeRestricted, listed, listed, false},
+		{"permissioned - allowed signer", AccessTypePermissioned, listed, contract, true},
+		{"permissioned - allowed caller", AccessTypePermissioned, other, listed, true},
+		{"permissioned - not allowed", AccessTypePermissioned, other, contract, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			accessControlType := AccessControlType{
+				AccessType:        tc.accessType,
+				AccessControlList: []string{listed.String()},
+			}
+			accessControl := AccessControl{Create: accessControlType, Call: accessControlType}
+			policy := NewRestrictedPermissionPolicy(&accessControl, tc.signer)
+			evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{}, nil, ethparams.TestChainConfig, vm.Config{})
+
+			require.Equal(t, tc.expAllowed, policy.CanCreate(tc.signer, tc.caller))
+			require.Equal(t, tc.expAllowed, policy.CanCall(tc.signer, tc.caller, other))
+
+			err := policy.GetCreateHook(tc.signer)(evm, tc.caller)
+			if tc.expAllowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "does not have permission to deploy contracts")
+			}
+
+			err = policy.GetCallHook(tc.signer)(evm, tc.caller, other)
+			if tc.expAllowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "does not have permission to perform a call")
+			}
+
+			// the calls to precompiles are always allowed
+			require.NoError(t, policy.GetCallHook(tc.signer)(evm, tc.caller, common.BytesToAddress([]byte{0x1})))
+		})
+	}
+}
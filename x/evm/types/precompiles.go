@@ -19,6 +19,14 @@ const (
 	EvidencePrecompileAddress     = "0x0000000000000000000000000000000000000807"
 )
 
+// WERC20PrecompileAddress is the default address of the wrapped EVM coin
+// precompile. Chains can register the precompile at another address.
+const WERC20PrecompileAddress = "0x0000000000000000000000000000000000000808"
+
+// HistoryStoragePrecompileAddress is the address of the EIP-2935 history
+// contract, which serves the hashes of the previous blocks.
+const HistoryStoragePrecompileAddress = "0x0000F90827F1C53a10cb7A02335B175320002935"
+
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
 //
 // NOTE: To be explicit, this list does not include the dynamically registered EVM extensions
@@ -34,4 +42,6 @@ var AvailableStaticPrecompiles = []string{
 	GovPrecompileAddress,
 	SlashingPrecompileAddress,
 	EvidencePrecompileAddress,
+	WERC20PrecompileAddress,
+	HistoryStoragePrecompileAddress,
 }
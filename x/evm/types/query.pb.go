@@ -1622,6 +1622,298 @@ func (m *QueryConfigResponse) GetConfig() *ChainConfig {
 	return nil
 }
 
+// QueryAccessControlRequest is the request type for the Query/AccessControl RPC
+// method.
+type QueryAccessControlRequest struct {
+	// address is the ethereum hex address of the account to check the
+	// permissions of.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccessControlRequest) Reset()         { *m = QueryAccessControlRequest{} }
+func (m *QueryAccessControlRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessControlRequest) ProtoMessage()    {}
+func (*QueryAccessControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{28}
+}
+
+func (m *QueryAccessControlRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryAccessControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessControlRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryAccessControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessControlRequest.Merge(m, src)
+}
+
+func (m *QueryAccessControlRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryAccessControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessControlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccessControlRequest proto.InternalMessageInfo
+
+func (m *QueryAccessControlRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccessControlResponse is the response type for the Query/AccessControl
+// RPC method.
+type QueryAccessControlResponse struct {
+	// can_create defines if the account can deploy contracts.
+	CanCreate bool `protobuf:"varint,1,opt,name=can_create,json=canCreate,proto3" json:"can_create,omitempty"`
+	// can_call defines if the account can call contracts. The calls to the
+	// precompiled contracts are always allowed.
+	CanCall bool `protobuf:"varint,2,opt,name=can_call,json=canCall,proto3" json:"can_call,omitempty"`
+}
+
+func (m *QueryAccessControlResponse) Reset()         { *m = QueryAccessControlResponse{} }
+func (m *QueryAccessControlResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessControlResponse) ProtoMessage()    {}
+func (*QueryAccessControlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{29}
+}
+
+func (m *QueryAccessControlResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryAccessControlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessControlResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryAccessControlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessControlResponse.Merge(m, src)
+}
+
+func (m *QueryAccessControlResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryAccessControlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessControlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccessControlResponse proto.InternalMessageInfo
+
+func (m *QueryAccessControlResponse) GetCanCreate() bool {
+	if m != nil {
+		return m.CanCreate
+	}
+	return false
+}
+
+func (m *QueryAccessControlResponse) GetCanCall() bool {
+	if m != nil {
+		return m.CanCall
+	}
+	return false
+}
+
+// QueryOpcodeGasScheduleRequest is the request type for the
+// Query/OpcodeGasSchedule RPC method.
+type QueryOpcodeGasScheduleRequest struct{}
+
+func (m *QueryOpcodeGasScheduleRequest) Reset()         { *m = QueryOpcodeGasScheduleRequest{} }
+func (m *QueryOpcodeGasScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOpcodeGasScheduleRequest) ProtoMessage()    {}
+func (*QueryOpcodeGasScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{30}
+}
+
+func (m *QueryOpcodeGasScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryOpcodeGasScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpcodeGasScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryOpcodeGasScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpcodeGasScheduleRequest.Merge(m, src)
+}
+
+func (m *QueryOpcodeGasScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryOpcodeGasScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpcodeGasScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOpcodeGasScheduleRequest proto.InternalMessageInfo
+
+// QueryOpcodeGasScheduleResponse is the response type for the
+// Query/OpcodeGasSchedule RPC method.
+type QueryOpcodeGasScheduleResponse struct {
+	// opcodes is the gas schedule of the opcodes of the EVM instruction set of
+	// the current block. The dynamic gas of an opcode, e.g. the cold access cost
+	// of SLOAD and SSTORE or the data cost of LOG0-LOG4, is charged in addition
+	// to its constant gas and is not affected by the opcode gas overrides.
+	Opcodes []OpcodeGas `protobuf:"bytes,1,rep,name=opcodes,proto3" json:"opcodes"`
+}
+
+func (m *QueryOpcodeGasScheduleResponse) Reset()         { *m = QueryOpcodeGasScheduleResponse{} }
+func (m *QueryOpcodeGasScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOpcodeGasScheduleResponse) ProtoMessage()    {}
+func (*QueryOpcodeGasScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{31}
+}
+
+func (m *QueryOpcodeGasScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryOpcodeGasScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpcodeGasScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryOpcodeGasScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpcodeGasScheduleResponse.Merge(m, src)
+}
+
+func (m *QueryOpcodeGasScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryOpcodeGasScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpcodeGasScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOpcodeGasScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryOpcodeGasScheduleResponse) GetOpcodes() []OpcodeGas {
+	if m != nil {
+		return m.Opcodes
+	}
+	return nil
+}
+
+// OpcodeGas defines the gas charged by an opcode of the EVM instruction set
+type OpcodeGas struct {
+	// opcode is the name of the opcode
+	Opcode string `protobuf:"bytes,1,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	// constant_gas is the constant gas charged by the opcode
+	ConstantGas uint64 `protobuf:"varint,2,opt,name=constant_gas,json=constantGas,proto3" json:"constant_gas,omitempty"`
+	// dynamic_gas defines if the opcode charges a dynamic gas, in addition to
+	// its constant gas
+	DynamicGas bool `protobuf:"varint,3,opt,name=dynamic_gas,json=dynamicGas,proto3" json:"dynamic_gas,omitempty"`
+	// overridden defines if the constant gas is overridden by the opcode gas
+	// overrides of the EVM parameters
+	Overridden bool `protobuf:"varint,4,opt,name=overridden,proto3" json:"overridden,omitempty"`
+}
+
+func (m *OpcodeGas) Reset()         { *m = OpcodeGas{} }
+func (m *OpcodeGas) String() string { return proto.CompactTextString(m) }
+func (*OpcodeGas) ProtoMessage()    {}
+func (*OpcodeGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{32}
+}
+
+func (m *OpcodeGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *OpcodeGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpcodeGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *OpcodeGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpcodeGas.Merge(m, src)
+}
+
+func (m *OpcodeGas) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *OpcodeGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpcodeGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpcodeGas proto.InternalMessageInfo
+
+func (m *OpcodeGas) GetOpcode() string {
+	if m != nil {
+		return m.Opcode
+	}
+	return ""
+}
+
+func (m *OpcodeGas) GetConstantGas() uint64 {
+	if m != nil {
+		return m.ConstantGas
+	}
+	return 0
+}
+
+func (m *OpcodeGas) GetDynamicGas() bool {
+	if m != nil {
+		return m.DynamicGas
+	}
+	return false
+}
+
+func (m *OpcodeGas) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
@@ -1651,115 +1943,133 @@ func init() {
 	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "ethermint.evm.v1.QueryGlobalMinGasPriceResponse")
 	proto.RegisterType((*QueryConfigRequest)(nil), "ethermint.evm.v1.QueryConfigRequest")
 	proto.RegisterType((*QueryConfigResponse)(nil), "ethermint.evm.v1.QueryConfigResponse")
+	proto.RegisterType((*QueryAccessControlRequest)(nil), "ethermint.evm.v1.QueryAccessControlRequest")
+	proto.RegisterType((*QueryAccessControlResponse)(nil), "ethermint.evm.v1.QueryAccessControlResponse")
+	proto.RegisterType((*QueryOpcodeGasScheduleRequest)(nil), "ethermint.evm.v1.QueryOpcodeGasScheduleRequest")
+	proto.RegisterType((*QueryOpcodeGasScheduleResponse)(nil), "ethermint.evm.v1.QueryOpcodeGasScheduleResponse")
+	proto.RegisterType((*OpcodeGas)(nil), "ethermint.evm.v1.OpcodeGas")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x13, 0x47,
-	0x1b, 0xce, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0x00, 0xc7, 0x24, 0x76, 0x58, 0xc8, 0x0f,
-	0xf8, 0x60, 0x37, 0xc9, 0xf7, 0x7d, 0x48, 0xdf, 0xd7, 0x43, 0x49, 0xa2, 0x10, 0x28, 0x50, 0xd1,
-	0x6d, 0xd4, 0x43, 0xa5, 0xca, 0x1a, 0xaf, 0x87, 0xf5, 0x2a, 0xde, 0x1d, 0xb3, 0x33, 0xb6, 0x1c,
-	0x10, 0x87, 0xa2, 0xaa, 0x2d, 0xea, 0x05, 0xa9, 0xb7, 0xf6, 0xc2, 0xb1, 0x52, 0x2f, 0xbd, 0xf1,
-	0x2f, 0x70, 0x44, 0xed, 0xa5, 0xea, 0x81, 0x56, 0x50, 0xa9, 0xfd, 0x1b, 0x7a, 0xaa, 0xe6, 0xc7,
-	0xda, 0xbb, 0xb6, 0x37, 0x0e, 0x15, 0xbd, 0xf5, 0x92, 0xec, 0xcc, 0xbc, 0xef, 0xfb, 0x3c, 0xf3,
-	0xce, 0x3b, 0xf3, 0x3e, 0x06, 0x73, 0x98, 0xd5, 0x70, 0xe0, 0xb9, 0x3e, 0x33, 0x71, 0xcb, 0x33,
-	0x5b, 0x6b, 0xe6, 0xdd, 0x26, 0x0e, 0xf6, 0x8d, 0x46, 0x40, 0x18, 0x81, 0xd3, 0x9d, 0x55, 0x03,
-	0xb7, 0x3c, 0xa3, 0xb5, 0x56, 0x38, 0x86, 0x3c, 0xd7, 0x27, 0xa6, 0xf8, 0x2b, 0x8d, 0x0a, 0x17,
-	0x6c, 0x42, 0x3d, 0x42, 0xcd, 0x0a, 0xa2, 0x58, 0x7a, 0x9b, 0xad, 0xb5, 0x0a, 0x66, 0x68, 0xcd,
-	0x6c, 0x20, 0xc7, 0xf5, 0x11, 0x73, 0x89, 0xaf, 0x6c, 0x0b, 0x7d, 0x70, 0x3c, 0xae, 0x5c, 0x9b,
-	0xed, 0x5b, 0x63, 0x6d, 0xb5, 0x34, 0xe3, 0x10, 0x87, 0x88, 0x4f, 0x93, 0x7f, 0xa9, 0xd9, 0x39,
-	0x87, 0x10, 0xa7, 0x8e, 0x4d, 0xd4, 0x70, 0x4d, 0xe4, 0xfb, 0x84, 0x09, 0x24, 0xaa, 0x56, 0x4b,
-	0x6a, 0x55, 0x8c, 0x2a, 0xcd, 0x3b, 0x26, 0x73, 0x3d, 0x4c, 0x19, 0xf2, 0x1a, 0xd2, 0x40, 0xff,
-	0x1f, 0x38, 0xfe, 0x1e, 0x67, 0xbb, 0x61, 0xdb, 0xa4, 0xe9, 0x33, 0x0b, 0xdf, 0x6d, 0x62, 0xca,
-	0x60, 0x1e, 0x64, 0x50, 0xb5, 0x1a, 0x60, 0x4a, 0xf3, 0xda, 0x82, 0xb6, 0x32, 0x61, 0x85, 0xc3,
-	0xff, 0x67, 0x3f, 0x7f, 0x52, 0x1a, 0xf9, 0xfd, 0x49, 0x69, 0x44, 0xb7, 0xc1, 0x4c, 0xdc, 0x95,
-	0x36, 0x88, 0x4f, 0x31, 0xf7, 0xad, 0xa0, 0x3a, 0xf2, 0x6d, 0x1c, 0xfa, 0xaa, 0x21, 0x3c, 0x0d,
-	0x26, 0x6c, 0x52, 0xc5, 0xe5, 0x1a, 0xa2, 0xb5, 0xfc, 0xa8, 0x58, 0xcb, 0xf2, 0x89, 0x6b, 0x88,
-	0xd6, 0xe0, 0x0c, 0x18, 0xf3, 0x09, 0x77, 0x4a, 0x2d, 0x68, 0x2b, 0x69, 0x4b, 0x0e, 0xf4, 0xb7,
-	0xc1, 0xac, 0x00, 0xd9, 0x12, 0xe9, 0xfd, 0x0b, 0x2c, 0x3f, 0xd5, 0x40, 0x61, 0x50, 0x04, 0x45,
-	0x76, 0x11, 0x1c, 0x91, 0x27, 0x57, 0x8e, 0x47, 0x9a, 0x92, 0xb3, 0x1b, 0x72, 0x12, 0x16, 0x40,
-	0x96, 0x72, 0x50, 0xce, 0x6f, 0x54, 0xf0, 0xeb, 0x8c, 0x79, 0x08, 0x24, 0xa3, 0x96, 0xfd, 0xa6,
-	0x57, 0xc1, 0x81, 0xda, 0xc1, 0x94, 0x9a, 0x7d, 0x57, 0x4c, 0xea, 0x37, 0xc0, 0x9c, 0xe0, 0xf1,
-	0x01, 0xaa, 0xbb, 0x55, 0xc4, 0x48, 0xd0, 0xb3, 0x99, 0x33, 0x60, 0xd2, 0x26, 0x7e, 0x2f, 0x8f,
-	0x1c, 0x9f, 0xdb, 0xe8, 0xdb, 0xd5, 0x17, 0x1a, 0x98, 0x4f, 0x88, 0xa6, 0x36, 0xb6, 0x0c, 0x8e,
-	0x86, 0xac, 0xe2, 0x11, 0x43, 0xb2, 0x6f, 0x70, 0x6b, 0x61, 0x11, 0x6d, 0xca, 0x73, 0x7e, 0x9d,
-	0xe3, 0x59, 0x55, 0x45, 0xd4, 0x71, 0x1d, 0x56, 0x44, 0xfa, 0x0d, 0x05, 0xf6, 0x3e, 0x23, 0x01,
-	0x72, 0x86, 0x83, 0xc1, 0x69, 0x90, 0xda, 0xc3, 0xfb, 0xaa, 0xde, 0xf8, 0x67, 0x04, 0xfe, 0xa2,
-	0x82, 0xef, 0x04, 0x53, 0xf0, 0x33, 0x60, 0xac, 0x85, 0xea, 0xcd, 0x10, 0x5c, 0x0e, 0xf4, 0xcb,
-	0x60, 0x5a, 0x95, 0x52, 0xf5, 0xb5, 0x36, 0xb9, 0x0c, 0x8e, 0x45, 0xfc, 0x14, 0x04, 0x04, 0x69,
-	0x5e, 0xfb, 0xc2, 0x6b, 0xd2, 0x12, 0xdf, 0xfa, 0x3d, 0x00, 0x85, 0xe1, 0x6e, 0xfb, 0x26, 0x71,
-	0x68, 0x08, 0x01, 0x41, 0x5a, 0xdc, 0x18, 0x19, 0x5f, 0x7c, 0xc3, 0xab, 0x00, 0x74, 0xdf, 0x15,
-	0xb1, 0xb7, 0xdc, 0xfa, 0x92, 0x21, 0x8b, 0xd6, 0xe0, 0x8f, 0x90, 0x21, 0x9f, 0x30, 0xf5, 0x08,
-	0x19, 0xb7, 0xbb, 0xa9, 0xb2, 0x22, 0x9e, 0x11, 0x92, 0x8f, 0x34, 0x95, 0xd8, 0x10, 0x5c, 0xf1,
-	0x3c, 0x0f, 0xd2, 0x75, 0xe2, 0xf0, 0xdd, 0xa5, 0x56, 0x72, 0xeb, 0x27, 0x8c, 0xde, 0xd7, 0xd0,
-	0xb8, 0x49, 0x1c, 0x4b, 0x98, 0xc0, 0x9d, 0x01, 0xa4, 0x96, 0x87, 0x92, 0x92, 0x38, 0x51, 0x56,
-	0xfa, 0x8c, 0xca, 0xc3, 0x6d, 0x14, 0x20, 0x2f, 0xcc, 0x83, 0x6e, 0x29, 0x82, 0xe1, 0xac, 0x22,
-	0xf8, 0x16, 0x18, 0x6f, 0x88, 0x19, 0x91, 0xa0, 0xdc, 0x7a, 0xbe, 0x9f, 0xa2, 0xf4, 0xd8, 0x9c,
-	0x78, 0xf6, 0xa2, 0x34, 0xf2, 0xcd, 0x6f, 0xdf, 0x5d, 0xd0, 0x2c, 0xe5, 0xa2, 0x3f, 0xd5, 0xc0,
-	0x91, 0x6d, 0x56, 0xdb, 0x42, 0xf5, 0x7a, 0x24, 0xdd, 0x28, 0x70, 0x68, 0x78, 0x30, 0xfc, 0x1b,
-	0x9e, 0x02, 0x19, 0x07, 0xd1, 0xb2, 0x8d, 0x1a, 0xea, 0x8e, 0x8c, 0x3b, 0x88, 0x6e, 0xa1, 0x06,
-	0xfc, 0x08, 0x4c, 0x37, 0x02, 0xd2, 0x20, 0x14, 0x07, 0x9d, 0x7b, 0xc6, 0xef, 0xc8, 0xe4, 0xe6,
-	0xfa, 0x1f, 0x2f, 0x4a, 0x86, 0xe3, 0xb2, 0x5a, 0xb3, 0x62, 0xd8, 0xc4, 0x33, 0x55, 0x83, 0x90,
-	0xff, 0x2e, 0xd1, 0xea, 0x9e, 0xc9, 0xf6, 0x1b, 0x98, 0x1a, 0x5b, 0xdd, 0x0b, 0x6e, 0x1d, 0x0d,
-	0x63, 0x85, 0x97, 0x73, 0x16, 0x64, 0xed, 0x1a, 0x72, 0xfd, 0xb2, 0x5b, 0xcd, 0xa7, 0x17, 0xb4,
-	0x95, 0x94, 0x95, 0x11, 0xe3, 0xeb, 0x55, 0x7d, 0x17, 0x1c, 0xdf, 0xa6, 0xcc, 0xf5, 0x10, 0xc3,
-	0x3b, 0xa8, 0x9b, 0x8d, 0x69, 0x90, 0x72, 0x90, 0x24, 0x9f, 0xb6, 0xf8, 0x27, 0x9f, 0x09, 0x30,
-	0x13, 0xbc, 0x27, 0x2d, 0xfe, 0xc9, 0xa3, 0xb6, 0xbc, 0x32, 0x0e, 0x02, 0x22, 0x2f, 0xf4, 0x84,
-	0x95, 0x69, 0x79, 0xdb, 0x7c, 0xa8, 0x3f, 0x4a, 0x87, 0x55, 0x10, 0x20, 0x1b, 0xef, 0xb6, 0xc3,
-	0xa4, 0xac, 0x81, 0x94, 0x47, 0x1d, 0x95, 0xe1, 0x52, 0x7f, 0x86, 0x6f, 0x51, 0x67, 0x9b, 0xcf,
-	0xe1, 0xa6, 0xb7, 0xdb, 0xb6, 0xb8, 0x2d, 0xbc, 0x02, 0x26, 0x19, 0x0f, 0x52, 0xb6, 0x89, 0x7f,
-	0xc7, 0x75, 0x04, 0x52, 0x6e, 0x7d, 0xbe, 0xdf, 0x57, 0x40, 0x6d, 0x09, 0x23, 0x2b, 0xc7, 0xba,
-	0x03, 0xb8, 0x05, 0x26, 0x1b, 0x01, 0xae, 0x62, 0x1b, 0x53, 0x4a, 0x02, 0x9a, 0x4f, 0x8b, 0x12,
-	0x1c, 0x8a, 0x1e, 0x73, 0xe2, 0xef, 0x6a, 0xa5, 0x4e, 0xec, 0xbd, 0xf0, 0x05, 0x1b, 0x13, 0x69,
-	0xcc, 0x89, 0x39, 0xf9, 0x7e, 0xc1, 0x79, 0x00, 0xa4, 0x89, 0xb8, 0x66, 0xe3, 0x22, 0x23, 0x13,
-	0x62, 0x46, 0x74, 0xa6, 0x6b, 0xe1, 0x32, 0x6f, 0x9e, 0xf9, 0x8c, 0xd8, 0x46, 0xc1, 0x90, 0x9d,
-	0xd5, 0x08, 0x3b, 0xab, 0xb1, 0x1b, 0x76, 0xd6, 0xcd, 0x29, 0x5e, 0x66, 0x8f, 0x7f, 0x2e, 0x69,
-	0xb2, 0xd4, 0x64, 0x24, 0xbe, 0x3c, 0xb0, 0x5a, 0xb2, 0x7f, 0x4f, 0xb5, 0x4c, 0xc4, 0xaa, 0x05,
-	0xea, 0x60, 0x4a, 0xee, 0xc1, 0x43, 0xed, 0x32, 0x2f, 0x10, 0x10, 0x49, 0xc3, 0x2d, 0xd4, 0xde,
-	0x41, 0xf4, 0x9d, 0x74, 0x76, 0x74, 0x3a, 0x65, 0x65, 0x59, 0xbb, 0xec, 0xfa, 0x55, 0xdc, 0xd6,
-	0x2f, 0xa8, 0xc7, 0xb1, 0x53, 0x0a, 0xdd, 0x97, 0xab, 0x8a, 0x18, 0x0a, 0x2f, 0x08, 0xff, 0xd6,
-	0x9f, 0xa6, 0xc0, 0xc9, 0xae, 0xf1, 0x26, 0x8f, 0x1a, 0x29, 0x1d, 0xd6, 0x0e, 0xdf, 0x8f, 0xe1,
-	0xa5, 0xc3, 0xda, 0xf4, 0x0d, 0x94, 0xce, 0x3f, 0xa7, 0x7e, 0xc8, 0x53, 0xd7, 0x2f, 0x81, 0x53,
-	0x7d, 0x07, 0x77, 0xc0, 0x41, 0x9f, 0xe8, 0xf4, 0x7a, 0x8a, 0xaf, 0xe2, 0xb0, 0xa7, 0xe8, 0x37,
-	0x3b, 0x7d, 0x5c, 0x4d, 0xab, 0x10, 0xff, 0x01, 0x59, 0xfe, 0xf0, 0x97, 0xef, 0x60, 0xd5, 0x4b,
-	0x37, 0x67, 0x7f, 0x7a, 0x51, 0x3a, 0x21, 0x77, 0x48, 0xab, 0x7b, 0x86, 0x4b, 0x4c, 0x0f, 0xb1,
-	0x9a, 0x71, 0xdd, 0x67, 0xbc, 0xc7, 0x0b, 0x6f, 0xbd, 0xa4, 0xd4, 0xcd, 0x4e, 0x9d, 0x54, 0x50,
-	0xfd, 0x96, 0xeb, 0xef, 0x20, 0x7a, 0x3b, 0x70, 0x3b, 0xd2, 0x42, 0xb7, 0x41, 0x31, 0xc9, 0x40,
-	0x01, 0x6f, 0x80, 0x29, 0xcf, 0xf5, 0xf9, 0xa6, 0xcb, 0x0d, 0xbe, 0xa0, 0xd0, 0xe7, 0xf9, 0x29,
-	0x25, 0x33, 0xc8, 0x79, 0xdd, 0x50, 0x9d, 0x2e, 0xa4, 0xea, 0xab, 0xb3, 0xd3, 0xe3, 0xb1, 0x59,
-	0x85, 0xf7, 0x5f, 0x30, 0xae, 0x8a, 0x55, 0x4b, 0x2a, 0xd6, 0x2d, 0x7e, 0x2a, 0xca, 0x4d, 0x19,
-	0xaf, 0x7f, 0x7f, 0x04, 0x8c, 0x89, 0x70, 0xf0, 0x63, 0x0d, 0x64, 0x94, 0x88, 0x83, 0x8b, 0xfd,
-	0xce, 0x03, 0x54, 0x7a, 0x61, 0x69, 0x98, 0x99, 0xe4, 0xa6, 0x2f, 0x3f, 0xfc, 0xe1, 0xd7, 0x2f,
-	0x47, 0xcf, 0xc0, 0x12, 0xff, 0x4d, 0x41, 0x68, 0xf8, 0xcb, 0x42, 0x89, 0x38, 0xf3, 0xbe, 0x2a,
-	0xca, 0x07, 0xf0, 0x2b, 0x0d, 0x4c, 0xc5, 0x74, 0x32, 0xfc, 0x57, 0x02, 0xc4, 0x20, 0x3d, 0x5e,
-	0xb8, 0x78, 0x38, 0x63, 0xc5, 0xca, 0x10, 0xac, 0x56, 0xe0, 0x52, 0x9c, 0x55, 0x28, 0xc7, 0xfb,
-	0xc8, 0x7d, 0xab, 0x81, 0xe9, 0x5e, 0xb9, 0x0b, 0x8d, 0x04, 0xc8, 0x04, 0x95, 0x5d, 0x30, 0x0f,
-	0x6d, 0xaf, 0x58, 0x5e, 0x16, 0x2c, 0x57, 0xa1, 0x11, 0x67, 0xd9, 0x0a, 0xed, 0xbb, 0x44, 0xa3,
-	0xea, 0xfd, 0x01, 0x7c, 0xa8, 0x81, 0x8c, 0x12, 0xb5, 0x89, 0xc7, 0x19, 0xd7, 0xcb, 0x89, 0xc7,
-	0xd9, 0xa3, 0x8d, 0xf5, 0x15, 0x41, 0x49, 0x87, 0x0b, 0x71, 0x4a, 0x4a, 0x20, 0xd3, 0x48, 0xca,
-	0x3e, 0xd3, 0x40, 0x46, 0x49, 0xdb, 0x44, 0x12, 0x71, 0x1d, 0x9d, 0x48, 0xa2, 0x47, 0x21, 0xeb,
-	0x97, 0x04, 0x89, 0x65, 0xb8, 0x18, 0x27, 0x41, 0xa5, 0x59, 0x97, 0x83, 0x79, 0x7f, 0x0f, 0xef,
-	0x3f, 0x80, 0x2d, 0x90, 0xe6, 0xea, 0x17, 0xea, 0x89, 0x25, 0xd2, 0x91, 0xd4, 0x85, 0xb3, 0x07,
-	0xda, 0x28, 0xfc, 0x45, 0x81, 0x5f, 0x82, 0xf3, 0xbd, 0xd5, 0x53, 0x8d, 0x65, 0x80, 0x82, 0x71,
-	0x29, 0xfe, 0xe0, 0xb9, 0x84, 0xa8, 0x31, 0x8d, 0x59, 0x58, 0x1c, 0x62, 0xa5, 0xd0, 0xe7, 0x04,
-	0xfa, 0x49, 0x38, 0x13, 0x47, 0x97, 0xa2, 0x12, 0x32, 0x90, 0x51, 0x9a, 0x12, 0x2e, 0xf4, 0xc7,
-	0x8b, 0xcb, 0xcd, 0xc2, 0xf2, 0xb0, 0x8e, 0x18, 0x62, 0x16, 0x05, 0x66, 0x1e, 0x9e, 0x8c, 0x63,
-	0x62, 0x56, 0x2b, 0xdb, 0x1c, 0xea, 0x1e, 0xc8, 0x45, 0x04, 0xe1, 0x21, 0x90, 0x07, 0xec, 0x75,
-	0x80, 0xa2, 0xd4, 0x75, 0x81, 0x3b, 0x07, 0x0b, 0x3d, 0xb8, 0xca, 0x94, 0x3f, 0xb1, 0xb0, 0x0d,
-	0x32, 0x4a, 0x25, 0x24, 0xd6, 0x59, 0x5c, 0x50, 0x26, 0xd6, 0x59, 0x8f, 0xd8, 0x48, 0xda, 0xb5,
-	0x94, 0x07, 0xac, 0x0d, 0x3f, 0xd1, 0x00, 0xe8, 0xb6, 0x2e, 0xb8, 0x72, 0x50, 0xd8, 0xa8, 0x2c,
-	0x29, 0x9c, 0x3f, 0x84, 0xa5, 0xe2, 0x70, 0x46, 0x70, 0x38, 0x0d, 0x67, 0x07, 0x71, 0x10, 0xbd,
-	0x94, 0x27, 0x40, 0xb5, 0xbe, 0x03, 0x6e, 0x7b, 0xb4, 0x63, 0x1e, 0x70, 0xdb, 0x63, 0x1d, 0x34,
-	0x29, 0x01, 0x61, 0x57, 0x85, 0x5f, 0x6b, 0xe0, 0x58, 0x5f, 0x1b, 0x84, 0x49, 0xef, 0x5c, 0x52,
-	0x47, 0x2d, 0xac, 0x1e, 0xde, 0x41, 0x11, 0x3b, 0x2b, 0x88, 0xcd, 0xc3, 0xd3, 0x71, 0x62, 0xb1,
-	0xae, 0xcb, 0xef, 0x9f, 0x52, 0x64, 0xe7, 0x12, 0x6f, 0x75, 0xa4, 0xbb, 0x26, 0xde, 0xbf, 0x78,
-	0xb7, 0x4d, 0xba, 0x7f, 0xb2, 0xa9, 0x6e, 0x5e, 0x79, 0xf6, 0xb2, 0xa8, 0x3d, 0x7f, 0x59, 0xd4,
-	0x7e, 0x79, 0x59, 0xd4, 0x1e, 0xbf, 0x2a, 0x8e, 0x3c, 0x7f, 0x55, 0x1c, 0xf9, 0xf1, 0x55, 0x71,
-	0xe4, 0xc3, 0xa5, 0x88, 0xd8, 0xea, 0x78, 0x12, 0x6a, 0xb6, 0xd6, 0x57, 0xcd, 0xb6, 0x88, 0x22,
-	0x04, 0x57, 0x65, 0x5c, 0x08, 0xbc, 0x7f, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x02, 0xd3, 0xdf,
-	0xf6, 0x1a, 0x14, 0x00, 0x00,
+	// 1851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x9a, 0xb4, 0x48, 0x3d, 0x4a, 0x89, 0x34, 0xa2, 0x1d, 0x69, 0x2d, 0x91, 0xf2, 0x3a,
+	0xfa, 0xb0, 0x6b, 0xef, 0x4a, 0x6a, 0x13, 0xa0, 0xed, 0xa1, 0x91, 0x08, 0x45, 0x49, 0x63, 0xb7,
+	0xee, 0x46, 0xc8, 0xa1, 0x40, 0x40, 0x0c, 0x97, 0x63, 0x72, 0x21, 0xee, 0x0e, 0xb3, 0x33, 0x24,
+	0xa8, 0x04, 0x3e, 0x34, 0x28, 0x9a, 0x06, 0xbd, 0x04, 0xc8, 0xad, 0xbd, 0xa4, 0xb7, 0x02, 0xbd,
+	0xf4, 0x96, 0x7f, 0x21, 0xc7, 0x00, 0xbd, 0x14, 0x3d, 0xb8, 0x85, 0x5d, 0xa0, 0xfd, 0x1b, 0x7a,
+	0x2a, 0xe6, 0x63, 0xc9, 0x5d, 0x91, 0x2b, 0x32, 0x45, 0x7a, 0xeb, 0x85, 0xdc, 0x79, 0xf3, 0x3e,
+	0x7e, 0xef, 0xcd, 0x9b, 0x79, 0xef, 0xc1, 0x06, 0xe1, 0x6d, 0x12, 0x05, 0x7e, 0xc8, 0x1d, 0xd2,
+	0x0f, 0x9c, 0xfe, 0x81, 0xf3, 0x41, 0x8f, 0x44, 0x17, 0x76, 0x37, 0xa2, 0x9c, 0xa2, 0xe5, 0xe1,
+	0xae, 0x4d, 0xfa, 0x81, 0xdd, 0x3f, 0x30, 0x57, 0x70, 0xe0, 0x87, 0xd4, 0x91, 0xbf, 0x8a, 0xc9,
+	0xbc, 0xe7, 0x51, 0x16, 0x50, 0xe6, 0x34, 0x30, 0x23, 0x4a, 0xda, 0xe9, 0x1f, 0x34, 0x08, 0xc7,
+	0x07, 0x4e, 0x17, 0xb7, 0xfc, 0x10, 0x73, 0x9f, 0x86, 0x9a, 0xd7, 0x1c, 0x33, 0x27, 0xf4, 0xaa,
+	0xbd, 0xf5, 0xb1, 0x3d, 0x3e, 0xd0, 0x5b, 0xe5, 0x16, 0x6d, 0x51, 0xf9, 0xe9, 0x88, 0x2f, 0x4d,
+	0xdd, 0x68, 0x51, 0xda, 0xea, 0x10, 0x07, 0x77, 0x7d, 0x07, 0x87, 0x21, 0xe5, 0xd2, 0x12, 0xd3,
+	0xbb, 0x55, 0xbd, 0x2b, 0x57, 0x8d, 0xde, 0x13, 0x87, 0xfb, 0x01, 0x61, 0x1c, 0x07, 0x5d, 0xc5,
+	0x60, 0x7d, 0x1f, 0x56, 0x7f, 0x26, 0xd0, 0x1e, 0x79, 0x1e, 0xed, 0x85, 0xdc, 0x25, 0x1f, 0xf4,
+	0x08, 0xe3, 0x68, 0x0d, 0x0a, 0xb8, 0xd9, 0x8c, 0x08, 0x63, 0x6b, 0xc6, 0x96, 0xb1, 0xb7, 0xe0,
+	0xc6, 0xcb, 0x1f, 0x14, 0x7f, 0xfd, 0x45, 0x75, 0xee, 0x5f, 0x5f, 0x54, 0xe7, 0x2c, 0x0f, 0xca,
+	0x69, 0x51, 0xd6, 0xa5, 0x21, 0x23, 0x42, 0xb6, 0x81, 0x3b, 0x38, 0xf4, 0x48, 0x2c, 0xab, 0x97,
+	0xe8, 0x16, 0x2c, 0x78, 0xb4, 0x49, 0xea, 0x6d, 0xcc, 0xda, 0x6b, 0xd7, 0xe4, 0x5e, 0x51, 0x10,
+	0xde, 0xc2, 0xac, 0x8d, 0xca, 0x70, 0x3d, 0xa4, 0x42, 0x28, 0xb7, 0x65, 0xec, 0xe5, 0x5d, 0xb5,
+	0xb0, 0x7e, 0x04, 0xeb, 0xd2, 0x48, 0x4d, 0x86, 0xf7, 0xbf, 0x40, 0xf9, 0x2b, 0x03, 0xcc, 0x49,
+	0x1a, 0x34, 0xd8, 0x6d, 0x78, 0x49, 0x9d, 0x5c, 0x3d, 0xad, 0x69, 0x49, 0x51, 0x8f, 0x14, 0x11,
+	0x99, 0x50, 0x64, 0xc2, 0xa8, 0xc0, 0x77, 0x4d, 0xe2, 0x1b, 0xae, 0x85, 0x0a, 0xac, 0xb4, 0xd6,
+	0xc3, 0x5e, 0xd0, 0x20, 0x91, 0xf6, 0x60, 0x49, 0x53, 0x7f, 0x22, 0x89, 0xd6, 0x3b, 0xb0, 0x21,
+	0x71, 0xbc, 0x87, 0x3b, 0x7e, 0x13, 0x73, 0x1a, 0x5d, 0x72, 0xe6, 0x36, 0x2c, 0x7a, 0x34, 0xbc,
+	0x8c, 0xa3, 0x24, 0x68, 0x47, 0x63, 0x5e, 0xfd, 0xc6, 0x80, 0xcd, 0x0c, 0x6d, 0xda, 0xb1, 0x5d,
+	0x78, 0x39, 0x46, 0x95, 0xd6, 0x18, 0x83, 0xfd, 0x16, 0x5d, 0x8b, 0x93, 0xe8, 0x58, 0x9d, 0xf3,
+	0x37, 0x39, 0x9e, 0x7d, 0x28, 0xa7, 0x45, 0xa7, 0x25, 0x91, 0xf5, 0x8e, 0x36, 0xf6, 0x2e, 0xa7,
+	0x11, 0x6e, 0x4d, 0x37, 0x86, 0x96, 0x21, 0x77, 0x4e, 0x2e, 0x74, 0xbe, 0x89, 0xcf, 0x84, 0xf9,
+	0xfb, 0x50, 0x4e, 0x2b, 0xd3, 0xe6, 0xcb, 0x70, 0xbd, 0x8f, 0x3b, 0xbd, 0xd8, 0xb8, 0x5a, 0x58,
+	0xaf, 0xc3, 0xb2, 0x4e, 0xa5, 0xe6, 0x37, 0x72, 0x72, 0x17, 0x56, 0x12, 0x72, 0xda, 0x04, 0x82,
+	0xbc, 0xc8, 0x7d, 0x29, 0xb5, 0xe8, 0xca, 0x6f, 0xeb, 0x43, 0x40, 0x92, 0xf1, 0x6c, 0xf0, 0x90,
+	0xb6, 0x58, 0x6c, 0x02, 0x41, 0x5e, 0xde, 0x18, 0xa5, 0x5f, 0x7e, 0xa3, 0x37, 0x01, 0x46, 0xef,
+	0x8a, 0xf4, 0xad, 0x74, 0xb8, 0x63, 0xab, 0xa4, 0xb5, 0xc5, 0x23, 0x64, 0xab, 0x27, 0x4c, 0x3f,
+	0x42, 0xf6, 0xe3, 0x51, 0xa8, 0xdc, 0x84, 0x64, 0x02, 0xe4, 0xa7, 0x06, 0xac, 0xa6, 0x8c, 0x6b,
+	0x9c, 0x77, 0x21, 0xdf, 0xa1, 0x2d, 0xe1, 0x5d, 0x6e, 0xaf, 0x74, 0x78, 0xc3, 0xbe, 0xfc, 0x1a,
+	0xda, 0x0f, 0x69, 0xcb, 0x95, 0x2c, 0xe8, 0x74, 0x02, 0xa8, 0xdd, 0xa9, 0xa0, 0x94, 0x9d, 0x24,
+	0x2a, 0xab, 0xac, 0xe3, 0xf0, 0x18, 0x47, 0x38, 0x88, 0xe3, 0x60, 0xb9, 0xb0, 0x9a, 0xa2, 0x6a,
+	0x80, 0x3f, 0x84, 0xf9, 0xae, 0xa4, 0xc8, 0x00, 0x95, 0x0e, 0xd7, 0xc6, 0x21, 0x2a, 0x89, 0xe3,
+	0x85, 0xaf, 0x9e, 0x55, 0xe7, 0xfe, 0xf0, 0xcf, 0x3f, 0xdd, 0x33, 0x5c, 0x2d, 0x62, 0x7d, 0x69,
+	0xc0, 0x4b, 0x27, 0xbc, 0x5d, 0xc3, 0x9d, 0x4e, 0x22, 0xdc, 0x38, 0x6a, 0xb1, 0xf8, 0x60, 0xc4,
+	0x37, 0x7a, 0x05, 0x0a, 0x2d, 0xcc, 0xea, 0x1e, 0xee, 0xea, 0x3b, 0x32, 0xdf, 0xc2, 0xac, 0x86,
+	0xbb, 0xe8, 0x7d, 0x58, 0xee, 0x46, 0xb4, 0x4b, 0x19, 0x89, 0x86, 0xf7, 0x4c, 0xdc, 0x91, 0xc5,
+	0xe3, 0xc3, 0x7f, 0x3f, 0xab, 0xda, 0x2d, 0x9f, 0xb7, 0x7b, 0x0d, 0xdb, 0xa3, 0x81, 0xa3, 0x0b,
+	0x84, 0xfa, 0x7b, 0xc0, 0x9a, 0xe7, 0x0e, 0xbf, 0xe8, 0x12, 0x66, 0xd7, 0x46, 0x17, 0xdc, 0x7d,
+	0x39, 0xd6, 0x15, 0x5f, 0xce, 0x75, 0x28, 0x7a, 0x6d, 0xec, 0x87, 0x75, 0xbf, 0xb9, 0x96, 0xdf,
+	0x32, 0xf6, 0x72, 0x6e, 0x41, 0xae, 0xdf, 0x6e, 0x5a, 0x67, 0xb0, 0x7a, 0xc2, 0xb8, 0x1f, 0x60,
+	0x4e, 0x4e, 0xf1, 0x28, 0x1a, 0xcb, 0x90, 0x6b, 0x61, 0x05, 0x3e, 0xef, 0x8a, 0x4f, 0x41, 0x89,
+	0x08, 0x97, 0xb8, 0x17, 0x5d, 0xf1, 0x29, 0xb4, 0xf6, 0x83, 0x3a, 0x89, 0x22, 0xaa, 0x2e, 0xf4,
+	0x82, 0x5b, 0xe8, 0x07, 0x27, 0x62, 0x69, 0x7d, 0x9a, 0x8f, 0xb3, 0x20, 0xc2, 0x1e, 0x39, 0x1b,
+	0xc4, 0x41, 0x39, 0x80, 0x5c, 0xc0, 0x5a, 0x3a, 0xc2, 0xd5, 0xf1, 0x08, 0x3f, 0x62, 0xad, 0x13,
+	0x41, 0x23, 0xbd, 0xe0, 0x6c, 0xe0, 0x0a, 0x5e, 0xf4, 0x06, 0x2c, 0x72, 0xa1, 0xa4, 0xee, 0xd1,
+	0xf0, 0x89, 0xdf, 0x92, 0x96, 0x4a, 0x87, 0x9b, 0xe3, 0xb2, 0xd2, 0x54, 0x4d, 0x32, 0xb9, 0x25,
+	0x3e, 0x5a, 0xa0, 0x1a, 0x2c, 0x76, 0x23, 0xd2, 0x24, 0x1e, 0x61, 0x8c, 0x46, 0x6c, 0x2d, 0xbf,
+	0x95, 0x9b, 0xc5, 0x7a, 0x4a, 0x48, 0xbc, 0xab, 0x8d, 0x0e, 0xf5, 0xce, 0xe3, 0x17, 0xec, 0xba,
+	0x0c, 0x63, 0x49, 0xd2, 0xd4, 0xfb, 0x85, 0x36, 0x01, 0x14, 0x8b, 0xbc, 0x66, 0xf3, 0x32, 0x22,
+	0x0b, 0x92, 0x22, 0x2b, 0xd3, 0x5b, 0xf1, 0xb6, 0x28, 0x9e, 0x6b, 0x05, 0xe9, 0x86, 0x69, 0xab,
+	0xca, 0x6a, 0xc7, 0x95, 0xd5, 0x3e, 0x8b, 0x2b, 0xeb, 0xf1, 0x92, 0x48, 0xb3, 0xcf, 0xfe, 0x56,
+	0x35, 0x54, 0xaa, 0x29, 0x4d, 0x62, 0x7b, 0x62, 0xb6, 0x14, 0xff, 0x37, 0xd9, 0xb2, 0x90, 0xca,
+	0x16, 0x64, 0xc1, 0x92, 0xf2, 0x21, 0xc0, 0x83, 0xba, 0x48, 0x10, 0x48, 0x84, 0xe1, 0x11, 0x1e,
+	0x9c, 0x62, 0xf6, 0xe3, 0x7c, 0xf1, 0xda, 0x72, 0xce, 0x2d, 0xf2, 0x41, 0xdd, 0x0f, 0x9b, 0x64,
+	0x60, 0xdd, 0xd3, 0x8f, 0xe3, 0x30, 0x15, 0x46, 0x2f, 0x57, 0x13, 0x73, 0x1c, 0x5f, 0x10, 0xf1,
+	0x6d, 0x7d, 0x99, 0x83, 0x9b, 0x23, 0xe6, 0x63, 0xa1, 0x35, 0x91, 0x3a, 0x7c, 0x10, 0xbf, 0x1f,
+	0xd3, 0x53, 0x87, 0x0f, 0xd8, 0xb7, 0x90, 0x3a, 0xff, 0x3f, 0xf5, 0x19, 0x4f, 0xdd, 0x7a, 0x00,
+	0xaf, 0x8c, 0x1d, 0xdc, 0x15, 0x07, 0x7d, 0x63, 0x58, 0xeb, 0x19, 0x79, 0x93, 0xc4, 0x35, 0xc5,
+	0x7a, 0x08, 0xe5, 0x34, 0x59, 0xab, 0xf8, 0x1e, 0x14, 0xc5, 0xc3, 0x5f, 0x7f, 0x42, 0x74, 0x2d,
+	0x3d, 0x5e, 0xff, 0xeb, 0xb3, 0xea, 0x0d, 0xe5, 0x21, 0x6b, 0x9e, 0xdb, 0x3e, 0x75, 0x02, 0xcc,
+	0xdb, 0xf6, 0xdb, 0x21, 0x17, 0x35, 0x5e, 0x4a, 0x5b, 0x55, 0xdd, 0xdd, 0x9c, 0x76, 0x68, 0x03,
+	0x77, 0x1e, 0xf9, 0xe1, 0x29, 0x66, 0x8f, 0x23, 0x7f, 0xd8, 0x5a, 0x58, 0x1e, 0x54, 0xb2, 0x18,
+	0xb4, 0xe1, 0x23, 0x58, 0x0a, 0xfc, 0x50, 0x38, 0x5d, 0xef, 0x8a, 0x0d, 0x6d, 0x7d, 0x53, 0x9c,
+	0x52, 0x36, 0x82, 0x52, 0x30, 0x52, 0x35, 0xac, 0x42, 0x3a, 0xbf, 0x86, 0x9e, 0xae, 0xa6, 0xa8,
+	0xda, 0xde, 0x6b, 0x30, 0xaf, 0x93, 0xd5, 0xc8, 0x4a, 0xd6, 0x9a, 0x38, 0x15, 0x2d, 0xa6, 0x99,
+	0xad, 0xd7, 0x74, 0x7f, 0x7b, 0xe4, 0x79, 0x84, 0xb1, 0x1a, 0x0d, 0x79, 0x44, 0x3b, 0x53, 0x7b,
+	0x0b, 0xeb, 0x3d, 0x30, 0x27, 0x89, 0x69, 0x2c, 0x9b, 0x00, 0x1e, 0x0e, 0xeb, 0x5e, 0x44, 0x30,
+	0x57, 0x8e, 0x17, 0xdd, 0x05, 0x0f, 0x87, 0x35, 0x49, 0x90, 0x09, 0x23, 0xb6, 0x71, 0xa7, 0x23,
+	0xab, 0x42, 0xd1, 0x2d, 0x88, 0x4d, 0xdc, 0xe9, 0x0c, 0x03, 0xff, 0xd3, 0xae, 0xe8, 0x47, 0x4e,
+	0x31, 0x7b, 0xd7, 0x6b, 0x93, 0x66, 0xaf, 0x33, 0x0c, 0xfc, 0xfb, 0x50, 0xc9, 0x62, 0x18, 0x96,
+	0xe3, 0x02, 0x95, 0x9b, 0xf1, 0x95, 0xbf, 0x35, 0x1e, 0x89, 0xa1, 0xf4, 0x71, 0x5e, 0x9c, 0x87,
+	0x1b, 0x4b, 0x58, 0x9f, 0x18, 0xb0, 0x30, 0xdc, 0x44, 0x37, 0x61, 0x5e, 0x6d, 0x68, 0xf7, 0xf5,
+	0x2a, 0x6e, 0x95, 0x39, 0x0e, 0xb9, 0xcc, 0x6a, 0x55, 0x92, 0x4b, 0x31, 0x4d, 0x88, 0x56, 0xa1,
+	0xd4, 0xbc, 0x08, 0x71, 0xe0, 0x7b, 0x92, 0x23, 0x27, 0xdd, 0x04, 0x4d, 0x12, 0x0c, 0x15, 0x00,
+	0xda, 0x27, 0x51, 0xe4, 0x37, 0x9b, 0x24, 0x94, 0xb5, 0xb5, 0xe8, 0x26, 0x28, 0x87, 0x9f, 0xaf,
+	0xc0, 0x75, 0xe9, 0x29, 0xfa, 0x85, 0x01, 0x05, 0xdd, 0x5d, 0xa3, 0xed, 0x71, 0x5f, 0x26, 0x8c,
+	0x4f, 0xe6, 0xce, 0x34, 0x36, 0x15, 0x2b, 0x6b, 0xf7, 0xe3, 0x3f, 0xff, 0xe3, 0xf3, 0x6b, 0xb7,
+	0x51, 0x55, 0x0c, 0x7b, 0x94, 0xc5, 0x23, 0x9f, 0xee, 0xae, 0x9d, 0x8f, 0xf4, 0x71, 0x3f, 0x45,
+	0xbf, 0x35, 0x60, 0x29, 0x35, 0xc0, 0xa0, 0xef, 0x64, 0x98, 0x98, 0x34, 0x28, 0x99, 0xf7, 0x67,
+	0x63, 0xd6, 0xa8, 0x6c, 0x89, 0x6a, 0x0f, 0xed, 0xa4, 0x51, 0xc5, 0x73, 0xd2, 0x18, 0xb8, 0x3f,
+	0x1a, 0xb0, 0x7c, 0x79, 0x0e, 0x41, 0x76, 0x86, 0xc9, 0x8c, 0xf1, 0xc7, 0x74, 0x66, 0xe6, 0xd7,
+	0x28, 0x5f, 0x97, 0x28, 0xf7, 0x91, 0x9d, 0x46, 0xd9, 0x8f, 0xf9, 0x47, 0x40, 0x93, 0x63, 0xd5,
+	0x53, 0xf4, 0xb1, 0x01, 0x05, 0x3d, 0x6d, 0x64, 0x1e, 0x67, 0x7a, 0x90, 0x31, 0x77, 0xa6, 0xb1,
+	0x69, 0x48, 0x7b, 0x12, 0x92, 0x85, 0xb6, 0xd2, 0x90, 0xf4, 0xe4, 0xc2, 0x12, 0x21, 0xfb, 0xc4,
+	0x80, 0x82, 0x9e, 0x39, 0x32, 0x41, 0xa4, 0x07, 0x1c, 0x73, 0x67, 0x1a, 0x9b, 0x06, 0xf1, 0x40,
+	0x82, 0xd8, 0x45, 0xdb, 0x69, 0x10, 0x4c, 0xb1, 0x8d, 0x30, 0x38, 0x1f, 0x9d, 0x93, 0x8b, 0xa7,
+	0xa8, 0x0f, 0x79, 0x31, 0x96, 0x20, 0x2b, 0x33, 0x45, 0x86, 0xb3, 0x8e, 0x79, 0xe7, 0x4a, 0x1e,
+	0x6d, 0x7f, 0x5b, 0xda, 0xaf, 0xa2, 0xcd, 0xcb, 0xd9, 0xd3, 0x4c, 0x45, 0x80, 0xc1, 0xbc, 0xea,
+	0xca, 0xd1, 0xab, 0x19, 0x5a, 0x53, 0xcd, 0xbf, 0xb9, 0x3d, 0x85, 0x4b, 0x5b, 0xdf, 0x90, 0xd6,
+	0x6f, 0xa2, 0x72, 0xda, 0xba, 0xea, 0xf6, 0x11, 0x87, 0x82, 0x6e, 0xf6, 0xd1, 0xd6, 0xb8, 0xbe,
+	0xf4, 0x1c, 0x60, 0xee, 0x4e, 0x6b, 0x55, 0x62, 0x9b, 0x15, 0x69, 0x73, 0x0d, 0xdd, 0x4c, 0xdb,
+	0x24, 0xbc, 0x2d, 0xdf, 0x58, 0xf4, 0x21, 0x94, 0x12, 0x9d, 0xfa, 0x0c, 0x96, 0x27, 0xf8, 0x3a,
+	0xa1, 0xd5, 0xb7, 0x2c, 0x69, 0x77, 0x03, 0x99, 0x97, 0xec, 0x6a, 0x56, 0xf1, 0xf0, 0xa1, 0x01,
+	0x14, 0x74, 0xfb, 0x96, 0x99, 0x67, 0xe9, 0x4e, 0xdf, 0xdc, 0x99, 0xc6, 0x76, 0xb5, 0xd7, 0xaa,
+	0x6f, 0xe3, 0x03, 0xf4, 0x4b, 0x03, 0x60, 0xd4, 0x53, 0xa0, 0xbd, 0xab, 0xd4, 0x26, 0xfb, 0x45,
+	0xf3, 0xee, 0x0c, 0x9c, 0x1a, 0xc3, 0x6d, 0x89, 0xe1, 0x16, 0x5a, 0x9f, 0x84, 0x41, 0x36, 0x39,
+	0x22, 0x00, 0xba, 0x27, 0xb9, 0xe2, 0xb6, 0x27, 0x5b, 0x19, 0x73, 0x67, 0x1a, 0xdb, 0xd5, 0x01,
+	0x88, 0xdb, 0x1d, 0xf4, 0x3b, 0x03, 0x56, 0xc6, 0xfa, 0x13, 0x94, 0xf5, 0xce, 0x65, 0xb5, 0x3a,
+	0xe6, 0xfe, 0xec, 0x02, 0x1a, 0xd8, 0x1d, 0x09, 0x6c, 0x13, 0xdd, 0x4a, 0x03, 0x4b, 0xb5, 0x43,
+	0xe2, 0xfe, 0xe9, 0x56, 0xf9, 0xd5, 0xcc, 0x5b, 0x9d, 0x68, 0x7b, 0xcc, 0xed, 0x29, 0x5c, 0x57,
+	0xdf, 0x3f, 0xd5, 0xed, 0xc8, 0x32, 0x96, 0x6a, 0x59, 0x32, 0xcb, 0xd8, 0xa4, 0x7e, 0xc8, 0xbc,
+	0x3f, 0x1b, 0xf3, 0xd5, 0x65, 0x0c, 0x4b, 0xe6, 0xba, 0xa7, 0xb8, 0x13, 0x2f, 0xd2, 0xef, 0x0d,
+	0x58, 0x19, 0x6b, 0x6b, 0x32, 0xcf, 0x2b, 0xab, 0x43, 0x32, 0xf7, 0x67, 0x17, 0xd0, 0x40, 0xef,
+	0x4a, 0xa0, 0x77, 0xd0, 0xed, 0x34, 0x50, 0xd5, 0xec, 0xc8, 0x23, 0x63, 0x5a, 0xe4, 0xf8, 0x8d,
+	0xaf, 0x9e, 0x57, 0x8c, 0xaf, 0x9f, 0x57, 0x8c, 0xbf, 0x3f, 0xaf, 0x18, 0x9f, 0xbd, 0xa8, 0xcc,
+	0x7d, 0xfd, 0xa2, 0x32, 0xf7, 0x97, 0x17, 0x95, 0xb9, 0x9f, 0xef, 0x24, 0xc6, 0x88, 0xa1, 0x1a,
+	0xca, 0x9c, 0xfe, 0xe1, 0xbe, 0x33, 0x90, 0x2a, 0xe5, 0x28, 0xd1, 0x98, 0x97, 0xa3, 0xcb, 0x77,
+	0xff, 0x33, 0x00, 0x7a, 0x4b, 0x90, 0x4c, 0xf4, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1810,6 +2120,12 @@ type QueryClient interface {
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// AccessControl queries if an account is allowed to create and call
+	// contracts by the access control policy of the EVM parameters
+	AccessControl(ctx context.Context, in *QueryAccessControlRequest, opts ...grpc.CallOption) (*QueryAccessControlResponse, error)
+	// OpcodeGasSchedule queries the effective gas schedule of the opcodes of the
+	// EVM instruction set, with the opcode gas overrides of the EVM parameters
+	OpcodeGasSchedule(ctx context.Context, in *QueryOpcodeGasScheduleRequest, opts ...grpc.CallOption) (*QueryOpcodeGasScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -1946,6 +2262,24 @@ func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) AccessControl(ctx context.Context, in *QueryAccessControlRequest, opts ...grpc.CallOption) (*QueryAccessControlResponse, error) {
+	out := new(QueryAccessControlResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/AccessControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OpcodeGasSchedule(ctx context.Context, in *QueryOpcodeGasScheduleRequest, opts ...grpc.CallOption) (*QueryOpcodeGasScheduleResponse, error) {
+	out := new(QueryOpcodeGasScheduleResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/OpcodeGasSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1982,6 +2316,12 @@ type QueryServer interface {
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// AccessControl queries if an account is allowed to create and call
+	// contracts by the access control policy of the EVM parameters
+	AccessControl(context.Context, *QueryAccessControlRequest) (*QueryAccessControlResponse, error)
+	// OpcodeGasSchedule queries the effective gas schedule of the opcodes of the
+	// EVM instruction set, with the opcode gas overrides of the EVM parameters
+	OpcodeGasSchedule(context.Context, *QueryOpcodeGasScheduleRequest) (*QueryOpcodeGasScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2043,6 +2383,14 @@ func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigReq
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
 
+func (*UnimplementedQueryServer) AccessControl(ctx context.Context, req *QueryAccessControlRequest) (*QueryAccessControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccessControl not implemented")
+}
+
+func (*UnimplementedQueryServer) OpcodeGasSchedule(ctx context.Context, req *QueryOpcodeGasScheduleRequest) (*QueryOpcodeGasScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpcodeGasSchedule not implemented")
+}
+
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
@@ -2299,111 +2647,158 @@ func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethermint.evm.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Account",
-			Handler:    _Query_Account_Handler,
-		},
-		{
-			MethodName: "CosmosAccount",
-			Handler:    _Query_CosmosAccount_Handler,
-		},
-		{
-			MethodName: "ValidatorAccount",
-			Handler:    _Query_ValidatorAccount_Handler,
-		},
-		{
-			MethodName: "Balance",
-			Handler:    _Query_Balance_Handler,
-		},
-		{
-			MethodName: "Storage",
-			Handler:    _Query_Storage_Handler,
-		},
-		{
-			MethodName: "Code",
-			Handler:    _Query_Code_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "EthCall",
-			Handler:    _Query_EthCall_Handler,
-		},
-		{
-			MethodName: "EstimateGas",
-			Handler:    _Query_EstimateGas_Handler,
-		},
-		{
-			MethodName: "TraceTx",
-			Handler:    _Query_TraceTx_Handler,
-		},
-		{
-			MethodName: "TraceBlock",
-			Handler:    _Query_TraceBlock_Handler,
-		},
-		{
-			MethodName: "BaseFee",
-			Handler:    _Query_BaseFee_Handler,
-		},
-		{
-			MethodName: "GlobalMinGasPrice",
-			Handler:    _Query_GlobalMinGasPrice_Handler,
-		},
-		{
-			MethodName: "Config",
-			Handler:    _Query_Config_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ethermint/evm/v1/query.proto",
-}
-
-func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AccessControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccessControlRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).AccessControl(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/AccessControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccessControl(ctx, req.(*QueryAccessControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OpcodeGasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpcodeGasScheduleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
+	if interceptor == nil {
+		return srv.(QueryServer).OpcodeGasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/OpcodeGasSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpcodeGasSchedule(ctx, req.(*QueryOpcodeGasScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var (
+	Query_serviceDesc  = _Query_serviceDesc
+	_Query_serviceDesc = grpc.ServiceDesc{
+		ServiceName: "ethermint.evm.v1.Query",
+		HandlerType: (*QueryServer)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Account",
+				Handler:    _Query_Account_Handler,
+			},
+			{
+				MethodName: "CosmosAccount",
+				Handler:    _Query_CosmosAccount_Handler,
+			},
+			{
+				MethodName: "ValidatorAccount",
+				Handler:    _Query_ValidatorAccount_Handler,
+			},
+			{
+				MethodName: "Balance",
+				Handler:    _Query_Balance_Handler,
+			},
+			{
+				MethodName: "Storage",
+				Handler:    _Query_Storage_Handler,
+			},
+			{
+				MethodName: "Code",
+				Handler:    _Query_Code_Handler,
+			},
+			{
+				MethodName: "Params",
+				Handler:    _Query_Params_Handler,
+			},
+			{
+				MethodName: "EthCall",
+				Handler:    _Query_EthCall_Handler,
+			},
+			{
+				MethodName: "EstimateGas",
+				Handler:    _Query_EstimateGas_Handler,
+			},
+			{
+				MethodName: "TraceTx",
+				Handler:    _Query_TraceTx_Handler,
+			},
+			{
+				MethodName: "TraceBlock",
+				Handler:    _Query_TraceBlock_Handler,
+			},
+			{
+				MethodName: "BaseFee",
+				Handler:    _Query_BaseFee_Handler,
+			},
+			{
+				MethodName: "GlobalMinGasPrice",
+				Handler:    _Query_GlobalMinGasPrice_Handler,
+			},
+			{
+				MethodName: "Config",
+				Handler:    _Query_Config_Handler,
+			},
+			{
+				MethodName: "AccessControl",
+				Handler:    _Query_AccessControl_Handler,
+			},
+			{
+				MethodName: "OpcodeGasSchedule",
+				Handler:    _Query_OpcodeGasSchedule_Handler,
+			},
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: "ethermint/evm/v1/query.proto",
+	}
+)
+
+func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
 func (m *QueryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -3415,6 +3810,194 @@ func (m *QueryConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAccessControlRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessControlRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessControlRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessControlResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessControlResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessControlResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanCall {
+		i--
+		if m.CanCall {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CanCreate {
+		i--
+		if m.CanCreate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpcodeGasScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpcodeGasScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpcodeGasScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpcodeGasScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpcodeGasScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpcodeGasScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Opcodes) > 0 {
+		for iNdEx := len(m.Opcodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Opcodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpcodeGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpcodeGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpcodeGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overridden {
+		i--
+		if m.Overridden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DynamicGas {
+		i--
+		if m.DynamicGas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConstantGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConstantGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opcode) > 0 {
+		i -= len(m.Opcode)
+		copy(dAtA[i:], m.Opcode)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opcode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3875,33 +4458,107 @@ func (m *QueryConfigResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryAccessControlRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryAccessControlResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CanCreate {
+		n += 2
+	}
+	if m.CanCall {
+		n += 2
+	}
+	return n
 }
 
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+func (m *QueryOpcodeGasScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOpcodeGasScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Opcodes) > 0 {
+		for _, e := range m.Opcodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OpcodeGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opcode)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConstantGas != 0 {
+		n += 1 + sovQuery(uint64(m.ConstantGas))
+	}
+	if m.DynamicGas {
+		n += 2
+	}
+	if m.Overridden {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
@@ -6880,6 +7537,458 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *QueryAccessControlRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessControlRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessControlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryAccessControlResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessControlResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessControlResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanCreate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanCreate = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanCall = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryOpcodeGasScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpcodeGasScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpcodeGasScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryOpcodeGasScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpcodeGasScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpcodeGasScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcodes = append(m.Opcodes, OpcodeGas{})
+			if err := m.Opcodes[len(m.Opcodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *OpcodeGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpcodeGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpcodeGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantGas", wireType)
+			}
+			m.ConstantGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConstantGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicGas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicGas = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overridden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overridden = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -89,6 +89,21 @@ func ConvertCoinsFrom18Decimals(coins sdk.Coins) sdk.Coins {
 	return convertedCoins
 }
 
+// ConvertCoinsTo18Decimals returns the given coins with the Amount of the evm
+// coin converted from its original representation to the 18 decimals one.
+func ConvertCoinsTo18Decimals(coins sdk.Coins) sdk.Coins {
+	evmDenom := GetEVMCoinDenom()
+
+	convertedCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Denom == evmDenom {
+			coin = MustConvertEvmCoinTo18Decimals(coin)
+		}
+		convertedCoins[i] = coin
+	}
+	return convertedCoins
+}
+
 // AdjustExtraDecimalsBigInt replaces all extra decimals by zero of an amount with 18 decimals in big.Int when having a decimal configuration different than 18 decimals
 func AdjustExtraDecimalsBigInt(amt *big.Int) *big.Int {
 	if amt.Sign() == 0 {
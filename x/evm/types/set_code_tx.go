@@ -0,0 +1,600 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/green901612/cosevm/utils"
+)
+
+const (
+	// SetCodeTxType is the EIP-7702 transaction type.
+	SetCodeTxType = 0x04
+
+	// SetCodeAuthorizationMagic is the prefix of the data signed by the
+	// authorities of the EIP-7702 authorizations.
+	SetCodeAuthorizationMagic = 0x05
+
+	// PerEmptyAccountCost is the intrinsic gas charged for each authorization
+	// of an EIP-7702 transaction.
+	PerEmptyAccountCost = 25000
+
+	// PerAuthBaseCost is the gas cost of an authorization whose authority
+	// already exists. The difference with PerEmptyAccountCost is refunded.
+	PerAuthBaseCost = 12500
+)
+
+// AuthorizationList is an EIP-7702 authorization list that represents the
+// slice of the protobuf SetCodeAuthorizations.
+type AuthorizationList []SetCodeAuthorization
+
+// setCodeTxRLP is the RLP layout of the signed EIP-7702 transactions.
+type setCodeTxRLP struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []authorizationRLP
+	V, R, S    *big.Int
+}
+
+// unsignedSetCodeTxRLP is the RLP layout of the data signed by the sender of
+// the EIP-7702 transactions.
+type unsignedSetCodeTxRLP struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []authorizationRLP
+}
+
+// authorizationRLP is the RLP layout of the signed EIP-7702 authorizations.
+type authorizationRLP struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V, R, S *big.Int
+}
+
+// unsignedAuthorizationRLP is the RLP layout of the data signed by the
+// authorities of the EIP-7702 authorizations.
+type unsignedAuthorizationRLP struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+}
+
+// TxType returns the tx type
+func (tx *SetCodeTx) TxType() uint8 {
+	return SetCodeTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *SetCodeTx) Copy() TxData {
+	return &SetCodeTx{
+		ChainID:        tx.ChainID,
+		Nonce:          tx.Nonce,
+		GasTipCap:      tx.GasTipCap,
+		GasFeeCap:      tx.GasFeeCap,
+		GasLimit:       tx.GasLimit,
+		To:             tx.To,
+		Amount:         tx.Amount,
+		Data:           common.CopyBytes(tx.Data),
+		Accesses:       tx.Accesses,
+		Authorizations: tx.Authorizations,
+		V:              common.CopyBytes(tx.V),
+		R:              common.CopyBytes(tx.R),
+		S:              common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetAuthorizationList returns the AuthorizationList field.
+func (tx *SetCodeTx) GetAuthorizationList() AuthorizationList {
+	return tx.Authorizations
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *SetCodeTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *SetCodeTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *SetCodeTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetValue returns the tx amount.
+func (tx *SetCodeTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *SetCodeTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *SetCodeTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns a DynamicFeeTx transaction tx with the same fields as
+// the SetCodeTx, since go-ethereum does not support the EIP-7702 transactions.
+//
+// NOTE: the returned data does not include the authorization list, so that the
+// transactions built from it must not be used to compute the transaction hash
+// or to recover the sender. Use the SetCodeTx methods instead.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.DynamicFeeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GetGas(),
+		To:         tx.GetTo(),
+		Value:      tx.GetValue(),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return utils.RawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *SetCodeTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx SetCodeTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if !utils.IsValidInt256(tx.GetGasTipCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !utils.IsValidInt256(tx.GetGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return errorsmod.Wrapf(
+			ErrInvalidGasCap, "max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !utils.IsValidInt256(tx.Fee()) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", amount)
+	}
+	if !utils.IsValidInt256(amount) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	// set code transactions cannot create contracts
+	if tx.To == "" {
+		return errorsmod.Wrap(ErrInvalidSetCodeTx, "to address cannot be empty")
+	}
+
+	if err := utils.ValidateAddress(tx.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if len(tx.Authorizations) == 0 {
+		return errorsmod.Wrap(ErrInvalidSetCodeTx, "authorization list cannot be empty")
+	}
+
+	for i, auth := range tx.Authorizations {
+		if err := auth.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid authorization %d", i)
+		}
+	}
+
+	if tx.GetChainID() == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on SetCode txs",
+		)
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit.
+func (tx SetCodeTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GetGas())
+}
+
+// Cost returns amount + gasprice * gaslimit.
+func (tx SetCodeTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *SetCodeTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GetGas())
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+// SigHash returns the hash signed by the sender of the transaction, i.e.
+// keccak256(0x04 || rlp([chain_id, nonce, max_priority_fee_per_gas,
+// max_fee_per_gas, gas_limit, destination, value, data, access_list,
+// authorization_list])).
+func (tx *SetCodeTx) SigHash() common.Hash {
+	return prefixedRlpHash(SetCodeTxType, &unsignedSetCodeTxRLP{
+		ChainID:    bigOrZero(tx.GetChainID()),
+		Nonce:      tx.Nonce,
+		GasTipCap:  bigOrZero(tx.GetGasTipCap()),
+		GasFeeCap:  bigOrZero(tx.GetGasFeeCap()),
+		Gas:        tx.GasLimit,
+		To:         common.HexToAddress(tx.To),
+		Value:      bigOrZero(tx.GetValue()),
+		Data:       tx.Data,
+		AccessList: tx.GetAccessList(),
+		AuthList:   tx.Authorizations.toRLP(),
+	})
+}
+
+// Hash returns the Ethereum transaction hash, i.e. the hash of the canonical
+// encoding of the signed transaction.
+func (tx *SetCodeTx) Hash() common.Hash {
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
+// MarshalBinary returns the canonical encoding of the transaction, i.e.
+// 0x04 || rlp([..., signature_y_parity, signature_r, signature_s]).
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	v, r, s := tx.GetRawSignatureValues()
+
+	var buf bytes.Buffer
+	buf.WriteByte(SetCodeTxType)
+	err := rlp.Encode(&buf, &setCodeTxRLP{
+		ChainID:    bigOrZero(tx.GetChainID()),
+		Nonce:      tx.Nonce,
+		GasTipCap:  bigOrZero(tx.GetGasTipCap()),
+		GasFeeCap:  bigOrZero(tx.GetGasFeeCap()),
+		Gas:        tx.GasLimit,
+		To:         common.HexToAddress(tx.To),
+		Value:      bigOrZero(tx.GetValue()),
+		Data:       tx.Data,
+		AccessList: tx.GetAccessList(),
+		AuthList:   tx.Authorizations.toRLP(),
+		V:          bigOrZero(v),
+		R:          bigOrZero(r),
+		S:          bigOrZero(s),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalSetCodeTx decodes the canonical encoding of an EIP-7702
+// transaction.
+func UnmarshalSetCodeTx(b []byte) (*SetCodeTx, error) {
+	if len(b) == 0 || b[0] != SetCodeTxType {
+		return nil, errorsmod.Wrap(ErrInvalidSetCodeTx, "invalid transaction type")
+	}
+
+	var dec setCodeTxRLP
+	if err := rlp.DecodeBytes(b[1:], &dec); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidSetCodeTx, err.Error())
+	}
+
+	tx := &SetCodeTx{
+		Nonce:    dec.Nonce,
+		GasLimit: dec.Gas,
+		To:       dec.To.Hex(),
+		Data:     dec.Data,
+		Accesses: NewAccessList(&dec.AccessList),
+	}
+
+	for _, field := range []struct {
+		value  *big.Int
+		target **sdkmath.Int
+	}{
+		{dec.GasTipCap, &tx.GasTipCap},
+		{dec.GasFeeCap, &tx.GasFeeCap},
+		{dec.Value, &tx.Amount},
+	} {
+		value, err := utils.SafeNewIntFromBigInt(field.value)
+		if err != nil {
+			return nil, err
+		}
+		*field.target = &value
+	}
+
+	for _, auth := range dec.AuthList {
+		chainID, err := utils.SafeNewIntFromBigInt(auth.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		tx.Authorizations = append(tx.Authorizations, SetCodeAuthorization{
+			ChainID: &chainID,
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+			V:       auth.V.Bytes(),
+			R:       auth.R.Bytes(),
+			S:       auth.S.Bytes(),
+		})
+	}
+
+	tx.SetSignatureValues(dec.ChainID, dec.V, dec.R, dec.S)
+	return tx, nil
+}
+
+// Sender recovers the sender of the transaction from its signature. It fails
+// if the transaction is not signed for the given chain ID.
+func (tx *SetCodeTx) Sender(chainID *big.Int) (common.Address, error) {
+	if txChainID := tx.GetChainID(); txChainID == nil || txChainID.Cmp(chainID) != 0 {
+		return common.Address{}, fmt.Errorf("invalid chain id for signer: have %s want %s", tx.GetChainID(), chainID)
+	}
+
+	v, r, s := tx.GetRawSignatureValues()
+	return recoverAddress(tx.SigHash(), v, r, s)
+}
+
+// WithSignature sets the given signature in the [R || S || V] format, where V
+// is the y parity, to the transaction.
+func (tx *SetCodeTx) WithSignature(sig []byte) error {
+	if len(sig) != crypto.SignatureLength {
+		return fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	v := new(big.Int).SetBytes([]byte{sig[64]})
+	tx.SetSignatureValues(nil, v, r, s)
+	return nil
+}
+
+// toRLP returns the RLP layout of the authorization list.
+func (al AuthorizationList) toRLP() []authorizationRLP {
+	authList := make([]authorizationRLP, len(al))
+	for i, auth := range al {
+		v, r, s := auth.GetRawSignatureValues()
+		authList[i] = authorizationRLP{
+			ChainID: bigOrZero(auth.GetChainID()),
+			Address: auth.GetAddress(),
+			Nonce:   auth.Nonce,
+			V:       bigOrZero(v),
+			R:       bigOrZero(r),
+			S:       bigOrZero(s),
+		}
+	}
+	return authList
+}
+
+// GetChainID returns the chain id of the authorization, zero if it is valid on
+// any chain.
+func (auth SetCodeAuthorization) GetChainID() *big.Int {
+	if auth.ChainID == nil {
+		return nil
+	}
+	return auth.ChainID.BigInt()
+}
+
+// GetAddress returns the address the code of the authority is delegated to.
+func (auth SetCodeAuthorization) GetAddress() common.Address {
+	return common.HexToAddress(auth.Address)
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the
+// authorization.
+func (auth SetCodeAuthorization) GetRawSignatureValues() (v, r, s *big.Int) {
+	return utils.RawSignatureValues(auth.V, auth.R, auth.S)
+}
+
+// SigHash returns the hash signed by the authority, i.e.
+// keccak256(0x05 || rlp([chain_id, address, nonce])).
+func (auth SetCodeAuthorization) SigHash() common.Hash {
+	return prefixedRlpHash(SetCodeAuthorizationMagic, &unsignedAuthorizationRLP{
+		ChainID: bigOrZero(auth.GetChainID()),
+		Address: auth.GetAddress(),
+		Nonce:   auth.Nonce,
+	})
+}
+
+// Authority recovers the address of the signer of the authorization.
+func (auth SetCodeAuthorization) Authority() (common.Address, error) {
+	v, r, s := auth.GetRawSignatureValues()
+	return recoverAddress(auth.SigHash(), v, r, s)
+}
+
+// Sign signs the authorization with the given private key of the authority.
+func (auth *SetCodeAuthorization) Sign(key *ecdsa.PrivateKey) error {
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), key)
+	if err != nil {
+		return err
+	}
+
+	auth.R = new(big.Int).SetBytes(sig[:32]).Bytes()
+	auth.S = new(big.Int).SetBytes(sig[32:64]).Bytes()
+	auth.V = new(big.Int).SetBytes([]byte{sig[64]}).Bytes()
+	return nil
+}
+
+// Validate performs a stateless validation of the authorization fields. The
+// signature of the authorization is verified during the execution, where the
+// invalid authorizations are skipped.
+func (auth SetCodeAuthorization) Validate() error {
+	if auth.ChainID == nil || auth.ChainID.IsNegative() || !utils.IsValidInt256(auth.ChainID.BigInt()) {
+		return errorsmod.Wrap(ErrInvalidSetCodeTx, "invalid authorization chain ID")
+	}
+
+	if err := utils.ValidateAddress(auth.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid authorization address")
+	}
+
+	return nil
+}
+
+// recoverAddress recovers the address of the signer of the given hash from the
+// signature values, where v is the y parity of the signature.
+func recoverAddress(sighash common.Hash, v, r, s *big.Int) (common.Address, error) {
+	// a zero y parity is stored as empty bytes
+	v = bigOrZero(v)
+	if r == nil || s == nil || v.BitLen() > 8 {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	yParity := byte(v.Uint64())
+	if !crypto.ValidateSignatureValues(yParity, r, s, true) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = yParity
+
+	pub, err := crypto.Ecrecover(sighash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(pub) == 0 || pub[0] != 4 {
+		return common.Address{}, errors.New("invalid public key")
+	}
+
+	return common.BytesToAddress(crypto.Keccak256(pub[1:])[12:]), nil
+}
+
+// prefixedRlpHash returns keccak256(prefix || rlp(x)).
+func prefixedRlpHash(prefix byte, x interface{}) common.Hash {
+	var buf bytes.Buffer
+	buf.WriteByte(prefix)
+	if err := rlp.Encode(&buf, x); err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(buf.Bytes())
+}
+
+// bigOrZero returns the given value, or zero if it is nil.
+func bigOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return value
+}
@@ -0,0 +1,170 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAuthorization(t *testing.T, chainID int64, address common.Address, nonce uint64) (SetCodeAuthorization, common.Address) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	authChainID := sdkmath.NewInt(chainID)
+	auth := SetCodeAuthorization{
+		ChainID: &authChainID,
+		Address: address.Hex(),
+		Nonce:   nonce,
+	}
+	require.NoError(t, auth.Sign(key))
+	return auth, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestSetCodeAuthorizationAuthority(t *testing.T) {
+	target := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	auth, authority := newTestAuthorization(t, 9000, target, 1)
+
+	recovered, err := auth.Authority()
+	require.NoError(t, err)
+	require.Equal(t, authority, recovered)
+
+	// the nonce is part of the signed payload
+	auth.Nonce++
+	recovered, err = auth.Authority()
+	require.NoError(t, err)
+	require.NotEqual(t, authority, recovered)
+
+	auth.R = nil
+	_, err = auth.Authority()
+	require.Error(t, err)
+}
+
+func TestSetCodeTxSignature(t *testing.T) {
+	chainID := big.NewInt(9000)
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	auth, _ := newTestAuthorization(t, 0, to, 0)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	msg := NewTx(&EvmTxArgs{
+		ChainID:           chainID,
+		Nonce:             1,
+		To:                &to,
+		Amount:            big.NewInt(10),
+		GasLimit:          100_000,
+		GasFeeCap:         big.NewInt(2_000_000_000),
+		GasTipCap:         big.NewInt(1_000_000_000),
+		AuthorizationList: AuthorizationList{auth},
+	})
+	txData, ok := msg.setCodeTx()
+	require.True(t, ok)
+
+	sig, err := crypto.Sign(txData.SigHash().Bytes(), key)
+	require.NoError(t, err)
+	require.NoError(t, txData.WithSignature(sig))
+
+	bz, err := txData.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, byte(SetCodeTxType), bz[0])
+
+	msgBz, err := msg.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, bz, msgBz)
+
+	decoded := &MsgEthereumTx{}
+	require.NoError(t, decoded.UnmarshalBinary(bz))
+	require.Equal(t, txData.Hash(), decoded.TxHash())
+	require.Equal(t, txData.Hash().Hex(), decoded.Hash)
+	require.NoError(t, decoded.ValidateBasic())
+
+	sender, err := decoded.RecoverSender(chainID)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+	require.Empty(t, decoded.From)
+
+	_, err = decoded.RecoverSender(big.NewInt(1))
+	require.Error(t, err)
+
+	sender, err = decoded.GetSender(chainID)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+	require.Equal(t, from.Hex(), decoded.From)
+
+	decodedTxData, ok := decoded.setCodeTx()
+	require.True(t, ok)
+	require.Equal(t, AuthorizationList{auth}, decodedTxData.GetAuthorizationList())
+}
+
+func TestSetCodeTxValidate(t *testing.T) {
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002").Hex()
+	chainID := sdkmath.NewInt(9000)
+	gasTipCap := sdkmath.NewInt(1)
+	gasFeeCap := sdkmath.NewInt(2)
+	auth, _ := newTestAuthorization(t, 9000, common.HexToAddress(to), 0)
+
+	testCases := []struct {
+		name     string
+		modify   func(tx *SetCodeTx)
+		expError bool
+	}{
+		{
+			"valid",
+			func(*SetCodeTx) {},
+			false,
+		},
+		{
+			"empty to address",
+			func(tx *SetCodeTx) { tx.To = "" },
+			true,
+		},
+		{
+			"empty authorization list",
+			func(tx *SetCodeTx) { tx.Authorizations = nil },
+			true,
+		},
+		{
+			"invalid authorization address",
+			func(tx *SetCodeTx) { tx.Authorizations[0].Address = "invalid" },
+			true,
+		},
+		{
+			"nil authorization chain id",
+			func(tx *SetCodeTx) { tx.Authorizations[0].ChainID = nil },
+			true,
+		},
+		{
+			"nil chain id",
+			func(tx *SetCodeTx) { tx.ChainID = nil },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := &SetCodeTx{
+				ChainID:        &chainID,
+				To:             to,
+				GasTipCap:      &gasTipCap,
+				GasFeeCap:      &gasFeeCap,
+				GasLimit:       21000,
+				Authorizations: AuthorizationList{auth},
+			}
+			tc.modify(tx)
+
+			err := tx.Validate()
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
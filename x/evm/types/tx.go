@@ -13,7 +13,7 @@ import (
 )
 
 // EvmTxArgs encapsulates all possible params to create all EVM txs types.
-// This includes LegacyTx, DynamicFeeTx and AccessListTx
+// This includes LegacyTx, DynamicFeeTx, AccessListTx and SetCodeTx
 type EvmTxArgs struct {
 	Nonce     uint64
 	GasLimit  uint64
@@ -25,10 +25,17 @@ type EvmTxArgs struct {
 	GasTipCap *big.Int
 	To        *common.Address
 	Accesses  *ethtypes.AccessList
+	// AuthorizationList is the EIP-7702 authorization list of SetCodeTx
+	AuthorizationList AuthorizationList
 }
 
 // ToTxData converts the EvmTxArgs to TxData
 func (args *EvmTxArgs) ToTxData() (TxData, error) {
+	// go-ethereum does not support the EIP-7702 transactions
+	if args.AuthorizationList != nil {
+		return UnpackTxData(NewTx(args).Data)
+	}
+
 	ethTx := NewTx(args).AsTransaction()
 	return NewTxDataFromTx(ethTx)
 }
@@ -150,7 +150,7 @@ func (args *TransactionArgs) ToTransaction() *MsgEthereumTx {
 		Data: anyData,
 		From: from,
 	}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.TxHash().Hex()
 	return &msg
 }
 
@@ -32,6 +32,21 @@ func IsEmptyCodeHash(bz []byte) bool {
 	return bytes.Equal(bz, EmptyCodeHash)
 }
 
+// BlockRandom returns the PREVRANDAO value of a block, given the hash of its
+// previous block, i.e. the LastBlockId of its CometBFT header. Unlike the hash
+// of the block itself, it is fixed before the block is proposed, so the
+// proposer can't grind it through the transactions it includes. It is still
+// biasable: the proposer of the previous block chooses its contents and can
+// try several of them to pick a favourable value, and it is known one block in
+// advance. Hence it must not be used as a source of secure randomness. It
+// returns an empty hash for the first block, which has no previous block.
+func BlockRandom(lastBlockHash common.Hash) common.Hash {
+	if lastBlockHash == (common.Hash{}) {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(lastBlockHash.Bytes())
+}
+
 // DecodeTxResponse decodes an protobuf-encoded byte slice into TxResponse
 func DecodeTxResponse(in []byte) (*MsgEthereumTxResponse, error) {
 	var txMsgData sdk.TxMsgData
@@ -77,7 +92,7 @@ func UnwrapEthereumMsg(tx *sdk.Tx, ethHash common.Hash) (*MsgEthereumTx, error)
 		if !ok {
 			return nil, fmt.Errorf("invalid tx type: %T", tx)
 		}
-		txHash := ethMsg.AsTransaction().Hash()
+		txHash := ethMsg.TxHash()
 		ethMsg.Hash = txHash.Hex()
 		if txHash == ethHash {
 			return ethMsg, nil
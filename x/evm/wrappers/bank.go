@@ -85,6 +85,36 @@ func (w BankWrapper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom
 	return types.MustConvertEvmCoinTo18Decimals(coin)
 }
 
+// GetAllBalances returns all the balances of the given account, with the
+// balance of the evm coin converted to 18 decimals.
+func (w BankWrapper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return types.ConvertCoinsTo18Decimals(w.BankKeeper.GetAllBalances(ctx, addr))
+}
+
+// GetSupply returns the total supply of the given denom. The supply of the evm
+// coin is converted to 18 decimals.
+func (w BankWrapper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	coin := w.BankKeeper.GetSupply(ctx, denom)
+	if denom != types.GetEVMCoinDenom() {
+		return coin
+	}
+
+	return types.MustConvertEvmCoinTo18Decimals(coin)
+}
+
+// IterateTotalSupply iterates over the total supply of all the denoms, with
+// the supply of the evm coin converted to 18 decimals.
+func (w BankWrapper) IterateTotalSupply(ctx context.Context, cb func(sdk.Coin) bool) {
+	evmDenom := types.GetEVMCoinDenom()
+
+	w.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		if coin.Denom == evmDenom {
+			coin = types.MustConvertEvmCoinTo18Decimals(coin)
+		}
+		return cb(coin)
+	})
+}
+
 // SendCoinsFromAccountToModule wraps around the Cosmos SDK x/bank module's
 // SendCoinsFromAccountToModule method to convert the evm coin, if present in
 // the input, to its original representation.
@@ -112,3 +142,15 @@ func (w BankWrapper) SendCoinsFromModuleToAccount(ctx context.Context, senderMod
 
 	return w.BankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, convertedCoins)
 }
+
+// SendCoins wraps around the Cosmos SDK x/bank module's SendCoins method to
+// convert the evm coin, if present in the input, to its original
+// representation.
+func (w BankWrapper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, coins sdk.Coins) error {
+	convertedCoins := types.ConvertCoinsFrom18Decimals(coins)
+	if convertedCoins.IsZero() {
+		return nil
+	}
+
+	return w.BankKeeper.SendCoins(ctx, fromAddr, toAddr, convertedCoins)
+}
@@ -14,15 +14,21 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/green901612/cosevm/x/feemarket/client/cli"
 	"github.com/green901612/cosevm/x/feemarket/keeper"
+	modulev1 "github.com/green901612/cosevm/x/feemarket/module/v1"
 	"github.com/green901612/cosevm/x/feemarket/types"
 )
 
@@ -184,3 +190,57 @@ func (am AppModule) IsAppModule() {}
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() {}
+
+// ____________________________________________________________________________
+
+// App Wiring Setup
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(ProvideModule),
+	)
+}
+
+// ModuleInputs defines the dependencies of the fee market module required by
+// depinject.
+type ModuleInputs struct {
+	depinject.In
+
+	Config       *modulev1.Module
+	Cdc          codec.Codec
+	StoreKey     *storetypes.KVStoreKey
+	TransientKey *storetypes.TransientStoreKey
+
+	// LegacySubspace is used solely for migration of x/params managed parameters
+	LegacySubspace paramstypes.Subspace `optional:"true"`
+}
+
+// ModuleOutputs defines the fee market module outputs provided to depinject.
+type ModuleOutputs struct {
+	depinject.Out
+
+	FeeMarketKeeper keeper.Keeper
+	Module          appmodule.AppModule
+}
+
+// ProvideModule builds the fee market keeper and app module from the injected
+// dependencies.
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc,
+		authority,
+		in.StoreKey,
+		in.TransientKey,
+		in.LegacySubspace,
+	)
+
+	m := NewAppModule(k, in.LegacySubspace)
+
+	return ModuleOutputs{FeeMarketKeeper: k, Module: m}
+}